@@ -44,6 +44,14 @@ const (
 	IntegrationDontRunAfterBuildAnnotation = "camel.apache.org/dont-run-after-build"
 	// IntegrationDontRunAfterBuildAnnotationTrueValue -- .
 	IntegrationDontRunAfterBuildAnnotationTrueValue = "true"
+	// IntegrationModelineAnnotation enables the operator to honour the modeline options declared in the Integration sources.
+	IntegrationModelineAnnotation = "camel.apache.org/modeline"
+	// IntegrationSecurityApprovalAnnotation lists the risk categories approved for the Integration (comma separated, `*` for all),
+	// as required by the security policy.
+	IntegrationSecurityApprovalAnnotation = "camel.apache.org/security-approval"
+	// IntegrationLogLevelsAnnotation lists the logger levels to change at runtime, without restarting the Integration
+	// (a JSON list of LogLevel).
+	IntegrationLogLevelsAnnotation = "camel.apache.org/log-levels"
 )
 
 // BuildConfiguration represent the configuration required to build the runtime.
@@ -224,12 +232,16 @@ type Traits struct {
 	GitOps *trait.GitOpsTrait `json:"gitops,omitempty" property:"gitops"`
 	// The configuration of Health trait
 	Health *trait.HealthTrait `json:"health,omitempty" property:"health"`
+	// The configuration of HPA trait
+	HPA *trait.HPATrait `json:"hpa,omitempty" property:"hpa"`
 	// The configuration of Ingress trait
 	Ingress *trait.IngressTrait `json:"ingress,omitempty" property:"ingress"`
 	// The configuration of Init Containers trait
 	InitContainers *trait.InitContainersTrait `json:"init-containers,omitempty" property:"init-containers"`
 	// The configuration of Istio trait
 	Istio *trait.IstioTrait `json:"istio,omitempty" property:"istio"`
+	// The configuration of Job trait
+	Job *trait.JobTrait `json:"job,omitempty" property:"job"`
 	// The configuration of Jolokia trait.
 	//
 	// Deprecated: no longer in use.
@@ -250,6 +262,8 @@ type Traits struct {
 	Master *trait.MasterTrait `json:"master,omitempty" property:"master"`
 	// The configuration of Mount trait
 	Mount *trait.MountTrait `json:"mount,omitempty" property:"mount"`
+	// The configuration of NetworkPolicy trait
+	NetworkPolicy *trait.NetworkPolicyTrait `json:"network-policy,omitempty" property:"network-policy"`
 	// The configuration of OpenAPI trait.
 	//
 	// Deprecated: no longer in use.
@@ -272,6 +286,8 @@ type Traits struct {
 	//
 	// Deprecated: no longer in use.
 	DeprecatedRegistry *trait.RegistryTrait `json:"registry,omitempty" property:"registry"`
+	// The configuration of Rollout trait
+	Rollout *trait.RolloutTrait `json:"rollout,omitempty" property:"rollout"`
 	// The configuration of Route trait.
 	//
 	// Deprecated: use ingress instead.
@@ -284,13 +300,15 @@ type Traits struct {
 	//
 	// Deprecated: no longer in use.
 	DeprecatedServiceBinding *trait.ServiceBindingTrait `json:"service-binding,omitempty" property:"service-binding"`
+	// The configuration of StatefulSet trait
+	StatefulSet *trait.StatefulSetTrait `json:"statefulset,omitempty" property:"statefulset"`
 	// The configuration of Telemetry trait
 	Telemetry *trait.TelemetryTrait `json:"telemetry,omitempty" property:"telemetry"`
 	// The configuration of Toleration trait
 	Toleration *trait.TolerationTrait `json:"toleration,omitempty" property:"toleration"`
 
-	// Deprecated: no longer in use.
-	DeprecatedAddons map[string]AddonTrait `json:"addons,omitempty"`
+	// The configuration of the external traits, registered with ExternalTrait resources, indexed by trait ID.
+	Addons map[string]AddonTrait `json:"addons,omitempty"`
 
 	// Deprecated: no longer in use.
 	DeprecatedStrimzi *TraitSpec `json:"strimzi,omitempty" property:"strimzi"`
@@ -351,6 +369,15 @@ type RegistrySpec struct {
 	Organization string `json:"organization,omitempty"`
 }
 
+// SecurityPolicySpec defines how the Integrations using security sensitive components are admitted.
+// The components are tagged with a risk category (ie, `command-execution`) by the rules shipped with the operator.
+type SecurityPolicySpec struct {
+	// the risk categories the Integrations are not allowed to use
+	Deny []string `json:"deny,omitempty"`
+	// the risk categories the Integrations can only use once approved with the `camel.apache.org/security-approval` annotation
+	RequireApproval []string `json:"requireApproval,omitempty"`
+}
+
 // ValueSource --.
 type ValueSource struct {
 	// Selects a key of a ConfigMap.
@@ -507,6 +534,8 @@ const (
 	SourceTypeTemplate SourceType = "template"
 	// SourceTypeErrorHandler is used to represent an error handler.
 	SourceTypeErrorHandler SourceType = "errorHandler"
+	// SourceTypeOpenAPI is used to represent an OpenAPI 3 document, from which the REST services are generated.
+	SourceTypeOpenAPI SourceType = "openapi"
 )
 
 // DataSpec represents the way the source is materialized in the running `Pod`.
@@ -0,0 +1,114 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ExternalTraitKind --.
+	ExternalTraitKind string = "ExternalTrait"
+)
+
+// +genclient
+// +genclient:noStatus
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=externaltraits,scope=Namespaced,shortName=et,categories=kamel;camel
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Trait",type=string,JSONPath=`.spec.id`,description="The trait ID"
+// +kubebuilder:printcolumn:name="Order",type=integer,JSONPath=`.spec.order`,description="The trait order"
+// +kubebuilder:printcolumn:name="Failure Policy",type=string,JSONPath=`.spec.failurePolicy`,description="The failure policy"
+
+// ExternalTrait registers a trait implemented out of the operator by a webhook. The operator calls the webhook
+// when configuring and applying the trait to an Integration, and applies the JSON patches it returns
+// to the Integration resources. ExternalTraits are read from the operator namespace.
+type ExternalTrait struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// the desired state of the external trait
+	Spec ExternalTraitSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ExternalTraitList contains a list of ExternalTrait.
+type ExternalTraitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ExternalTrait `json:"items"`
+}
+
+// ExternalTraitSpec defines the external trait and how the operator calls it.
+type ExternalTraitSpec struct {
+	// The trait ID, used to configure the trait in the `addons` section of the Integration traits.
+	// It must not be the ID of a trait provided by the operator.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	ID string `json:"id"`
+	// The order in which the trait is executed among the other traits (default `2450`, after all the resources are created).
+	Order *int `json:"order,omitempty"`
+	// The Integration phases in which the trait is executed (default `Deploying`, `Running` and `Error`).
+	Phases []IntegrationPhase `json:"phases,omitempty"`
+	// Whether the trait is executed when the Integration does not configure it (default `false`).
+	EnabledByDefault bool `json:"enabledByDefault,omitempty"`
+	// The webhook implementing the trait.
+	Webhook ExternalTraitWebhook `json:"webhook"`
+	// What to do when the webhook cannot be called or returns an invalid response,
+	// either `Fail` the Integration (default) or `Ignore` the trait.
+	FailurePolicy ExternalTraitFailurePolicy `json:"failurePolicy,omitempty"`
+	// The number of seconds to wait for the webhook response (default `10`).
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=30
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// ExternalTraitWebhook defines how to reach the webhook implementing an external trait.
+// Exactly one of `url` or `service` must be set.
+type ExternalTraitWebhook struct {
+	// The URL of the webhook.
+	URL string `json:"url,omitempty"`
+	// The Service exposing the webhook over HTTPS.
+	Service *ExternalTraitServiceReference `json:"service,omitempty"`
+	// The PEM encoded CA bundle used to verify the webhook server certificate (the system trust roots are used otherwise).
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
+// ExternalTraitServiceReference references the Service exposing an external trait webhook.
+type ExternalTraitServiceReference struct {
+	// The namespace of the Service (default the ExternalTrait namespace).
+	Namespace string `json:"namespace,omitempty"`
+	// The name of the Service.
+	Name string `json:"name"`
+	// The URL path of the webhook.
+	Path string `json:"path,omitempty"`
+	// The port of the Service (default `443`).
+	Port *int32 `json:"port,omitempty"`
+}
+
+// ExternalTraitFailurePolicy defines how the failures of an external trait webhook are handled.
+// +kubebuilder:validation:Enum=Fail;Ignore
+type ExternalTraitFailurePolicy string
+
+const (
+	// ExternalTraitFailurePolicyFail fails the Integration when the webhook call fails.
+	ExternalTraitFailurePolicyFail ExternalTraitFailurePolicy = "Fail"
+	// ExternalTraitFailurePolicyIgnore skips the trait when the webhook call fails.
+	ExternalTraitFailurePolicyIgnore ExternalTraitFailurePolicy = "Ignore"
+)
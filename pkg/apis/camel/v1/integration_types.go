@@ -103,6 +103,11 @@ type IntegrationStatus struct {
 	Jar string `json:"jar,omitempty"`
 	// a list of dependencies needed by the application
 	Dependencies []string `json:"dependencies,omitempty"`
+	// the origin of the dependencies, recorded when the `dependencies.provenance` trait option is enabled
+	DependencyOrigins []DependencyOrigin `json:"dependencyOrigins,omitempty"`
+	// the modeline options declared in the sources and applied by the operator,
+	// recorded when the `camel.apache.org/modeline` annotation is enabled
+	Modeline []ModelineOption `json:"modeline,omitempty"`
 	// the profile needed to run this Integration
 	//
 	// Deprecated: may be removed in future releases.
@@ -135,6 +140,14 @@ type IntegrationStatus struct {
 	Selector string `json:"selector,omitempty"`
 	// features offered by the Integration
 	Capabilities []string `json:"capabilities,omitempty"`
+	// the progress of the delivery of a new version, when the `rollout` trait is enabled
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+	// the logger levels changed at runtime, as requested with the `camel.apache.org/log-levels` annotation
+	LogLevels []LogLevel `json:"logLevels,omitempty"`
+	// the executions of the Integration, when it runs as a CronJob
+	Cron *CronStatus `json:"cron,omitempty"`
+	// the health checks of the Camel runtime, aggregated across the Pods of the Integration
+	Health *HealthStatus `json:"health,omitempty"`
 	// the timestamp representing the last time when this integration was initialized.
 	InitializationTimestamp *metav1.Time `json:"lastInitTimestamp,omitempty"`
 	// the timestamp representing the last time when this integration was deployed.
@@ -185,6 +198,10 @@ const (
 	IntegrationPhaseRunning IntegrationPhase = "Running"
 	// IntegrationPhaseError --.
 	IntegrationPhaseError IntegrationPhase = "Error"
+	// IntegrationPhaseSucceeded if the Integration ran to completion (job controller strategy).
+	IntegrationPhaseSucceeded IntegrationPhase = "Succeeded"
+	// IntegrationPhaseFailed if the Integration did not run to completion (job controller strategy).
+	IntegrationPhaseFailed IntegrationPhase = "Failed"
 	// IntegrationPhaseUnknown --.
 	//
 	// Deprecated: no longer in use.
@@ -206,10 +223,16 @@ const (
 	IntegrationConditionKnativeAvailable IntegrationConditionType = "KnativeAvailable"
 	// IntegrationConditionCronJobAvailable --.
 	IntegrationConditionCronJobAvailable IntegrationConditionType = "CronJobAvailable"
+	// IntegrationConditionStatefulSetAvailable --.
+	IntegrationConditionStatefulSetAvailable IntegrationConditionType = "StatefulSetAvailable"
+	// IntegrationConditionJobAvailable --.
+	IntegrationConditionJobAvailable IntegrationConditionType = "JobAvailable"
 	// IntegrationConditionExposureAvailable --.
 	IntegrationConditionExposureAvailable IntegrationConditionType = "ExposureAvailable"
 	// IntegrationConditionPrometheusAvailable --.
 	IntegrationConditionPrometheusAvailable IntegrationConditionType = "PrometheusAvailable"
+	// IntegrationConditionNetworkPolicyAvailable --.
+	IntegrationConditionNetworkPolicyAvailable IntegrationConditionType = "NetworkPolicyAvailable"
 	// IntegrationConditionProbesAvailable --.
 	IntegrationConditionProbesAvailable IntegrationConditionType = "ProbesAvailable"
 	// IntegrationConditionTraitInfo --.
@@ -247,8 +270,20 @@ const (
 	IntegrationConditionCronJobAvailableReason string = "CronJobAvailableReason"
 	// IntegrationConditionCronJobNotAvailableReason --.
 	IntegrationConditionCronJobNotAvailableReason string = "CronJobNotAvailableReason"
+	// IntegrationConditionStatefulSetAvailableReason --.
+	IntegrationConditionStatefulSetAvailableReason string = "StatefulSetAvailable"
+	// IntegrationConditionStatefulSetNotAvailableReason --.
+	IntegrationConditionStatefulSetNotAvailableReason string = "StatefulSetNotAvailable"
+	// IntegrationConditionJobAvailableReason --.
+	IntegrationConditionJobAvailableReason string = "JobAvailable"
+	// IntegrationConditionJobNotAvailableReason --.
+	IntegrationConditionJobNotAvailableReason string = "JobNotAvailable"
 	// IntegrationConditionPrometheusAvailableReason --.
 	IntegrationConditionPrometheusAvailableReason string = "PrometheusAvailable"
+	// IntegrationConditionNetworkPolicyAvailableReason --.
+	IntegrationConditionNetworkPolicyAvailableReason string = "NetworkPolicyAvailable"
+	// IntegrationConditionNetworkPolicyAuditReason used to report the traffic allowed by a NetworkPolicy which is not enforced.
+	IntegrationConditionNetworkPolicyAuditReason string = "NetworkPolicyAudit"
 	// IntegrationConditionProbesAvailableReason --.
 	IntegrationConditionProbesAvailableReason string = "ProbesAvailable"
 	// IntegrationConditionMonitoringPodsAvailableReason used to specify that the Pods generated are available for monitoring.
@@ -259,6 +294,10 @@ const (
 	IntegrationConditionDeploymentReadyReason string = "DeploymentReady"
 	// IntegrationConditionDeploymentProgressingReason --.
 	IntegrationConditionDeploymentProgressingReason string = "DeploymentProgressing"
+	// IntegrationConditionStatefulSetReadyReason --.
+	IntegrationConditionStatefulSetReadyReason string = "StatefulSetReady"
+	// IntegrationConditionStatefulSetProgressingReason --.
+	IntegrationConditionStatefulSetProgressingReason string = "StatefulSetProgressing"
 	// IntegrationConditionCronJobCreatedReason --.
 	IntegrationConditionCronJobCreatedReason string = "CronJobCreated"
 	// IntegrationConditionCronJobActiveReason --.
@@ -267,6 +306,14 @@ const (
 	IntegrationConditionLastJobSucceededReason string = "LastJobSucceeded"
 	// IntegrationConditionLastJobFailedReason --.
 	IntegrationConditionLastJobFailedReason string = "LastJobFailed"
+	// IntegrationConditionJobActiveReason --.
+	IntegrationConditionJobActiveReason string = "JobActive"
+	// IntegrationConditionJobPendingReason --.
+	IntegrationConditionJobPendingReason string = "JobPending"
+	// IntegrationConditionJobSucceededReason --.
+	IntegrationConditionJobSucceededReason string = "JobSucceeded"
+	// IntegrationConditionJobFailedReason --.
+	IntegrationConditionJobFailedReason string = "JobFailed"
 	// IntegrationConditionRuntimeNotReadyReason --.
 	IntegrationConditionRuntimeNotReadyReason string = "RuntimeNotReady"
 	// IntegrationConditionErrorReason --.
@@ -281,10 +328,288 @@ const (
 	IntegrationConditionKameletsAvailableReason string = "KameletsAvailable"
 	// IntegrationConditionKameletsNotAvailableReason --.
 	IntegrationConditionKameletsNotAvailableReason string = "KameletsNotAvailable"
+	// IntegrationConditionKameletsDeprecated used to warn that the Integration resolves deprecated Kamelets.
+	IntegrationConditionKameletsDeprecated IntegrationConditionType = "KameletsDeprecated"
+	// IntegrationConditionKameletsDeprecatedReason --.
+	IntegrationConditionKameletsDeprecatedReason string = "KameletsDeprecated"
 	// IntegrationConditionImportingKindAvailableReason used (as false) if we're trying to import an unsupported kind.
 	IntegrationConditionImportingKindAvailableReason string = "ImportingKindAvailable"
+	// IntegrationConditionPropertiesResolved reports whether the property placeholders used in the sources can be resolved.
+	IntegrationConditionPropertiesResolved IntegrationConditionType = "PropertiesResolved"
+	// IntegrationConditionPropertiesResolvedReason --.
+	IntegrationConditionPropertiesResolvedReason string = "PropertiesResolved"
+	// IntegrationConditionPropertiesUnresolvedReason --.
+	IntegrationConditionPropertiesUnresolvedReason string = "PropertiesUnresolved"
+	// IntegrationConditionActivationProfile reports whether every consumer can be activated by an event driven scaler,
+	// so that the integration can scale to zero.
+	IntegrationConditionActivationProfile IntegrationConditionType = "ActivationProfile"
+	// IntegrationConditionEventDrivenReason --.
+	IntegrationConditionEventDrivenReason string = "EventDriven"
+	// IntegrationConditionAlwaysActiveReason --.
+	IntegrationConditionAlwaysActiveReason string = "AlwaysActive"
+	// IntegrationConditionModelineApplied reports the modeline options applied by the operator.
+	IntegrationConditionModelineApplied IntegrationConditionType = "ModelineApplied"
+	// IntegrationConditionModelineAppliedReason --.
+	IntegrationConditionModelineAppliedReason string = "ModelineApplied"
+	// IntegrationConditionModelineInvalidReason --.
+	IntegrationConditionModelineInvalidReason string = "ModelineInvalid"
+	// IntegrationConditionSecurityPolicy reports whether the risk categories of the Integration are admitted by the security policy.
+	IntegrationConditionSecurityPolicy IntegrationConditionType = "SecurityPolicy"
+	// IntegrationConditionSecurityPolicyAdmittedReason --.
+	IntegrationConditionSecurityPolicyAdmittedReason string = "Admitted"
+	// IntegrationConditionSecurityPolicyDeniedReason --.
+	IntegrationConditionSecurityPolicyDeniedReason string = "Denied"
+	// IntegrationConditionSecurityApprovalRequiredReason --.
+	IntegrationConditionSecurityApprovalRequiredReason string = "ApprovalRequired"
+	// IntegrationConditionRollout reports the progress of the delivery of a new version of the Integration.
+	IntegrationConditionRollout IntegrationConditionType = "Rollout"
+	// IntegrationConditionRolloutProgressingReason --.
+	IntegrationConditionRolloutProgressingReason string = "RolloutProgressing"
+	// IntegrationConditionRolloutPromotedReason --.
+	IntegrationConditionRolloutPromotedReason string = "RolloutPromoted"
+	// IntegrationConditionRolloutAbortedReason --.
+	IntegrationConditionRolloutAbortedReason string = "RolloutAborted"
+	// IntegrationConditionLogLevels reports whether the logger levels changed at runtime are applied to the Integration Pods.
+	IntegrationConditionLogLevels IntegrationConditionType = "LogLevels"
+	// IntegrationConditionLogLevelsAppliedReason --.
+	IntegrationConditionLogLevelsAppliedReason string = "LogLevelsApplied"
+	// IntegrationConditionLogLevelsFailedReason --.
+	IntegrationConditionLogLevelsFailedReason string = "LogLevelsFailed"
+	// IntegrationConditionCronJobExecutions reports whether the scheduled executions of an Integration running as a CronJob
+	// happen and succeed.
+	IntegrationConditionCronJobExecutions IntegrationConditionType = "CronJobExecutions"
+	// IntegrationConditionCronJobExecutionsSucceededReason --.
+	IntegrationConditionCronJobExecutionsSucceededReason string = "ExecutionsSucceeded"
+	// IntegrationConditionCronJobSuspendedReason --.
+	IntegrationConditionCronJobSuspendedReason string = "CronJobSuspended"
+	// IntegrationConditionCronJobMissedSchedulesReason --.
+	IntegrationConditionCronJobMissedSchedulesReason string = "MissedSchedules"
+	// IntegrationConditionCronJobConsecutiveFailuresReason --.
+	IntegrationConditionCronJobConsecutiveFailuresReason string = "ConsecutiveFailures"
 )
 
+// DependencyOriginType defines the kind of element requiring a dependency.
+type DependencyOriginType string
+
+const (
+	// DependencyOriginURI is used for dependencies required by an endpoint URI.
+	DependencyOriginURI DependencyOriginType = "uri"
+	// DependencyOriginRule is used for dependencies required by a source code pattern.
+	DependencyOriginRule DependencyOriginType = "rule"
+	// DependencyOriginLanguage is used for dependencies required by an expression language.
+	DependencyOriginLanguage DependencyOriginType = "language"
+	// DependencyOriginDSL is used for dependencies required by a DSL element, such as a bean or a data format.
+	DependencyOriginDSL DependencyOriginType = "dsl"
+	// DependencyOriginLoader is used for dependencies required to load a source.
+	DependencyOriginLoader DependencyOriginType = "loader"
+	// DependencyOriginKamelet is used for dependencies declared by a Kamelet.
+	DependencyOriginKamelet DependencyOriginType = "kamelet"
+	// DependencyOriginTrait is used for dependencies added by a trait.
+	DependencyOriginTrait DependencyOriginType = "trait"
+	// DependencyOriginCapability is used for dependencies required by a capability of the runtime catalog.
+	DependencyOriginCapability DependencyOriginType = "capability"
+	// DependencyOriginRuntime is used for dependencies required by the runtime.
+	DependencyOriginRuntime DependencyOriginType = "runtime"
+	// DependencyOriginSpec is used for dependencies declared in the Integration spec.
+	DependencyOriginSpec DependencyOriginType = "spec"
+	// DependencyOriginProfile is used for dependencies declared by the IntegrationProfile.
+	DependencyOriginProfile DependencyOriginType = "profile"
+	// DependencyOriginModeline is used for dependencies declared by a modeline option of a source.
+	DependencyOriginModeline DependencyOriginType = "modeline"
+)
+
+// DependencyOrigin describes why a dependency has been added to an Integration.
+type DependencyOrigin struct {
+	// the dependency
+	Dependency string `json:"dependency"`
+	// the kind of element requiring the dependency
+	Type DependencyOriginType `json:"type"`
+	// the name of the source requiring the dependency
+	Source string `json:"source,omitempty"`
+	// the line of the source requiring the dependency
+	Line int `json:"line,omitempty"`
+	// the element requiring the dependency, such as the URI, the rule, the Kamelet, the trait or the capability name
+	Detail string `json:"detail,omitempty"`
+}
+
+// ModelineOption is a modeline option declared in a source of the Integration.
+type ModelineOption struct {
+	// the name of the option, such as `trait` or `dependency`
+	Name string `json:"name"`
+	// the value of the option
+	Value string `json:"value,omitempty"`
+	// the name of the source declaring the option
+	Source string `json:"source,omitempty"`
+	// the line of the source declaring the option
+	Line int `json:"line,omitempty"`
+}
+
+// LogLevel is the level of a logger changed at runtime, without restarting the Integration.
+type LogLevel struct {
+	// the name of the logger, empty for the root logger
+	Logger string `json:"logger"`
+	// the level of the logger, such as `DEBUG` or `TRACE`
+	Level string `json:"level"`
+	// the time at which the level is reverted, if any
+	Expiration *metav1.Time `json:"expiration,omitempty"`
+	// the Pods the level is applied to
+	Pods []string `json:"pods,omitempty"`
+}
+
+// CronStatus reports the executions of an Integration running as a CronJob.
+type CronStatus struct {
+	// the last executions, the most recent first
+	Executions []CronExecution `json:"executions,omitempty"`
+	// the number of scheduled executions missed since the last one
+	MissedSchedules int32 `json:"missedSchedules,omitempty"`
+	// the number of executions that failed in a row
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+	// the time of the next scheduled execution, unless the CronJob is suspended
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+}
+
+// CronExecution is an execution of an Integration running as a CronJob.
+type CronExecution struct {
+	// the name of the Job running the execution
+	Job string `json:"job"`
+	// the name of the last Pod started by the Job, if any
+	Pod string `json:"pod,omitempty"`
+	// whether the execution has been triggered manually instead of by the schedule
+	Manual bool `json:"manual,omitempty"`
+	// the outcome of the execution
+	Outcome CronExecutionOutcome `json:"outcome"`
+	// the time at which the execution started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// the time at which the execution ended
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// CronExecutionOutcome is the outcome of an execution of an Integration running as a CronJob.
+// +kubebuilder:validation:Enum=Running;Succeeded;Failed
+type CronExecutionOutcome string
+
+const (
+	// CronExecutionOutcomeRunning is used when the execution is still running.
+	CronExecutionOutcomeRunning CronExecutionOutcome = "Running"
+	// CronExecutionOutcomeSucceeded is used when the execution completed successfully.
+	CronExecutionOutcomeSucceeded CronExecutionOutcome = "Succeeded"
+	// CronExecutionOutcomeFailed is used when the execution failed.
+	CronExecutionOutcomeFailed CronExecutionOutcome = "Failed"
+)
+
+// HealthStatus reports the health checks of the Camel runtime, aggregated across the Pods of an Integration.
+type HealthStatus struct {
+	// the health checks, grouped by the element they check
+	Checks []HealthCheckReport `json:"checks,omitempty"`
+	// the last transitions of the health checks, the most recent first
+	Transitions []HealthCheckTransition `json:"transitions,omitempty"`
+	// the ready Pods whose health checks could not be probed
+	ProbeFailures []PodHealthProbeFailure `json:"probeFailures,omitempty"`
+}
+
+// HealthCheckReport is a health check of the Camel runtime, as reported by the Pods of an Integration.
+type HealthCheckReport struct {
+	// the name of the health check, such as `route:route1`
+	Name string `json:"name"`
+	// the kind of element checked
+	Kind HealthCheckKind `json:"kind"`
+	// the identifier of the element checked, such as the route id or the component name
+	ID string `json:"id,omitempty"`
+	// the status of the check, `DOWN` when it is down in any of the Pods
+	Status HealthCheckStatus `json:"status"`
+	// the status of the check in each Pod
+	Pods []PodHealthCheck `json:"pods,omitempty"`
+}
+
+// PodHealthCheck is the result of a health check in a Pod of an Integration.
+type PodHealthCheck struct {
+	// the name of the Pod
+	Pod string `json:"pod"`
+	// the status of the check in the Pod
+	Status HealthCheckStatus `json:"status"`
+	// the reason why the check is down, if any
+	Message string `json:"message,omitempty"`
+	// the details reported by the check, when it is down
+	Data RawMessage `json:"data,omitempty"`
+}
+
+// PodHealthProbeFailure reports why the health checks of a Pod of an Integration could not be probed.
+type PodHealthProbeFailure struct {
+	// the name of the Pod
+	Pod string `json:"pod"`
+	// the error returned when probing the Pod
+	Message string `json:"message"`
+}
+
+// HealthCheckTransition is a change of the status of a health check in a Pod of an Integration.
+type HealthCheckTransition struct {
+	// the name of the health check
+	Check string `json:"check"`
+	// the name of the Pod
+	Pod string `json:"pod"`
+	// the status the check changed to
+	Status HealthCheckStatus `json:"status"`
+	// the reason why the check is down, if any
+	Message string `json:"message,omitempty"`
+	// the time at which the change has been observed
+	Time metav1.Time `json:"time"`
+}
+
+// HealthCheckKind is the kind of element checked by a health check of the Camel runtime.
+// +kubebuilder:validation:Enum=Context;Route;Consumer;Component;Other
+type HealthCheckKind string
+
+const (
+	// HealthCheckKindContext is used for the checks of the Camel context.
+	HealthCheckKindContext HealthCheckKind = "Context"
+	// HealthCheckKindRoute is used for the checks of a route.
+	HealthCheckKindRoute HealthCheckKind = "Route"
+	// HealthCheckKindConsumer is used for the checks of the consumer of a route.
+	HealthCheckKindConsumer HealthCheckKind = "Consumer"
+	// HealthCheckKindComponent is used for the checks of a component, such as its connectivity to a remote system.
+	HealthCheckKindComponent HealthCheckKind = "Component"
+	// HealthCheckKindOther is used for the checks not related to Camel.
+	HealthCheckKindOther HealthCheckKind = "Other"
+)
+
+// RolloutPhase is the phase of the delivery of a new version of an Integration.
+type RolloutPhase string
+
+const (
+	// RolloutPhaseProgressing is used when the traffic is being shifted to the new version.
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhasePromoted is used when the new version has replaced the previous one.
+	RolloutPhasePromoted RolloutPhase = "Promoted"
+	// RolloutPhaseAborted is used when the new version failed the analysis and the previous one was restored.
+	RolloutPhaseAborted RolloutPhase = "Aborted"
+)
+
+// RolloutStatus describes the progress of the delivery of a new version of an Integration.
+type RolloutStatus struct {
+	// the phase of the rollout
+	Phase RolloutPhase `json:"phase,omitempty"`
+	// the Integration digest of the stable version
+	StableDigest string `json:"stableDigest,omitempty"`
+	// the Integration digest of the version being delivered
+	CanaryDigest string `json:"canaryDigest,omitempty"`
+	// the container image of the stable version
+	StableImage string `json:"stableImage,omitempty"`
+	// the container image of the version being delivered
+	CanaryImage string `json:"canaryImage,omitempty"`
+	// the Knative revision of the stable version
+	StableRevision string `json:"stableRevision,omitempty"`
+	// the index of the current step
+	Step int32 `json:"step,omitempty"`
+	// the time the current step started
+	StepStartTime *metav1.Time `json:"stepStartTime,omitempty"`
+	// the percentage of the traffic shifted to the new version
+	Weight int32 `json:"weight,omitempty"`
+	// the time of the next analysis of the new version
+	NextAnalysisTime *metav1.Time `json:"nextAnalysisTime,omitempty"`
+	// a human-readable message about the last analysis
+	Message string `json:"message,omitempty"`
+}
+
 // IntegrationCondition describes the state of a resource at a certain point.
 type IntegrationCondition struct {
 	// Type of integration condition.
@@ -75,9 +75,11 @@ func (in *Integration) Initialize() {
 	if in.Spec.Profile != "" {
 		profile = in.Spec.Profile
 	}
+	// the rollout is kept across the rebuilds, in order to deliver the new version against the stable one
 	in.Status = IntegrationStatus{
 		Phase:   IntegrationPhaseInitialization,
 		Profile: profile,
+		Rollout: in.Status.Rollout,
 	}
 }
 
@@ -367,6 +369,40 @@ func (in *Integration) IsSynthetic() bool {
 	return in.Annotations[IntegrationSyntheticLabel] == "true"
 }
 
+// GetLogLevels returns the logger levels to change at runtime, as listed in the log-levels annotation.
+func (in *Integration) GetLogLevels() ([]LogLevel, error) {
+	value, ok := in.Annotations[IntegrationLogLevelsAnnotation]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var levels []LogLevel
+	if err := json.Unmarshal([]byte(value), &levels); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", IntegrationLogLevelsAnnotation, err)
+	}
+
+	return levels, nil
+}
+
+// SetLogLevels lists the logger levels to change at runtime in the log-levels annotation,
+// removing the annotation when no level is given.
+func (in *Integration) SetLogLevels(levels []LogLevel) error {
+	if len(levels) == 0 {
+		delete(in.Annotations, IntegrationLogLevelsAnnotation)
+
+		return nil
+	}
+	value, err := json.Marshal(levels)
+	if err != nil {
+		return err
+	}
+	if in.Annotations == nil {
+		in.Annotations = make(map[string]string)
+	}
+	in.Annotations[IntegrationLogLevelsAnnotation] = string(value)
+
+	return nil
+}
+
 // SetBuildCompletePhase set the proper building phase and the related timestamps.
 func (in *Integration) SetBuildCompletePhase() {
 	now := metav1.Now().Rfc3339Copy()
@@ -154,3 +154,39 @@ func TestReadWriteYaml(t *testing.T) {
 	assert.NotNil(t, data)
 	assert.Equal(t, yaml, string(data))
 }
+
+func TestInitializeKeepsRollout(t *testing.T) {
+	it := NewIntegration("ns", "my-it")
+	it.Status.Phase = IntegrationPhaseRunning
+	it.Status.Image = "my-image:v1"
+	it.Status.Rollout = &RolloutStatus{
+		Phase:       RolloutPhasePromoted,
+		StableImage: "my-image:v1",
+	}
+
+	it.Initialize()
+
+	assert.Equal(t, IntegrationPhaseInitialization, it.Status.Phase)
+	assert.Empty(t, it.Status.Image)
+	assert.Equal(t, "my-image:v1", it.Status.Rollout.StableImage)
+}
+
+func TestLogLevels(t *testing.T) {
+	it := NewIntegration("ns", "my-it")
+	levels, err := it.GetLogLevels()
+	require.NoError(t, err)
+	assert.Empty(t, levels)
+
+	require.NoError(t, it.SetLogLevels([]LogLevel{{Logger: "org.apache.camel", Level: "DEBUG"}}))
+	assert.JSONEq(t, `[{"logger":"org.apache.camel","level":"DEBUG"}]`, it.Annotations[IntegrationLogLevelsAnnotation])
+	levels, err = it.GetLogLevels()
+	require.NoError(t, err)
+	assert.Equal(t, []LogLevel{{Logger: "org.apache.camel", Level: "DEBUG"}}, levels)
+
+	require.NoError(t, it.SetLogLevels(nil))
+	assert.NotContains(t, it.Annotations, IntegrationLogLevelsAnnotation)
+
+	it.Annotations[IntegrationLogLevelsAnnotation] = "org.apache.camel=DEBUG"
+	_, err = it.GetLogLevels()
+	require.Error(t, err)
+}
@@ -37,6 +37,8 @@ type IntegrationProfileSpec struct {
 	//
 	// Deprecated: to be removed in future versions.
 	Kamelet IntegrationProfileKameletSpec `json:"kamelet,omitempty"`
+	// the policy applied to the Integrations using security sensitive components
+	Security *SecurityPolicySpec `json:"security,omitempty"`
 }
 
 // IntegrationProfileStatus defines the observed state of IntegrationProfile.
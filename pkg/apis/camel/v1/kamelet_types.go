@@ -36,6 +36,12 @@ const (
 	KameletGroupLabel = "camel.apache.org/kamelet.group"
 	// KameletDataTypeLabel label used to override the default Kamelet action data type.
 	KameletDataTypeLabel = "camel.apache.org/kamelet.data.type"
+	// KameletProviderAnnotation annotation used to identify the Kamelet provider.
+	KameletProviderAnnotation = "camel.apache.org/provider"
+	// KameletSupportLevelAnnotation annotation used to identify the Kamelet support level.
+	KameletSupportLevelAnnotation = "camel.apache.org/kamelet.support.level"
+	// KameletCatalogVersionAnnotation annotation used to identify the catalog version the Kamelet belongs to.
+	KameletCatalogVersionAnnotation = "camel.apache.org/catalog.version"
 
 	// KameletTypeSink type Sink.
 	KameletTypeSink = "sink"
@@ -67,6 +73,8 @@ var (
 // +kubebuilder:printcolumn:name="Provider",type=string,JSONPath=`.metadata.annotations.camel\.apache\.org\/provider`,description="The Kamelet provider"
 // +kubebuilder:printcolumn:name="Bundled",type=string,JSONPath=`.metadata.labels.camel\.apache\.org\/kamelet\.bundled`,description="The Kamelet bundled"
 // +kubebuilder:printcolumn:name="Camel Version",type=string,JSONPath=`.metadata.annotations.camel\.apache\.org\/catalog\.version`,description="The Camel compatible version"
+// +kubebuilder:printcolumn:name="Deprecated",type=string,JSONPath=`.spec.deprecation.message`,description="The Kamelet deprecation notice",priority=1
+// +kubebuilder:printcolumn:name="Usage",type=integer,JSONPath=`.status.usageCount`,description="The number of Integrations using the Kamelet"
 
 // Kamelet is the Schema for the kamelets API.
 type Kamelet struct {
@@ -77,7 +85,6 @@ type Kamelet struct {
 	Spec KameletSpec `json:"spec,omitempty"`
 	// the actual status of the resource
 	// +kubebuilder:default:={phase:"Ready"}
-	// Deprecated no longer in use
 	Status KameletStatus `json:"status,omitempty"`
 }
 
@@ -105,6 +112,20 @@ type KameletSpecBase struct {
 	DataTypes map[TypeSlot]DataTypesSpec `json:"dataTypes,omitempty"`
 	// Camel dependencies needed by the Kamelet
 	Dependencies []string `json:"dependencies,omitempty"`
+	// the deprecation notice, if the Kamelet is about to be retired
+	Deprecation *KameletDeprecation `json:"deprecation,omitempty"`
+}
+
+// KameletDeprecation describes the retirement plan of a deprecated Kamelet.
+type KameletDeprecation struct {
+	// a human readable message explaining why the Kamelet is deprecated
+	Message string `json:"message,omitempty"`
+	// the name of the Kamelet replacing this one (if any)
+	Replacement string `json:"replacement,omitempty"`
+	// the version of the replacement Kamelet to use (if any)
+	ReplacementVersion string `json:"replacementVersion,omitempty"`
+	// the date when the Kamelet is expected to be removed (ie, 2027-01-31)
+	RemovalDate string `json:"removalDate,omitempty"`
 }
 
 // Template is an unstructured object representing a Kamelet template in YAML/JSON DSL.
@@ -189,6 +210,18 @@ type KameletStatus struct {
 	Conditions []KameletCondition `json:"conditions,omitempty"`
 	// Properties --
 	Properties []KameletProperty `json:"properties,omitempty"`
+	// the number of Integrations currently using the Kamelet
+	UsageCount int `json:"usageCount,omitempty"`
+	// the Integrations currently using the Kamelet
+	Integrations []KameletUsage `json:"integrations,omitempty"`
+}
+
+// KameletUsage references an Integration using a Kamelet.
+type KameletUsage struct {
+	// the namespace of the Integration
+	Namespace string `json:"namespace"`
+	// the name of the Integration
+	Name string `json:"name"`
 }
 
 // KameletProperty specify the behavior of a property in a Kamelet.
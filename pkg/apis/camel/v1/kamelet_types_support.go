@@ -21,6 +21,7 @@ package v1
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -184,6 +185,40 @@ func (k *Kamelet) IsBundled() bool {
 	return k.Labels != nil && k.Labels[KameletBundledLabel] == "true"
 }
 
+// IsDeprecated returns true if the Kamelet specification carries a deprecation notice.
+func (k *Kamelet) IsDeprecated() bool {
+	return k.Spec.Deprecation != nil
+}
+
+// DeprecationMessage returns a human readable notice about the Kamelet deprecation, including the replacement and
+// the removal date when available. It returns an empty string if the Kamelet is not deprecated.
+func (k *Kamelet) DeprecationMessage() string {
+	if !k.IsDeprecated() {
+		return ""
+	}
+	d := k.Spec.Deprecation
+	message := fmt.Sprintf("Kamelet %s is deprecated", k.Name)
+	if d.Message != "" {
+		message += ": " + d.Message
+	}
+	var notes []string
+	if d.Replacement != "" {
+		replacement := d.Replacement
+		if d.ReplacementVersion != "" {
+			replacement += " (version " + d.ReplacementVersion + ")"
+		}
+		notes = append(notes, "use "+replacement+" instead")
+	}
+	if d.RemovalDate != "" {
+		notes = append(notes, "it will be removed on "+d.RemovalDate)
+	}
+	if len(notes) > 0 {
+		message += " (" + strings.Join(notes, ", ") + ")"
+	}
+
+	return message
+}
+
 func ValidKameletName(name string) bool {
 	return !reservedKameletNames[name]
 }
@@ -214,6 +249,7 @@ func NewKameletList() KameletList {
 
 // CloneWithVersion clones a Kamelet and set the main specification with any version provided.
 // It also changes the name adding a suffix with the version provided.
+// A deprecation set on the main specification applies to any version which does not declare its own.
 func (k *Kamelet) CloneWithVersion(version string) (*Kamelet, error) {
 	clone := k.DeepCopy()
 	if version != "" {
@@ -221,7 +257,11 @@ func (k *Kamelet) CloneWithVersion(version string) (*Kamelet, error) {
 		if !ok {
 			return nil, fmt.Errorf("could not find version %s for Kamelet %s/%s", version, k.Namespace, k.Name)
 		}
-		clone.Spec.KameletSpecBase = kameletVersionSpec
+		deprecation := clone.Spec.Deprecation
+		clone.Spec.KameletSpecBase = *kameletVersionSpec.DeepCopy()
+		if clone.Spec.Deprecation == nil {
+			clone.Spec.Deprecation = deprecation
+		}
 	}
 	// Remove any existing version
 	clone.Spec.Versions = nil
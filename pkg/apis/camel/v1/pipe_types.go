@@ -138,6 +138,8 @@ type PipeConditionType string
 const (
 	// PipeConditionReady --.
 	PipeConditionReady PipeConditionType = "Ready"
+	// PipeConditionKameletsDeprecated used to warn that the Pipe resolves deprecated Kamelets.
+	PipeConditionKameletsDeprecated PipeConditionType = "KameletsDeprecated"
 	// PipeIntegrationConditionError -- .
 	//
 	// Deprecated: no longer in use.
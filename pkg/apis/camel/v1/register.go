@@ -58,6 +58,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&KameletList{},
 		&Pipe{},
 		&PipeList{},
+		&ExternalTrait{},
+		&ExternalTraitList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 
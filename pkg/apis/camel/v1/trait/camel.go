@@ -34,4 +34,8 @@ type CamelTrait struct {
 	RuntimeVersion string `json:"runtimeVersion,omitempty" property:"runtime-version"`
 	// A list of properties to be provided to the Integration runtime
 	Properties []string `json:"properties,omitempty" property:"properties"`
+	// Move the Integration to an error phase when any property placeholder used in the sources can't be resolved
+	// from the configuration provided (default `false`). The unresolved properties are reported in the
+	// `PropertiesResolved` condition regardless of this setting.
+	FailOnUnresolvedProperties *bool `json:"failOnUnresolvedProperties,omitempty" property:"fail-on-unresolved-properties"`
 }
@@ -36,6 +36,10 @@ package trait
 //
 //     `cron:tab?schedule=0/2 * * * ?` or `quartz:trigger?cron=0 0/2 * * * ?`.
 //
+// The operator reports the last executions of the CronJob in the Integration status, along with the count of missed schedules and of
+// consecutive failures. The `kamel cron` command shows them, triggers an execution regardless of the schedule and suspends or resumes
+// the scheduled executions.
+//
 // +camel-k:trait=cron.
 //
 //nolint:godoclint
@@ -76,4 +80,6 @@ type CronTrait struct {
 	// Specifies the number of retries before marking the job failed.
 	// It defaults to 2.
 	BackoffLimit *int32 `json:"backoffLimit,omitempty" property:"backoff-limit"`
+	// Suspends the subsequent executions of the CronJob, the ones already started are not affected (default `false`).
+	Suspend *bool `json:"suspend,omitempty" property:"suspend"`
 }
@@ -26,4 +26,7 @@ package trait
 //nolint:godoclint
 type DependenciesTrait struct {
 	PlatformBaseTrait `json:",inline" property:",squash"`
+	// Record the origin of each dependency (the source and line, URI, rule, Kamelet, trait or capability requiring it)
+	// in the Integration status.
+	Provenance *bool `json:"provenance,omitempty" property:"provenance"`
 }
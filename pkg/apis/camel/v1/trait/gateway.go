@@ -21,6 +21,8 @@ package trait
 // to the outside world with a Kubernetes Gateway API. The trait is in charge to automatically discover associate the
 // Integration Service generated with a Gateway and an HTTPRoute resource (HTTP/HTTPS protocol only supported).
 //
+// When the Integration has an OpenAPI source, the HTTPRoute only matches the paths declared by the OpenAPI document.
+//
 // NOTE: if any other protocol is required, please create a request in order to develop it.
 //
 // +camel-k:trait=gateway.
@@ -0,0 +1,55 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The HPA trait creates a Kubernetes HorizontalPodAutoscaler, scaling the Integration between a minimum and a maximum
+// number of replicas according to the CPU and memory utilization of its pods, and to the Camel metrics of its routes.
+//
+// The Camel metrics targets require the metrics of the Integration pods to be scraped through the `PodMonitor` created
+// by the `prometheus` trait, and to be served by the Kubernetes custom metrics API, e.g. with the Prometheus Adapter,
+// as the `camel_exchanges_inflight` and `camel_exchanges_per_second` pods metrics.
+//
+// The HorizontalPodAutoscaler scales the Integration, or the Pipe owning it, through its `scale` subresource, and takes
+// over the `replicas` field of its specification. It cannot be combined with the `keda` trait, and isn't supported with
+// the `knative-service`, `cron-job` and `job` controller strategies.
+//
+// +camel-k:trait=hpa.
+//
+//nolint:godoclint
+type HPATrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// The minimum number of replicas (default `1`).
+	MinReplicas *int32 `json:"minReplicas,omitempty" property:"min-replicas"`
+	// The maximum number of replicas. It is required.
+	MaxReplicas *int32 `json:"maxReplicas,omitempty" property:"max-replicas"`
+	// The target average CPU utilization of the pods, as a percentage of the requested CPU
+	// (default `80` when no other target is set).
+	CPUUtilization *int32 `json:"cpuUtilization,omitempty" property:"cpu-utilization"`
+	// The target average memory utilization of the pods, as a percentage of the requested memory.
+	MemoryUtilization *int32 `json:"memoryUtilization,omitempty" property:"memory-utilization"`
+	// The target average number of inflight exchanges per pod (e.g. `10`).
+	InflightExchanges string `json:"inflightExchanges,omitempty" property:"inflight-exchanges"`
+	// The target average number of exchanges processed per second by each pod (e.g. `100`, or `500m`).
+	ExchangeRate string `json:"exchangeRate,omitempty" property:"exchange-rate"`
+	// The ids of the routes whose Camel metrics are targeted, each route being measured on its own.
+	// The metrics of all the routes are aggregated by default.
+	Routes []string `json:"routes,omitempty" property:"routes"`
+	// The number of seconds the recommendations are looked back at before scaling down (default `300`).
+	ScaleDownStabilizationWindowSeconds *int32 `json:"scaleDownStabilizationWindowSeconds,omitempty" property:"scale-down-stabilization-window-seconds"`
+}
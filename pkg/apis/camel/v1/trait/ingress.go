@@ -24,6 +24,9 @@ import networkingv1 "k8s.io/api/networking/v1"
 //
 // It's enabled by default whenever a Service is added to the integration (through the `service` trait).
 //
+// When the integration has an OpenAPI source, and no path is configured, the ingress only exposes the paths
+// declared by the OpenAPI document.
+//
 // +camel-k:trait=ingress.
 //
 //nolint:godoclint
@@ -0,0 +1,51 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Job trait runs the Integration once, to completion, as a Kubernetes Job instead of a Deployment.
+//
+// It is meant for batch Integrations, such as data migrations, that must not be restarted once their work is done.
+// The Camel application is stopped after the configured number of messages has been processed, or when it has been
+// idle for a while, and the Integration phase reflects the outcome of the Job (`Succeeded` or `Failed`).
+//
+// A new Job is created whenever the Integration is changed.
+//
+// +camel-k:trait=job.
+//
+//nolint:godoclint
+type JobTrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// Stop the Camel application once the given number of messages has been processed.
+	MaxMessages *int32 `json:"maxMessages,omitempty" property:"max-messages"`
+	// Stop the Camel application once it has been idle, with no message processed, for the given number of seconds.
+	// It defaults to 60s when `max-messages` is not set.
+	MaxIdleSeconds *int32 `json:"maxIdleSeconds,omitempty" property:"max-idle-seconds"`
+	// Specifies the number of retries before marking the Job failed (default `0`).
+	BackoffLimit *int32 `json:"backoffLimit,omitempty" property:"backoff-limit"`
+	// The number of Pods that must run to completion for the Job to succeed (default `1`).
+	Completions *int32 `json:"completions,omitempty" property:"completions"`
+	// The maximum number of Pods running in parallel (default `1`).
+	Parallelism *int32 `json:"parallelism,omitempty" property:"parallelism"`
+	// Specifies the duration in seconds, relative to the start time, that the Job
+	// may be continuously active before it is considered to be failed.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty" property:"active-deadline-seconds"`
+	// The number of seconds after which a finished Job is deleted, together with its Pods.
+	// The Job is kept by default.
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty" property:"ttl-seconds-after-finished"`
+}
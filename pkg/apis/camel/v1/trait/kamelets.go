@@ -31,4 +31,6 @@ type KameletsTrait struct {
 	List string `json:"list,omitempty" property:"list"`
 	// The directory where the application mounts and reads Kamelet spec (default `/etc/camel/kamelets`)
 	MountPoint string `json:"mountPoint,omitempty" property:"mount-point"`
+	// Share the Kamelets bundle ConfigMaps with any other Integration of the namespace using the same Kamelets (disabled by default)
+	SharedBundle *bool `json:"sharedBundle,omitempty" property:"shared-bundle"`
 }
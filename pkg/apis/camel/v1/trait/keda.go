@@ -39,7 +39,8 @@ type KedaTrait struct {
 	// to the name of a KEDA autoscaler and a key/value map named `metadata` containing specific trigger options
 	// and optionally a mapping of secrets, used by Keda operator to poll resources according to the autoscaler type.
 	Triggers []KedaTrigger `json:"triggers,omitempty" property:"triggers"`
-	// Automatically discover KEDA triggers from Camel component URIs.
+	// Automatically discover KEDA triggers from Camel component URIs. Supported consumers are `kafka`, `spring-rabbitmq`,
+	// `aws2-sqs`, `azure-servicebus`, `spring-redis`, `nats` (with JetStream enabled), `cron` and `quartz`.
 	// +kubebuilder:validation:Optional
 	Auto *bool `json:"auto,omitempty" property:"auto"`
 	// Additional metadata to merge into auto-discovered triggers. Keys are trigger types (e.g., "kafka"),
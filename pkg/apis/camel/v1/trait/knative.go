@@ -22,6 +22,9 @@ package trait
 //
 // The Camel Knative component will then use the full configuration to configure the routes.
 //
+// The sources and sinks not set explicitly are discovered from the endpoints of the routes, including the dead letter
+// channels of their error handlers.
+//
 // WARNING: The Knative trait is **deprecated** and will be removed in future release versions:
 // use Camel (Quarkus) Knative component instead.
 //
@@ -39,6 +39,10 @@ type MountTrait struct {
 	// You can use the syntax [pvcname:/container/path:size:accessMode<:storageClass>] to create a dynamic PVC based on the Storage Class provided
 	// or the default cluster Storage Class. However, if the PVC exists, the operator would mount it.
 	Volumes []string `json:"volumes,omitempty" property:"volumes"`
+	// A list of Persistent Volume Claim templates, provisioning a dedicated volume for each replica of the Integration.
+	// Only available with the `statefulset` controller strategy.
+	// Syntax: name:/container/path:size[:accessMode[:storageClass]] (access mode defaults to ReadWriteOnce)
+	VolumeClaimTemplates []string `json:"volumeClaimTemplates,omitempty" property:"volume-claim-templates"`
 	// A list of EmptyDir volumes to be mounted. An optional size limit may be configured (default 500Mi).
 	// Syntax: name:/container/path[:sizeLimit]
 	EmptyDirs []string `json:"emptyDirs,omitempty" property:"empty-dirs"`
@@ -0,0 +1,50 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The NetworkPolicy trait creates a Kubernetes NetworkPolicy allowing only the traffic the Integration needs,
+// so that it can run in namespaces denying any traffic by default.
+//
+// The ingress traffic is allowed from the pods of the cluster to the ports exposed by the Integration container,
+// such as the HTTP port of the `service` and `knative-service` traits, the metrics port scraped through the `prometheus`
+// trait and the ports of the health probes. The egress traffic is derived from the endpoints of the Integration routes:
+// the Kubernetes Services they target, Kafka bootstrap servers included, are resolved into the pods backing them,
+// while the endpoints of external hosts are only restricted by port. The DNS resolution is allowed as well.
+//
+// In audit mode, the NetworkPolicy is not created, and the traffic it would allow is reported in the
+// `NetworkPolicyAvailable` Integration condition: any other traffic would be blocked.
+//
+// +camel-k:trait=network-policy.
+//
+//nolint:godoclint
+type NetworkPolicyTrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// Only report the traffic the NetworkPolicy would allow, without creating it (default `false`).
+	Audit *bool `json:"audit,omitempty" property:"audit"`
+	// Allow the DNS resolution, on port 53 (default `true`).
+	DNS *bool `json:"dns,omitempty" property:"dns"`
+	// Additional ports allowed from the pods of the cluster, in the form `port[/protocol]` (e.g. `8443`).
+	IngressPorts []string `json:"ingressPorts,omitempty" property:"ingress-ports"`
+	// Additional CIDRs allowed to reach the ingress ports of the Integration (e.g. `10.0.0.0/16`).
+	IngressCIDRs []string `json:"ingressCIDRs,omitempty" property:"ingress-cidrs"`
+	// Additional ports the Integration is allowed to reach on any host, in the form `port[/protocol]` (e.g. `5432`).
+	EgressPorts []string `json:"egressPorts,omitempty" property:"egress-ports"`
+	// Additional CIDRs the Integration is allowed to reach on any port (e.g. `192.168.1.0/24`).
+	EgressCIDRs []string `json:"egressCIDRs,omitempty" property:"egress-cidrs"`
+}
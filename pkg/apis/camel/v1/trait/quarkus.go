@@ -48,6 +48,10 @@ type QuarkusTrait struct {
 	NativeBaseImage string `json:"nativeBaseImage,omitempty" property:"native-base-image"`
 	// The image containing the tooling required for a native build (by default it will use the one provided in the runtime catalog)
 	NativeBuilderImage string `json:"nativeBuilderImage,omitempty" property:"native-builder-image"`
+	// Trains a JDK Application Class Data Sharing (AppCDS) archive when building a `jvm` kit, and layers it into the image,
+	// so that the Integration starts faster (default `false`). The archive is skipped when the JDK of the base image
+	// does not match the one of the builder, and the JVM ignores it at runtime when it does not match its classpath.
+	AppCDS *bool `json:"appCDS,omitempty" property:"app-cds"`
 }
 
 // QuarkusMode is the type of Quarkus build packaging.
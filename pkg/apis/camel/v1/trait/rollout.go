@@ -0,0 +1,73 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Rollout trait progressively delivers a new version of the Integration, keeping the previous and the new container
+// image side by side while the traffic is shifted in steps from the former to the latter.
+//
+// The HTTP traffic is shifted by weighting the backends of the HTTPRoute created by the `gateway` trait, or
+// the traffic targets of the Knative Service. Message consumers, and any other kind of Integration, are shifted by
+// distributing the replicas between both versions.
+//
+// At the end of each step the new version is analysed through the Camel exchanges metrics scraped from its pods:
+// the rollout moves to the next step when the pods of the new version are ready, have processed enough exchanges,
+// and the exchanges failure rate and mean processing time are within the configured thresholds. The analysis is held
+// while the pods are not ready or the exchanges are too few, and the rollout is aborted, restoring the previous
+// version, when the thresholds are exceeded or the step lasts longer than the progress deadline. The new version is
+// promoted once the last step is successfully analysed. The progress is reported in the `Rollout` Integration condition.
+//
+// NOTE: the rollout is triggered by a change of the Integration digest, that is of its specification or of the
+// configuration it mounts. Each version mounts its own copy of the ConfigMaps of the Integration.
+//
+// +camel-k:trait=rollout.
+//
+//nolint:godoclint
+type RolloutTrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// The rollout strategy, either `canary` (default) to shift the traffic in several steps,
+	// or `blue-green` to shift the whole traffic at once while keeping the previous version available until the analysis.
+	Strategy RolloutStrategy `json:"strategy,omitempty" property:"strategy"`
+	// The percentages of the traffic shifted to the new version at each step (default `10,25,50` for `canary`, `100` for `blue-green`).
+	Steps []int32 `json:"steps,omitempty" property:"steps"`
+	// The duration of each step, at the end of which the new version is analysed (default `1m`).
+	StepDuration string `json:"stepDuration,omitempty" property:"step-duration"`
+	// The maximum percentage of failed exchanges of the new version (default `5`).
+	MaxFailureRate *int32 `json:"maxFailureRate,omitempty" property:"max-failure-rate"`
+	// The minimum number of exchanges processed by the new version before it is analysed (default `10`).
+	MinExchanges *int32 `json:"minExchanges,omitempty" property:"min-exchanges"`
+	// The maximum duration of a step, including the time the analysis is held, after which the rollout is aborted (default `10m`).
+	ProgressDeadline string `json:"progressDeadline,omitempty" property:"progress-deadline"`
+	// The maximum mean processing time of the exchanges of the new version, in milliseconds (not verified by default).
+	MaxLatency *int64 `json:"maxLatency,omitempty" property:"max-latency"`
+	// The port of the metrics endpoint of the Integration pods (default `8080`).
+	MetricsPort *int32 `json:"metricsPort,omitempty" property:"metrics-port"`
+	// The path of the metrics endpoint of the Integration pods (default `/q/metrics`).
+	MetricsPath string `json:"metricsPath,omitempty" property:"metrics-path"`
+}
+
+// RolloutStrategy is the strategy used to shift the traffic to the new version of an Integration.
+// +kubebuilder:validation:Enum=canary;blue-green
+type RolloutStrategy string
+
+const (
+	// RolloutStrategyCanary shifts the traffic in several steps.
+	RolloutStrategyCanary RolloutStrategy = "canary"
+	// RolloutStrategyBlueGreen shifts the whole traffic at once.
+	RolloutStrategyBlueGreen RolloutStrategy = "blue-green"
+)
@@ -29,6 +29,9 @@ package trait
 // following parameters to reference them: `tls-certificate-secret`, `tls-key-secret`, `tls-ca-certificate-secret`, `tls-destination-ca-certificate-secret`
 // See the examples section at the end of this page to see the setup options.
 //
+// When the integration has an OpenAPI source, the route named after the integration service exposes the first path declared
+// by the OpenAPI document, and a route, suffixed by the index of the path, is created for each of the other paths.
+//
 // +camel-k:trait=route.
 // +camel-k:deprecated=2.6.0.
 //
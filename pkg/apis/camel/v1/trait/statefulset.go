@@ -0,0 +1,46 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// The StatefulSet trait runs the Integration as a Kubernetes StatefulSet instead of a Deployment, giving each replica
+// a stable network identity and its own storage, which survive the restarts and the updates of the Integration.
+//
+// It is meant for Integrations keeping a local state, such as file based idempotent or aggregation repositories,
+// or Kafka Streams state stores. The per-replica volumes are declared with the `volume-claim-templates` property
+// of the mount trait, and the replicas are reachable through a headless Service.
+//
+// +camel-k:trait=statefulset.
+//
+//nolint:godoclint
+type StatefulSetTrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// The policy used to create and delete the pods, either `OrderedReady` (default) or `Parallel`.
+	// +kubebuilder:validation:Enum=OrderedReady;Parallel
+	PodManagementPolicy appsv1.PodManagementPolicyType `json:"podManagementPolicy,omitempty" property:"pod-management-policy"`
+	// The strategy used to replace the existing pods with new ones, either `RollingUpdate` (default) or `OnDelete`.
+	// +kubebuilder:validation:Enum=RollingUpdate;OnDelete
+	Strategy appsv1.StatefulSetUpdateStrategyType `json:"strategy,omitempty" property:"strategy"`
+	// Whether the Persistent Volume Claims created from the templates are deleted when the Integration is deleted
+	// (default `false`).
+	DeleteClaims *bool `json:"deleteClaims,omitempty" property:"delete-claims"`
+}
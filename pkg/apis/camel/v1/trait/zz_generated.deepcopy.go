@@ -170,6 +170,11 @@ func (in *CamelTrait) DeepCopyInto(out *CamelTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.FailOnUnresolvedProperties != nil {
+		in, out := &in.FailOnUnresolvedProperties, &out.FailOnUnresolvedProperties
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelTrait.
@@ -292,6 +297,11 @@ func (in *CronTrait) DeepCopyInto(out *CronTrait) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronTrait.
@@ -308,6 +318,11 @@ func (in *CronTrait) DeepCopy() *CronTrait {
 func (in *DependenciesTrait) DeepCopyInto(out *DependenciesTrait) {
 	*out = *in
 	in.PlatformBaseTrait.DeepCopyInto(&out.PlatformBaseTrait)
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependenciesTrait.
@@ -482,6 +497,52 @@ func (in *GitOpsTrait) DeepCopy() *GitOpsTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HPATrait) DeepCopyInto(out *HPATrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CPUUtilization != nil {
+		in, out := &in.CPUUtilization, &out.CPUUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MemoryUtilization != nil {
+		in, out := &in.MemoryUtilization, &out.MemoryUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScaleDownStabilizationWindowSeconds != nil {
+		in, out := &in.ScaleDownStabilizationWindowSeconds, &out.ScaleDownStabilizationWindowSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPATrait.
+func (in *HPATrait) DeepCopy() *HPATrait {
+	if in == nil {
+		return nil
+	}
+	out := new(HPATrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthTrait) DeepCopyInto(out *HealthTrait) {
 	*out = *in
@@ -654,6 +715,57 @@ func (in *JVMTrait) DeepCopy() *JVMTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTrait) DeepCopyInto(out *JobTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.MaxMessages != nil {
+		in, out := &in.MaxMessages, &out.MaxMessages
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxIdleSeconds != nil {
+		in, out := &in.MaxIdleSeconds, &out.MaxIdleSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Completions != nil {
+		in, out := &in.Completions, &out.Completions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTrait.
+func (in *JobTrait) DeepCopy() *JobTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JolokiaTrait) DeepCopyInto(out *JolokiaTrait) {
 	*out = *in
@@ -729,6 +841,11 @@ func (in *KameletsTrait) DeepCopyInto(out *KameletsTrait) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SharedBundle != nil {
+		in, out := &in.SharedBundle, &out.SharedBundle
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KameletsTrait.
@@ -1087,6 +1204,11 @@ func (in *MountTrait) DeepCopyInto(out *MountTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.VolumeClaimTemplates != nil {
+		in, out := &in.VolumeClaimTemplates, &out.VolumeClaimTemplates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.EmptyDirs != nil {
 		in, out := &in.EmptyDirs, &out.EmptyDirs
 		*out = make([]string, len(*in))
@@ -1114,6 +1236,52 @@ func (in *MountTrait) DeepCopy() *MountTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyTrait) DeepCopyInto(out *NetworkPolicyTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IngressPorts != nil {
+		in, out := &in.IngressPorts, &out.IngressPorts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IngressCIDRs != nil {
+		in, out := &in.IngressCIDRs, &out.IngressCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressPorts != nil {
+		in, out := &in.EgressPorts, &out.EgressPorts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressCIDRs != nil {
+		in, out := &in.EgressCIDRs, &out.EgressCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyTrait.
+func (in *NetworkPolicyTrait) DeepCopy() *NetworkPolicyTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenAPITrait) DeepCopyInto(out *OpenAPITrait) {
 	*out = *in
@@ -1315,6 +1483,11 @@ func (in *QuarkusTrait) DeepCopyInto(out *QuarkusTrait) {
 		*out = make([]QuarkusMode, len(*in))
 		copy(*out, *in)
 	}
+	if in.AppCDS != nil {
+		in, out := &in.AppCDS, &out.AppCDS
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuarkusTrait.
@@ -1362,6 +1535,47 @@ func (in *RegistryTrait) DeepCopy() *RegistryTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutTrait) DeepCopyInto(out *RolloutTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxFailureRate != nil {
+		in, out := &in.MaxFailureRate, &out.MaxFailureRate
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinExchanges != nil {
+		in, out := &in.MinExchanges, &out.MinExchanges
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxLatency != nil {
+		in, out := &in.MaxLatency, &out.MaxLatency
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MetricsPort != nil {
+		in, out := &in.MetricsPort, &out.MetricsPort
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutTrait.
+func (in *RolloutTrait) DeepCopy() *RolloutTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTrait) DeepCopyInto(out *RouteTrait) {
 	*out = *in
@@ -1482,6 +1696,27 @@ func (in *ServiceTrait) DeepCopy() *ServiceTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatefulSetTrait) DeepCopyInto(out *StatefulSetTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.DeleteClaims != nil {
+		in, out := &in.DeleteClaims, &out.DeleteClaims
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatefulSetTrait.
+func (in *StatefulSetTrait) DeepCopy() *StatefulSetTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(StatefulSetTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TelemetryTrait) DeepCopyInto(out *TelemetryTrait) {
 	*out = *in
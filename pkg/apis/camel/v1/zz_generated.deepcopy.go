@@ -709,6 +709,55 @@ func (in *Container) DeepCopy() *Container {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronExecution) DeepCopyInto(out *CronExecution) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronExecution.
+func (in *CronExecution) DeepCopy() *CronExecution {
+	if in == nil {
+		return nil
+	}
+	out := new(CronExecution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronStatus) DeepCopyInto(out *CronStatus) {
+	*out = *in
+	if in.Executions != nil {
+		in, out := &in.Executions, &out.Executions
+		*out = make([]CronExecution, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronStatus.
+func (in *CronStatus) DeepCopy() *CronStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CronStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataSpec) DeepCopyInto(out *DataSpec) {
 	*out = *in
@@ -805,6 +854,21 @@ func (in *DataTypesSpec) DeepCopy() *DataTypesSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DependencyOrigin) DeepCopyInto(out *DependencyOrigin) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependencyOrigin.
+func (in *DependencyOrigin) DeepCopy() *DependencyOrigin {
+	if in == nil {
+		return nil
+	}
+	out := new(DependencyOrigin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Endpoint) DeepCopyInto(out *Endpoint) {
 	*out = *in
@@ -995,6 +1059,140 @@ func (in *ExternalDocumentation) DeepCopy() *ExternalDocumentation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTrait) DeepCopyInto(out *ExternalTrait) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTrait.
+func (in *ExternalTrait) DeepCopy() *ExternalTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalTrait) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTraitList) DeepCopyInto(out *ExternalTraitList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExternalTrait, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTraitList.
+func (in *ExternalTraitList) DeepCopy() *ExternalTraitList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTraitList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalTraitList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTraitServiceReference) DeepCopyInto(out *ExternalTraitServiceReference) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTraitServiceReference.
+func (in *ExternalTraitServiceReference) DeepCopy() *ExternalTraitServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTraitServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTraitSpec) DeepCopyInto(out *ExternalTraitSpec) {
+	*out = *in
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = new(int)
+		**out = **in
+	}
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]IntegrationPhase, len(*in))
+		copy(*out, *in)
+	}
+	in.Webhook.DeepCopyInto(&out.Webhook)
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTraitSpec.
+func (in *ExternalTraitSpec) DeepCopy() *ExternalTraitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTraitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTraitWebhook) DeepCopyInto(out *ExternalTraitWebhook) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ExternalTraitServiceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTraitWebhook.
+func (in *ExternalTraitWebhook) DeepCopy() *ExternalTraitWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTraitWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtraDirectories) DeepCopyInto(out *ExtraDirectories) {
 	*out = *in
@@ -1142,6 +1340,28 @@ func (in *HealthCheck) DeepCopy() *HealthCheck {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckReport) DeepCopyInto(out *HealthCheckReport) {
+	*out = *in
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = make([]PodHealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckReport.
+func (in *HealthCheckReport) DeepCopy() *HealthCheckReport {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthCheckResponse) DeepCopyInto(out *HealthCheckResponse) {
 	*out = *in
@@ -1162,6 +1382,56 @@ func (in *HealthCheckResponse) DeepCopy() *HealthCheckResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckTransition) DeepCopyInto(out *HealthCheckTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckTransition.
+func (in *HealthCheckTransition) DeepCopy() *HealthCheckTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
+	*out = *in
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]HealthCheckReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]HealthCheckTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProbeFailures != nil {
+		in, out := &in.ProbeFailures, &out.ProbeFailures
+		*out = make([]PodHealthProbeFailure, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthStatus.
+func (in *HealthStatus) DeepCopy() *HealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Integration) DeepCopyInto(out *Integration) {
 	*out = *in
@@ -1760,6 +2030,11 @@ func (in *IntegrationProfileSpec) DeepCopyInto(out *IntegrationProfileSpec) {
 		copy(*out, *in)
 	}
 	in.Kamelet.DeepCopyInto(&out.Kamelet)
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecurityPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationProfileSpec.
@@ -1868,6 +2143,16 @@ func (in *IntegrationStatus) DeepCopyInto(out *IntegrationStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DependencyOrigins != nil {
+		in, out := &in.DependencyOrigins, &out.DependencyOrigins
+		*out = make([]DependencyOrigin, len(*in))
+		copy(*out, *in)
+	}
+	if in.Modeline != nil {
+		in, out := &in.Modeline, &out.Modeline
+		*out = make([]ModelineOption, len(*in))
+		copy(*out, *in)
+	}
 	if in.Traits != nil {
 		in, out := &in.Traits, &out.Traits
 		*out = new(Traits)
@@ -1912,6 +2197,28 @@ func (in *IntegrationStatus) DeepCopyInto(out *IntegrationStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogLevels != nil {
+		in, out := &in.LogLevels, &out.LogLevels
+		*out = make([]LogLevel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Cron != nil {
+		in, out := &in.Cron, &out.Cron
+		*out = new(CronStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(HealthStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.InitializationTimestamp != nil {
 		in, out := &in.InitializationTimestamp, &out.InitializationTimestamp
 		*out = (*in).DeepCopy()
@@ -2136,6 +2443,21 @@ func (in *KameletCondition) DeepCopy() *KameletCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KameletDeprecation) DeepCopyInto(out *KameletDeprecation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KameletDeprecation.
+func (in *KameletDeprecation) DeepCopy() *KameletDeprecation {
+	if in == nil {
+		return nil
+	}
+	out := new(KameletDeprecation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KameletList) DeepCopyInto(out *KameletList) {
 	*out = *in
@@ -2260,6 +2582,11 @@ func (in *KameletSpecBase) DeepCopyInto(out *KameletSpecBase) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Deprecation != nil {
+		in, out := &in.Deprecation, &out.Deprecation
+		*out = new(KameletDeprecation)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KameletSpecBase.
@@ -2287,6 +2614,11 @@ func (in *KameletStatus) DeepCopyInto(out *KameletStatus) {
 		*out = make([]KameletProperty, len(*in))
 		copy(*out, *in)
 	}
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = make([]KameletUsage, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KameletStatus.
@@ -2299,6 +2631,21 @@ func (in *KameletStatus) DeepCopy() *KameletStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KameletUsage) DeepCopyInto(out *KameletUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KameletUsage.
+func (in *KameletUsage) DeepCopy() *KameletUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(KameletUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KanikoTask) DeepCopyInto(out *KanikoTask) {
 	*out = *in
@@ -2342,6 +2689,30 @@ func (in *KanikoTaskCache) DeepCopy() *KanikoTaskCache {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogLevel) DeepCopyInto(out *LogLevel) {
+	*out = *in
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = (*in).DeepCopy()
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogLevel.
+func (in *LogLevel) DeepCopy() *LogLevel {
+	if in == nil {
+		return nil
+	}
+	out := new(LogLevel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MavenArtifact) DeepCopyInto(out *MavenArtifact) {
 	*out = *in
@@ -2433,6 +2804,21 @@ func (in *MavenSpec) DeepCopy() *MavenSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelineOption) DeepCopyInto(out *ModelineOption) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelineOption.
+func (in *ModelineOption) DeepCopy() *ModelineOption {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelineOption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Path) DeepCopyInto(out *Path) {
 	*out = *in
@@ -2741,6 +3127,41 @@ func (in *PodCondition) DeepCopy() *PodCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodHealthCheck) DeepCopyInto(out *PodHealthCheck) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(RawMessage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHealthCheck.
+func (in *PodHealthCheck) DeepCopy() *PodHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(PodHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodHealthProbeFailure) DeepCopyInto(out *PodHealthProbeFailure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHealthProbeFailure.
+func (in *PodHealthProbeFailure) DeepCopy() *PodHealthProbeFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(PodHealthProbeFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSpec) DeepCopyInto(out *PodSpec) {
 	*out = *in
@@ -2938,6 +3359,29 @@ func (in *RepositoryPolicy) DeepCopy() *RepositoryPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.StepStartTime != nil {
+		in, out := &in.StepStartTime, &out.StepStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextAnalysisTime != nil {
+		in, out := &in.NextAnalysisTime, &out.NextAnalysisTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuntimeSpec) DeepCopyInto(out *RuntimeSpec) {
 	*out = *in
@@ -2989,6 +3433,31 @@ func (in *S2iTask) DeepCopy() *S2iTask {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityPolicySpec) DeepCopyInto(out *SecurityPolicySpec) {
+	*out = *in
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequireApproval != nil {
+		in, out := &in.RequireApproval, &out.RequireApproval
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicySpec.
+func (in *SecurityPolicySpec) DeepCopy() *SecurityPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -3261,6 +3730,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.HealthTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HPA != nil {
+		in, out := &in.HPA, &out.HPA
+		*out = new(trait.HPATrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Ingress != nil {
 		in, out := &in.Ingress, &out.Ingress
 		*out = new(trait.IngressTrait)
@@ -3276,6 +3750,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.IstioTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(trait.JobTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DeprecatedJolokia != nil {
 		in, out := &in.DeprecatedJolokia, &out.DeprecatedJolokia
 		*out = new(trait.JolokiaTrait)
@@ -3321,6 +3800,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.MountTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(trait.NetworkPolicyTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DeprecatedOpenAPI != nil {
 		in, out := &in.DeprecatedOpenAPI, &out.DeprecatedOpenAPI
 		*out = new(trait.OpenAPITrait)
@@ -3366,6 +3850,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.RegistryTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(trait.RolloutTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Route != nil {
 		in, out := &in.Route, &out.Route
 		*out = new(trait.RouteTrait)
@@ -3386,6 +3875,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.ServiceBindingTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StatefulSet != nil {
+		in, out := &in.StatefulSet, &out.StatefulSet
+		*out = new(trait.StatefulSetTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Telemetry != nil {
 		in, out := &in.Telemetry, &out.Telemetry
 		*out = new(trait.TelemetryTrait)
@@ -3396,8 +3890,8 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.TolerationTrait)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.DeprecatedAddons != nil {
-		in, out := &in.DeprecatedAddons, &out.DeprecatedAddons
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
 		*out = make(map[string]AddonTrait, len(*in))
 		for key, val := range *in {
 			(*out)[key] = *val.DeepCopy()
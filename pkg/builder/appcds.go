@@ -0,0 +1,244 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/digest"
+	"github.com/apache/camel-k/v2/pkg/util/io"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+)
+
+const (
+	// AppCDSArchiveTarget is the location of the AppCDS archive, relative to the deployment directory.
+	AppCDSArchiveTarget = DependenciesDir + "/app-cds.jsa"
+
+	appCDSDir             = "appcds"
+	appCDSTrainingTimeout = 5 * time.Minute
+)
+
+var (
+	// appCDSModTime is the modification time set on the files the archive is trained against.
+	// The JVM validates the archive against the modification time of the jars on the classpath,
+	// so it must be reproduced in the image. It's the one Jib sets by default.
+	appCDSModTime = time.Unix(1, 0)
+
+	javaVersionRegexp      = regexp.MustCompile(`version "(\d+)(?:\.(\d+))?`)
+	imageJavaVersionRegexp = regexp.MustCompile(`(?:jdk|jre|java)-?(\d+)`)
+	imageJavaNameRegexp    = regexp.MustCompile(`temurin|jdk|jre|java`)
+	imageJavaTagRegexp     = regexp.MustCompile(`^(\d+)(?:[.\-_]|$)`)
+
+	// builderJavaVersion returns the major version of the JDK running the build, it's a variable so that tests can replace it.
+	builderJavaVersion = localJavaVersion
+	// trainAppCDSArchive runs the application to record the archive, it's a variable so that tests can replace it.
+	trainAppCDSArchive = runAppCDSTraining
+)
+
+// AppCDSClasspath returns the classpath the AppCDS archive is trained with, that is the jars of the given artifacts,
+// in a stable order. The JVM only uses the archive when its classpath starts with it.
+func AppCDSClasspath(artifacts []v1.Artifact) []string {
+	classpath := make([]string, 0, len(artifacts))
+	for _, a := range artifacts {
+		if filepath.Ext(a.Target) != ".jar" || strings.HasSuffix(filepath.Dir(a.Target), "/quarkus") {
+			continue
+		}
+		classpath = append(classpath, a.Target)
+	}
+	sort.Strings(classpath)
+
+	return classpath
+}
+
+// HasAppCDSArchive returns true if the given artifacts contain an AppCDS archive.
+func HasAppCDSArchive(artifacts []v1.Artifact) bool {
+	for _, a := range artifacts {
+		if a.Target == AppCDSArchiveTarget {
+			return true
+		}
+	}
+
+	return false
+}
+
+// generateAppCDSArchive trains a JDK AppCDS archive by starting the application with its runtime layout,
+// and adds it to the artifacts so that it's layered into the image.
+// The build is not failed when the archive cannot be generated: the application just starts without it.
+func generateAppCDSArchive(ctx *builderContext) error {
+	javaVersion, err := builderJavaVersion(ctx.C)
+	if err != nil {
+		log.Infof("Skipping AppCDS archive for %s: cannot detect the builder JDK version: %v", ctx.Build.Name, err)
+
+		return nil
+	}
+	if imageVersion, ok := imageJavaVersion(ctx.BaseImage); !ok {
+		log.Infof("Cannot detect the JDK version of base image %s, the AppCDS archive will be verified at runtime", ctx.BaseImage)
+	} else if imageVersion != javaVersion {
+		log.Infof("Skipping AppCDS archive for %s: base image %s runs JDK %d while the builder runs JDK %d",
+			ctx.Build.Name, ctx.BaseImage, imageVersion, javaVersion)
+
+		return nil
+	}
+
+	trainingDir := filepath.Join(ctx.Path, appCDSDir)
+	if err := prepareAppCDSLayout(ctx.Artifacts, trainingDir); err != nil {
+		return err
+	}
+	archive := filepath.Join(trainingDir, AppCDSArchiveTarget)
+	classpath := AppCDSClasspath(ctx.Artifacts)
+	if err := trainAppCDSArchive(ctx.C, trainingDir, archive, classpath, ctx.Catalog.Runtime.ApplicationClass); err != nil {
+		log.Infof("Skipping AppCDS archive for %s: %v", ctx.Build.Name, err)
+
+		return nil
+	}
+
+	sha1, err := digest.ComputeSHA1(archive)
+	if err != nil {
+		return err
+	}
+	ctx.Artifacts = append(ctx.Artifacts, v1.Artifact{
+		ID:       filepath.Base(AppCDSArchiveTarget),
+		Location: archive,
+		Target:   AppCDSArchiveTarget,
+		Checksum: "sha1:" + sha1,
+	})
+
+	return nil
+}
+
+// prepareAppCDSLayout reproduces the layout of the image in the given directory, copying the artifacts to their target,
+// with the modification time they have in the image. The artifacts are copied rather than linked, as a hard link shares
+// the modification time of the file of the local Maven repository, which is shared with the other builds.
+func prepareAppCDSLayout(artifacts []v1.Artifact, dir string) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	for _, a := range artifacts {
+		target := filepath.Join(dir, a.Target)
+		if err := os.MkdirAll(filepath.Dir(target), io.FilePerm755); err != nil {
+			return err
+		}
+		if _, err := util.CopyFile(a.Location, target); err != nil {
+			return err
+		}
+		if err := os.Chtimes(target, appCDSModTime, appCDSModTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runAppCDSTraining starts the application, which exits as soon as it's started, and dumps the loaded classes into the archive.
+func runAppCDSTraining(ctx context.Context, dir, archive string, classpath []string, mainClass string) error {
+	ctx, cancel := context.WithTimeout(ctx, appCDSTrainingTimeout)
+	defer cancel()
+
+	args := []string{
+		"-XX:ArchiveClassesAtExit=" + archive,
+		"-Dquarkus.appcds.generate=true",
+		"-cp", strings.Join(classpath, ":"),
+		mainClass,
+	}
+	cmd := exec.CommandContext(ctx, javaCommand(), args...)
+	cmd.Dir = dir
+	log.Debugf("Training AppCDS archive: %s", strings.Join(cmd.Args, " "))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("training failed: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+	if _, err := os.Stat(archive); err != nil {
+		return errors.New("the JVM did not produce the archive")
+	}
+
+	return nil
+}
+
+// localJavaVersion returns the major version of the JDK running the build.
+func localJavaVersion(ctx context.Context) (int, error) {
+	out, err := exec.CommandContext(ctx, javaCommand(), "-version").CombinedOutput()
+	if err != nil {
+		return 0, err
+	}
+
+	return parseJavaVersion(string(out))
+}
+
+func javaCommand() string {
+	if javaHome, ok := os.LookupEnv("JAVA_HOME"); ok {
+		return filepath.Join(javaHome, "bin", "java")
+	}
+
+	return "java"
+}
+
+// parseJavaVersion extracts the major version from the output of `java -version`.
+func parseJavaVersion(out string) (int, error) {
+	matches := javaVersionRegexp.FindStringSubmatch(out)
+	if matches == nil {
+		return 0, fmt.Errorf("cannot parse java version from %q", out)
+	}
+	major, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, err
+	}
+	// Legacy 1.x versioning scheme
+	if major == 1 && matches[2] != "" {
+		return strconv.Atoi(matches[2])
+	}
+
+	return major, nil
+}
+
+// imageJavaVersion guesses the major version of the JDK shipped by the given image from its name or tag,
+// e.g. eclipse-temurin:17-jdk or registry.access.redhat.com/ubi9/openjdk-21-runtime:1.20.
+func imageJavaVersion(image string) (int, bool) {
+	name, _, _ := strings.Cut(image, "@")
+	repository := name
+	tag := ""
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		repository, tag = name[:i], name[i+1:]
+	}
+	repository = repository[strings.LastIndex(repository, "/")+1:]
+
+	if matches := imageJavaVersionRegexp.FindStringSubmatch(repository); matches != nil {
+		version, err := strconv.Atoi(matches[1])
+
+		return version, err == nil
+	}
+	if imageJavaNameRegexp.MatchString(repository) {
+		if matches := imageJavaTagRegexp.FindStringSubmatch(tag); matches != nil {
+			version, err := strconv.Atoi(matches[1])
+
+			return version, err == nil
+		}
+	}
+
+	return 0, false
+}
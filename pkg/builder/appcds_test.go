@@ -0,0 +1,210 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+)
+
+func TestParseJavaVersion(t *testing.T) {
+	for out, expected := range map[string]int{
+		`openjdk version "17.0.9" 2023-10-17`:  17,
+		`openjdk version "21" 2023-09-19`:      21,
+		`java version "1.8.0_392"`:             8,
+		`openjdk version "11.0.21" 2023-10-17`: 11,
+	} {
+		version, err := parseJavaVersion(out)
+		require.NoError(t, err)
+		assert.Equal(t, expected, version, out)
+	}
+
+	_, err := parseJavaVersion("command not found")
+	require.Error(t, err)
+}
+
+func TestImageJavaVersion(t *testing.T) {
+	for image, expected := range map[string]int{
+		"eclipse-temurin:17-jdk@sha256:a27c79d44326d5f689668df5fedfee487652066d2a91e172747056cc7fbee6fc": 17,
+		"docker.io/library/eclipse-temurin:21":                    21,
+		"registry.access.redhat.com/ubi9/openjdk-21-runtime:1.20": 21,
+		"localhost:5000/my/openjdk17:latest":                      17,
+	} {
+		version, ok := imageJavaVersion(image)
+		assert.True(t, ok, image)
+		assert.Equal(t, expected, version, image)
+	}
+
+	for _, image := range []string{"quay.io/quarkus/quarkus-micro-image:2.0", "localhost:5000/my-base", "eclipse-temurin:latest"} {
+		_, ok := imageJavaVersion(image)
+		assert.False(t, ok, image)
+	}
+}
+
+func TestAppCDSClasspath(t *testing.T) {
+	assert.Equal(t, []string{
+		"dependencies/app/camel-k-integration.jar",
+		"dependencies/lib/boot/quarkus-bootstrap.jar",
+		"dependencies/lib/main/camel-core.jar",
+		"dependencies/quarkus-run.jar",
+	}, AppCDSClasspath([]v1.Artifact{
+		{Target: "dependencies/quarkus-run.jar"},
+		{Target: "dependencies/lib/main/camel-core.jar"},
+		{Target: "dependencies/quarkus/generated-bytecode.jar"},
+		{Target: "dependencies/quarkus/quarkus-application.dat"},
+		{Target: "dependencies/lib/boot/quarkus-bootstrap.jar"},
+		{Target: "dependencies/app/camel-k-integration.jar"},
+	}))
+}
+
+func TestGenerateAppCDSArchiveLayout(t *testing.T) {
+	var trainedClasspath []string
+	stubAppCDSTraining(t, 17, func(ctx context.Context, dir, archive string, classpath []string, mainClass string) error {
+		trainedClasspath = classpath
+		assert.Equal(t, "io.quarkus.bootstrap.runner.QuarkusEntryPoint", mainClass)
+		// The archive is trained against the image layout
+		assert.FileExists(t, filepath.Join(dir, "dependencies", "quarkus-run.jar"))
+		assert.FileExists(t, filepath.Join(dir, "dependencies", "lib", "main", "camel-core.jar"))
+
+		return os.WriteFile(archive, []byte("archive"), 0o600)
+	})
+
+	withArchive := buildAppCDSImageContext(t, "eclipse-temurin:17-jdk")
+	withoutArchive := buildAppCDSImageContext(t, "")
+
+	assert.Equal(t, []string{"dependencies/lib/main/camel-core.jar", "dependencies/quarkus-run.jar"}, trainedClasspath)
+	assert.Equal(t, append([]string{AppCDSArchiveTarget}, withoutArchive...), withArchive)
+}
+
+func TestPrepareAppCDSLayoutKeepsRepository(t *testing.T) {
+	repository := t.TempDir()
+	jar := filepath.Join(repository, "camel-core.jar")
+	require.NoError(t, os.WriteFile(jar, []byte("jar"), 0o600))
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(jar, modTime, modTime))
+
+	dir := filepath.Join(t.TempDir(), appCDSDir)
+	artifacts := []v1.Artifact{{ID: "camel-core.jar", Location: jar, Target: "dependencies/lib/main/camel-core.jar"}}
+	require.NoError(t, prepareAppCDSLayout(artifacts, dir))
+
+	// the artifact of the shared Maven repository is left untouched
+	info, err := os.Stat(jar)
+	require.NoError(t, err)
+	assert.True(t, info.ModTime().Equal(modTime))
+	copied, err := os.Stat(filepath.Join(dir, artifacts[0].Target))
+	require.NoError(t, err)
+	assert.True(t, copied.ModTime().Equal(appCDSModTime))
+	assert.False(t, os.SameFile(info, copied))
+}
+
+func TestGenerateAppCDSArchiveJavaVersionMismatch(t *testing.T) {
+	stubAppCDSTraining(t, 17, func(ctx context.Context, dir, archive string, classpath []string, mainClass string) error {
+		assert.Fail(t, "the archive must not be trained for a different JDK")
+
+		return nil
+	})
+
+	assert.Equal(t, buildAppCDSImageContext(t, ""), buildAppCDSImageContext(t, "eclipse-temurin:21-jdk"))
+}
+
+func TestGenerateAppCDSArchiveTrainingFailure(t *testing.T) {
+	stubAppCDSTraining(t, 17, func(ctx context.Context, dir, archive string, classpath []string, mainClass string) error {
+		return errors.New("training failed")
+	})
+
+	assert.Equal(t, buildAppCDSImageContext(t, ""), buildAppCDSImageContext(t, "eclipse-temurin:17-jdk"))
+}
+
+func stubAppCDSTraining(t *testing.T, javaVersion int, train func(context.Context, string, string, []string, string) error) {
+	t.Helper()
+	previousVersion, previousTraining := builderJavaVersion, trainAppCDSArchive
+	t.Cleanup(func() {
+		builderJavaVersion, trainAppCDSArchive = previousVersion, previousTraining
+	})
+	builderJavaVersion = func(context.Context) (int, error) {
+		return javaVersion, nil
+	}
+	trainAppCDSArchive = train
+}
+
+// buildAppCDSImageContext runs the package steps of a jvm kit, generating the AppCDS archive unless the base image is empty,
+// and returns the layout of the resulting image context.
+func buildAppCDSImageContext(t *testing.T, baseImage string) []string {
+	t.Helper()
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	quarkusAppDir := filepath.Join(dir, "maven", "target", "quarkus-app")
+	for _, file := range []string{"quarkus-run.jar", "lib/main/camel-core.jar", "quarkus/quarkus-application.dat"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(quarkusAppDir, filepath.Dir(file)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(quarkusAppDir, file), []byte(file), 0o600))
+	}
+
+	ctx := &builderContext{
+		C:         context.TODO(),
+		Catalog:   catalog,
+		Path:      dir,
+		BaseImage: baseImage,
+		Build:     v1.BuilderTask{BaseTask: v1.BaseTask{Name: "package"}},
+	}
+	require.NoError(t, computeQuarkusDependencies(ctx))
+	if baseImage != "" {
+		require.NoError(t, generateAppCDSArchive(ctx))
+	}
+	require.NoError(t, standardImageContext(ctx))
+
+	var layout []string
+	modTimes := make(map[string]time.Time)
+	contextDir := filepath.Join(dir, ContextDir)
+	err = filepath.Walk(contextDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(contextDir, path)
+		if err != nil {
+			return err
+		}
+		layout = append(layout, rel)
+		modTimes[rel] = info.ModTime()
+
+		return nil
+	})
+	require.NoError(t, err)
+	sort.Strings(layout)
+
+	if _, ok := modTimes[AppCDSArchiveTarget]; ok {
+		for _, a := range AppCDSClasspath(ctx.Artifacts) {
+			// The image files keep the modification time the archive is trained against
+			assert.True(t, modTimes[a].Equal(appCDSModTime), a)
+		}
+	}
+
+	return layout
+}
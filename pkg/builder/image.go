@@ -166,11 +166,19 @@ func imageContext(ctx *builderContext, selector artifactsSelector) error {
 		return err
 	}
 
+	appCDS := HasAppCDSArchive(ctx.SelectedArtifacts)
 	for _, entry := range ctx.SelectedArtifacts {
-		_, err := util.CopyFile(entry.Location, filepath.Join(contextDir, entry.Target))
+		target := filepath.Join(contextDir, entry.Target)
+		_, err := util.CopyFile(entry.Location, target)
 		if err != nil {
 			return err
 		}
+		// The JVM checks that the jars have the modification time the AppCDS archive is trained with
+		if appCDS {
+			if err := os.Chtimes(target, appCDSModTime, appCDSModTime); err != nil {
+				return err
+			}
+		}
 	}
 
 	for _, entry := range ctx.Resources {
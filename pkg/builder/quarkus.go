@@ -56,6 +56,7 @@ type quarkusSteps struct {
 	BuildQuarkusMavenContext   Step
 	BuildQuarkusMavenProject   Step
 	ComputeQuarkusDependencies Step
+	GenerateAppCDSArchive      Step
 	PrepareProjectWithSources  Step
 
 	CommonSteps []Step
@@ -69,6 +70,7 @@ var Quarkus = quarkusSteps{
 	PrepareProjectWithSources:  NewStep(ProjectBuildPhase-1, prepareProjectWithSources),
 	BuildQuarkusMavenProject:   NewStep(ProjectBuildPhase+2, buildMavenProject),
 	ComputeQuarkusDependencies: NewStep(ProjectBuildPhase+1, computeQuarkusDependencies),
+	GenerateAppCDSArchive:      NewStep(ApplicationPackagePhase-1, generateAppCDSArchive),
 }
 
 func prepareProjectWithSources(ctx *builderContext) error {
@@ -36,6 +36,8 @@ type IntegrationProfileSpecApplyConfiguration struct {
 	//
 	// Deprecated: to be removed in future versions.
 	Kamelet *IntegrationProfileKameletSpecApplyConfiguration `json:"kamelet,omitempty"`
+	// the policy applied to the Integrations using security sensitive components
+	Security *SecurityPolicySpecApplyConfiguration `json:"security,omitempty"`
 }
 
 // IntegrationProfileSpecApplyConfiguration constructs a declarative configuration of the IntegrationProfileSpec type for use with
@@ -77,3 +79,11 @@ func (b *IntegrationProfileSpecApplyConfiguration) WithKamelet(value *Integratio
 	b.Kamelet = value
 	return b
 }
+
+// WithSecurity sets the Security field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Security field is set to the value of the last call.
+func (b *IntegrationProfileSpecApplyConfiguration) WithSecurity(value *SecurityPolicySpecApplyConfiguration) *IntegrationProfileSpecApplyConfiguration {
+	b.Security = value
+	return b
+}
@@ -105,3 +105,11 @@ func (b *IntegrationProfileStatusApplyConfiguration) WithConditions(values ...*I
 	}
 	return b
 }
+
+// WithSecurity sets the Security field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Security field is set to the value of the last call.
+func (b *IntegrationProfileStatusApplyConfiguration) WithSecurity(value *SecurityPolicySpecApplyConfiguration) *IntegrationProfileStatusApplyConfiguration {
+	b.IntegrationProfileSpecApplyConfiguration.Security = value
+	return b
+}
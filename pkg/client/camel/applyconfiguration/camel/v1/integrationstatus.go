@@ -41,7 +41,11 @@ type IntegrationStatusApplyConfiguration struct {
 	// the Java jar dependency to execute (if available)
 	Jar *string `json:"jar,omitempty"`
 	// a list of dependencies needed by the application
-	Dependencies []string `json:"dependencies,omitempty"`
+	Dependencies      []string                             `json:"dependencies,omitempty"`
+	DependencyOrigins []DependencyOriginApplyConfiguration `json:"dependencyOrigins,omitempty"`
+	// the modeline options declared in the sources and applied by the operator,
+	// recorded when the `camel.apache.org/modeline` annotation is enabled
+	Modeline []ModelineOptionApplyConfiguration `json:"modeline,omitempty"`
 	// the profile needed to run this Integration
 	//
 	// Deprecated: may be removed in future releases.
@@ -74,6 +78,14 @@ type IntegrationStatusApplyConfiguration struct {
 	Selector *string `json:"selector,omitempty"`
 	// features offered by the Integration
 	Capabilities []string `json:"capabilities,omitempty"`
+	// the progress of the delivery of a new version, when the `rollout` trait is enabled
+	Rollout *RolloutStatusApplyConfiguration `json:"rollout,omitempty"`
+	// the logger levels changed at runtime, as requested with the `camel.apache.org/log-levels` annotation
+	LogLevels []LogLevelApplyConfiguration `json:"logLevels,omitempty"`
+	// the executions of the Integration, when it runs as a CronJob
+	Cron *CronStatusApplyConfiguration `json:"cron,omitempty"`
+	// the health checks of the Camel runtime, aggregated across the Pods of the Integration
+	Health *HealthStatusApplyConfiguration `json:"health,omitempty"`
 	// the timestamp representing the last time when this integration was initialized.
 	InitializationTimestamp *metav1.Time `json:"lastInitTimestamp,omitempty"`
 	// the timestamp representing the last time when this integration was deployed.
@@ -290,3 +302,66 @@ func (b *IntegrationStatusApplyConfiguration) WithBuildTimestamp(value metav1.Ti
 	b.BuildTimestamp = &value
 	return b
 }
+
+// WithDependencyOrigins adds the given value to the DependencyOrigins field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DependencyOrigins field.
+func (b *IntegrationStatusApplyConfiguration) WithDependencyOrigins(values ...*DependencyOriginApplyConfiguration) *IntegrationStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDependencyOrigins")
+		}
+		b.DependencyOrigins = append(b.DependencyOrigins, *values[i])
+	}
+	return b
+}
+
+// WithModeline adds the given value to the Modeline field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Modeline field.
+func (b *IntegrationStatusApplyConfiguration) WithModeline(values ...*ModelineOptionApplyConfiguration) *IntegrationStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithModeline")
+		}
+		b.Modeline = append(b.Modeline, *values[i])
+	}
+	return b
+}
+
+// WithRollout sets the Rollout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rollout field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithRollout(value *RolloutStatusApplyConfiguration) *IntegrationStatusApplyConfiguration {
+	b.Rollout = value
+	return b
+}
+
+// WithLogLevels adds the given value to the LogLevels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the LogLevels field.
+func (b *IntegrationStatusApplyConfiguration) WithLogLevels(values ...*LogLevelApplyConfiguration) *IntegrationStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithLogLevels")
+		}
+		b.LogLevels = append(b.LogLevels, *values[i])
+	}
+	return b
+}
+
+// WithCron sets the Cron field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cron field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithCron(value *CronStatusApplyConfiguration) *IntegrationStatusApplyConfiguration {
+	b.Cron = value
+	return b
+}
+
+// WithHealth sets the Health field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Health field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithHealth(value *HealthStatusApplyConfiguration) *IntegrationStatusApplyConfiguration {
+	b.Health = value
+	return b
+}
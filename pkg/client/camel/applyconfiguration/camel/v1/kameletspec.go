@@ -121,3 +121,11 @@ func (b *KameletSpecApplyConfiguration) WithVersions(entries map[string]KameletS
 	}
 	return b
 }
+
+// WithDeprecation sets the Deprecation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Deprecation field is set to the value of the last call.
+func (b *KameletSpecApplyConfiguration) WithDeprecation(value *KameletDeprecationApplyConfiguration) *KameletSpecApplyConfiguration {
+	b.KameletSpecBaseApplyConfiguration.Deprecation = value
+	return b
+}
@@ -41,6 +41,8 @@ type KameletSpecBaseApplyConfiguration struct {
 	DataTypes map[camelv1.TypeSlot]DataTypesSpecApplyConfiguration `json:"dataTypes,omitempty"`
 	// Camel dependencies needed by the Kamelet
 	Dependencies []string `json:"dependencies,omitempty"`
+	// the deprecation notice, if the Kamelet is about to be retired
+	Deprecation *KameletDeprecationApplyConfiguration `json:"deprecation,omitempty"`
 }
 
 // KameletSpecBaseApplyConfiguration constructs a declarative configuration of the KameletSpecBase type for use with
@@ -115,3 +117,11 @@ func (b *KameletSpecBaseApplyConfiguration) WithDependencies(values ...string) *
 	}
 	return b
 }
+
+// WithDeprecation sets the Deprecation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Deprecation field is set to the value of the last call.
+func (b *KameletSpecBaseApplyConfiguration) WithDeprecation(value *KameletDeprecationApplyConfiguration) *KameletSpecBaseApplyConfiguration {
+	b.Deprecation = value
+	return b
+}
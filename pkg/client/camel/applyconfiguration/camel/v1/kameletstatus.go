@@ -36,6 +36,10 @@ type KameletStatusApplyConfiguration struct {
 	Conditions []KameletConditionApplyConfiguration `json:"conditions,omitempty"`
 	// Properties --
 	Properties []KameletPropertyApplyConfiguration `json:"properties,omitempty"`
+	// the number of Integrations currently using the Kamelet
+	UsageCount *int `json:"usageCount,omitempty"`
+	// the Integrations currently using the Kamelet
+	Integrations []KameletUsageApplyConfiguration `json:"integrations,omitempty"`
 }
 
 // KameletStatusApplyConfiguration constructs a declarative configuration of the KameletStatus type for use with
@@ -85,3 +89,24 @@ func (b *KameletStatusApplyConfiguration) WithProperties(values ...*KameletPrope
 	}
 	return b
 }
+
+// WithUsageCount sets the UsageCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UsageCount field is set to the value of the last call.
+func (b *KameletStatusApplyConfiguration) WithUsageCount(value int) *KameletStatusApplyConfiguration {
+	b.UsageCount = &value
+	return b
+}
+
+// WithIntegrations adds the given value to the Integrations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Integrations field.
+func (b *KameletStatusApplyConfiguration) WithIntegrations(values ...*KameletUsageApplyConfiguration) *KameletStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithIntegrations")
+		}
+		b.Integrations = append(b.Integrations, *values[i])
+	}
+	return b
+}
@@ -58,12 +58,16 @@ type TraitsApplyConfiguration struct {
 	GitOps *trait.GitOpsTrait `json:"gitops,omitempty"`
 	// The configuration of Health trait
 	Health *trait.HealthTrait `json:"health,omitempty"`
+	// The configuration of HPA trait
+	HPA *trait.HPATrait `json:"hpa,omitempty"`
 	// The configuration of Ingress trait
 	Ingress *trait.IngressTrait `json:"ingress,omitempty"`
 	// The configuration of Init Containers trait
 	InitContainers *trait.InitContainersTrait `json:"init-containers,omitempty"`
 	// The configuration of Istio trait
 	Istio *trait.IstioTrait `json:"istio,omitempty"`
+	// The configuration of Job trait
+	Job *trait.JobTrait `json:"job,omitempty"`
 	// The configuration of Jolokia trait.
 	//
 	// Deprecated: no longer in use.
@@ -84,6 +88,8 @@ type TraitsApplyConfiguration struct {
 	Master *trait.MasterTrait `json:"master,omitempty"`
 	// The configuration of Mount trait
 	Mount *trait.MountTrait `json:"mount,omitempty"`
+	// The configuration of NetworkPolicy trait
+	NetworkPolicy *trait.NetworkPolicyTrait `json:"network-policy,omitempty"`
 	// The configuration of OpenAPI trait.
 	//
 	// Deprecated: no longer in use.
@@ -106,6 +112,8 @@ type TraitsApplyConfiguration struct {
 	//
 	// Deprecated: no longer in use.
 	DeprecatedRegistry *trait.RegistryTrait `json:"registry,omitempty"`
+	// The configuration of Rollout trait
+	Rollout *trait.RolloutTrait `json:"rollout,omitempty"`
 	// The configuration of Route trait.
 	//
 	// Deprecated: use ingress instead.
@@ -118,12 +126,14 @@ type TraitsApplyConfiguration struct {
 	//
 	// Deprecated: no longer in use.
 	DeprecatedServiceBinding *trait.ServiceBindingTrait `json:"service-binding,omitempty"`
+	// The configuration of StatefulSet trait
+	StatefulSet *trait.StatefulSetTrait `json:"statefulset,omitempty"`
 	// The configuration of Telemetry trait
 	Telemetry *trait.TelemetryTrait `json:"telemetry,omitempty"`
 	// The configuration of Toleration trait
 	Toleration *trait.TolerationTrait `json:"toleration,omitempty"`
-	// Deprecated: no longer in use.
-	DeprecatedAddons map[string]AddonTraitApplyConfiguration `json:"addons,omitempty"`
+	// The configuration of the external traits, registered with ExternalTrait resources, indexed by trait ID.
+	Addons map[string]AddonTraitApplyConfiguration `json:"addons,omitempty"`
 	// Deprecated: no longer in use.
 	DeprecatedStrimzi *TraitSpecApplyConfiguration `json:"strimzi,omitempty"`
 	// Deprecated: no longer in use.
@@ -250,6 +260,14 @@ func (b *TraitsApplyConfiguration) WithHealth(value trait.HealthTrait) *TraitsAp
 	return b
 }
 
+// WithHPA sets the HPA field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HPA field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithHPA(value trait.HPATrait) *TraitsApplyConfiguration {
+	b.HPA = &value
+	return b
+}
+
 // WithIngress sets the Ingress field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Ingress field is set to the value of the last call.
@@ -274,6 +292,14 @@ func (b *TraitsApplyConfiguration) WithIstio(value trait.IstioTrait) *TraitsAppl
 	return b
 }
 
+// WithJob sets the Job field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Job field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithJob(value trait.JobTrait) *TraitsApplyConfiguration {
+	b.Job = &value
+	return b
+}
+
 // WithDeprecatedJolokia sets the DeprecatedJolokia field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the DeprecatedJolokia field is set to the value of the last call.
@@ -346,6 +372,14 @@ func (b *TraitsApplyConfiguration) WithMount(value trait.MountTrait) *TraitsAppl
 	return b
 }
 
+// WithNetworkPolicy sets the NetworkPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkPolicy field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithNetworkPolicy(value trait.NetworkPolicyTrait) *TraitsApplyConfiguration {
+	b.NetworkPolicy = &value
+	return b
+}
+
 // WithDeprecatedOpenAPI sets the DeprecatedOpenAPI field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the DeprecatedOpenAPI field is set to the value of the last call.
@@ -418,6 +452,14 @@ func (b *TraitsApplyConfiguration) WithDeprecatedRegistry(value trait.RegistryTr
 	return b
 }
 
+// WithRollout sets the Rollout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rollout field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithRollout(value trait.RolloutTrait) *TraitsApplyConfiguration {
+	b.Rollout = &value
+	return b
+}
+
 // WithRoute sets the Route field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Route field is set to the value of the last call.
@@ -450,6 +492,14 @@ func (b *TraitsApplyConfiguration) WithDeprecatedServiceBinding(value trait.Serv
 	return b
 }
 
+// WithStatefulSet sets the StatefulSet field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StatefulSet field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithStatefulSet(value trait.StatefulSetTrait) *TraitsApplyConfiguration {
+	b.StatefulSet = &value
+	return b
+}
+
 // WithTelemetry sets the Telemetry field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Telemetry field is set to the value of the last call.
@@ -466,16 +516,16 @@ func (b *TraitsApplyConfiguration) WithToleration(value trait.TolerationTrait) *
 	return b
 }
 
-// WithDeprecatedAddons puts the entries into the DeprecatedAddons field in the declarative configuration
+// WithAddons puts the entries into the Addons field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
-// If called multiple times, the entries provided by each call will be put on the DeprecatedAddons field,
-// overwriting an existing map entries in DeprecatedAddons field with the same key.
-func (b *TraitsApplyConfiguration) WithDeprecatedAddons(entries map[string]AddonTraitApplyConfiguration) *TraitsApplyConfiguration {
-	if b.DeprecatedAddons == nil && len(entries) > 0 {
-		b.DeprecatedAddons = make(map[string]AddonTraitApplyConfiguration, len(entries))
+// If called multiple times, the entries provided by each call will be put on the Addons field,
+// overwriting an existing map entries in Addons field with the same key.
+func (b *TraitsApplyConfiguration) WithAddons(entries map[string]AddonTraitApplyConfiguration) *TraitsApplyConfiguration {
+	if b.Addons == nil && len(entries) > 0 {
+		b.Addons = make(map[string]AddonTraitApplyConfiguration, len(entries))
 	}
 	for k, v := range entries {
-		b.DeprecatedAddons[k] = v
+		b.Addons[k] = v
 	}
 	return b
 }
@@ -81,6 +81,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.CatalogApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ConfigurationSpec"):
 		return &camelv1.ConfigurationSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("CronExecution"):
+		return &camelv1.CronExecutionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("CronStatus"):
+		return &camelv1.CronStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("DataSpec"):
 		return &camelv1.DataSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("DataTypeReference"):
@@ -89,6 +93,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.DataTypeSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("DataTypesSpec"):
 		return &camelv1.DataTypesSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DependencyOrigin"):
+		return &camelv1.DependencyOriginApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Endpoint"):
 		return &camelv1.EndpointApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EndpointProperties"):
@@ -99,6 +105,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.EventTypeSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ExternalDocumentation"):
 		return &camelv1.ExternalDocumentationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ExternalTrait"):
+		return &camelv1.ExternalTraitApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ExternalTraitServiceReference"):
+		return &camelv1.ExternalTraitServiceReferenceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ExternalTraitSpec"):
+		return &camelv1.ExternalTraitSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ExternalTraitWebhook"):
+		return &camelv1.ExternalTraitWebhookApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Failure"):
 		return &camelv1.FailureApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("FailureRecovery"):
@@ -109,8 +123,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.GitConfigSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HeaderSpec"):
 		return &camelv1.HeaderSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HealthCheckReport"):
+		return &camelv1.HealthCheckReportApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HealthCheckResponse"):
 		return &camelv1.HealthCheckResponseApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HealthCheckTransition"):
+		return &camelv1.HealthCheckTransitionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HealthStatus"):
+		return &camelv1.HealthStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Integration"):
 		return &camelv1.IntegrationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationCondition"):
@@ -165,6 +185,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.KameletApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("KameletCondition"):
 		return &camelv1.KameletConditionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("KameletDeprecation"):
+		return &camelv1.KameletDeprecationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("KameletProperty"):
 		return &camelv1.KameletPropertyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("KameletRepositorySpec"):
@@ -175,16 +197,22 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.KameletSpecBaseApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("KameletStatus"):
 		return &camelv1.KameletStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("KameletUsage"):
+		return &camelv1.KameletUsageApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("KanikoTask"):
 		return &camelv1.KanikoTaskApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("KanikoTaskCache"):
 		return &camelv1.KanikoTaskCacheApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("LogLevel"):
+		return &camelv1.LogLevelApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MavenArtifact"):
 		return &camelv1.MavenArtifactApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MavenBuildSpec"):
 		return &camelv1.MavenBuildSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MavenSpec"):
 		return &camelv1.MavenSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ModelineOption"):
+		return &camelv1.ModelineOptionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Pipe"):
 		return &camelv1.PipeApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PipeCondition"):
@@ -195,6 +223,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.PipeStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PodCondition"):
 		return &camelv1.PodConditionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PodHealthCheck"):
+		return &camelv1.PodHealthCheckApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PodHealthProbeFailure"):
+		return &camelv1.PodHealthProbeFailureApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PodSpec"):
 		return &camelv1.PodSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PodSpecTemplate"):
@@ -207,10 +239,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.RepositoryApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RepositoryPolicy"):
 		return &camelv1.RepositoryPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RolloutStatus"):
+		return &camelv1.RolloutStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RuntimeSpec"):
 		return &camelv1.RuntimeSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("S2iTask"):
 		return &camelv1.S2iTaskApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SecurityPolicySpec"):
+		return &camelv1.SecurityPolicySpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Server"):
 		return &camelv1.ServerApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SourceSpec"):
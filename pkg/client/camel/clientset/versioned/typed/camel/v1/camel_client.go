@@ -31,6 +31,7 @@ type CamelV1Interface interface {
 	RESTClient() rest.Interface
 	BuildsGetter
 	CamelCatalogsGetter
+	ExternalTraitsGetter
 	IntegrationsGetter
 	IntegrationKitsGetter
 	IntegrationPlatformsGetter
@@ -52,6 +53,10 @@ func (c *CamelV1Client) CamelCatalogs(namespace string) CamelCatalogInterface {
 	return newCamelCatalogs(c, namespace)
 }
 
+func (c *CamelV1Client) ExternalTraits(namespace string) ExternalTraitInterface {
+	return newExternalTraits(c, namespace)
+}
+
 func (c *CamelV1Client) Integrations(namespace string) IntegrationInterface {
 	return newIntegrations(c, namespace)
 }
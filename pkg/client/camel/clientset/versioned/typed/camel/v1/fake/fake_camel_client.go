@@ -37,6 +37,10 @@ func (c *FakeCamelV1) CamelCatalogs(namespace string) v1.CamelCatalogInterface {
 	return newFakeCamelCatalogs(c, namespace)
 }
 
+func (c *FakeCamelV1) ExternalTraits(namespace string) v1.ExternalTraitInterface {
+	return newFakeExternalTraits(c, namespace)
+}
+
 func (c *FakeCamelV1) Integrations(namespace string) v1.IntegrationInterface {
 	return newFakeIntegrations(c, namespace)
 }
@@ -23,6 +23,8 @@ type BuildExpansion interface{}
 
 type CamelCatalogExpansion interface{}
 
+type ExternalTraitExpansion interface{}
+
 type IntegrationExpansion interface{}
 
 type IntegrationKitExpansion interface{}
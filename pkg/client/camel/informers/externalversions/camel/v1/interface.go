@@ -29,6 +29,8 @@ type Interface interface {
 	Builds() BuildInformer
 	// CamelCatalogs returns a CamelCatalogInformer.
 	CamelCatalogs() CamelCatalogInformer
+	// ExternalTraits returns a ExternalTraitInformer.
+	ExternalTraits() ExternalTraitInformer
 	// Integrations returns a IntegrationInformer.
 	Integrations() IntegrationInformer
 	// IntegrationKits returns a IntegrationKitInformer.
@@ -64,6 +66,11 @@ func (v *version) CamelCatalogs() CamelCatalogInformer {
 	return &camelCatalogInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ExternalTraits returns a ExternalTraitInformer.
+func (v *version) ExternalTraits() ExternalTraitInformer {
+	return &externalTraitInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Integrations returns a IntegrationInformer.
 func (v *version) Integrations() IntegrationInformer {
 	return &integrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -58,6 +58,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Camel().V1().Builds().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("camelcatalogs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Camel().V1().CamelCatalogs().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("externaltraits"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Camel().V1().ExternalTraits().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("integrations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Camel().V1().Integrations().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("integrationkits"):
@@ -35,6 +35,14 @@ type CamelCatalogListerExpansion interface{}
 // CamelCatalogNamespaceLister.
 type CamelCatalogNamespaceListerExpansion interface{}
 
+// ExternalTraitListerExpansion allows custom methods to be added to
+// ExternalTraitLister.
+type ExternalTraitListerExpansion interface{}
+
+// ExternalTraitNamespaceListerExpansion allows custom methods to be added to
+// ExternalTraitNamespaceLister.
+type ExternalTraitNamespaceListerExpansion interface{}
+
 // IntegrationListerExpansion allows custom methods to be added to
 // IntegrationLister.
 type IntegrationListerExpansion interface{}
@@ -0,0 +1,111 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func newCronHistoryCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *cronHistoryCommandOptions) {
+	options := cronHistoryCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "history <integration>",
+		Short:   "Show the last executions of an Integration running as a CronJob",
+		Long:    `Show the last executions of an Integration running as a CronJob, as reported by the operator.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("history expects exactly one Integration name")
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	return &cmd, &options
+}
+
+type cronHistoryCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (o *cronHistoryCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	it, err := getIntegration(o.Context, c, args[0], o.Namespace)
+	if err != nil {
+		return err
+	}
+
+	status := it.Status.Cron
+	if status == nil || len(status.Executions) == 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "No execution recorded for Integration %s\n", it.Name)
+	} else {
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+		fmt.Fprintln(w, "JOB\tTRIGGER\tSTART\tEND\tOUTCOME\tPOD")
+		for _, e := range status.Executions {
+			trigger := "schedule"
+			if e.Manual {
+				trigger = "manual"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", e.Job, trigger, formatExecutionTime(e.StartTime), formatExecutionTime(e.CompletionTime), e.Outcome, e.Pod)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	if status != nil {
+		fmt.Fprintf(cmd.OutOrStdout(), "\nConsecutive failures: %d\nMissed schedules: %d\n", status.ConsecutiveFailures, status.MissedSchedules)
+		if status.NextScheduleTime != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Next schedule: %s\n", status.NextScheduleTime.Format(time.RFC3339))
+		}
+	}
+	if it.Spec.Traits.Cron != nil && ptr.Deref(it.Spec.Traits.Cron.Suspend, false) {
+		fmt.Fprintln(cmd.OutOrStdout(), "The scheduled executions are suspended")
+	}
+	if condition := it.Status.GetCondition(v1.IntegrationConditionCronJobExecutions); condition != nil &&
+		condition.Status == corev1.ConditionFalse {
+		fmt.Fprintf(cmd.OutOrStdout(), "\nWarning: %s\n", condition.Message)
+	}
+
+	return nil
+}
+
+func formatExecutionTime(t *metav1.Time) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.Format(time.RFC3339)
+}
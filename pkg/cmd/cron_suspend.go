@@ -0,0 +1,160 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/client"
+)
+
+const cronSuspendTraitAnnotation = v1.TraitAnnotationPrefix + "cron.suspend"
+
+func newCronSuspendCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *cronSuspendCommandOptions) {
+	options := cronSuspendCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+		suspend:        true,
+	}
+
+	cmd := cobra.Command{
+		Use:     "suspend <integration>",
+		Short:   "Suspend the scheduled executions of an Integration running as a CronJob",
+		Long:    `Suspend the scheduled executions of an Integration running as a CronJob, by setting the cron trait suspend parameter. The executions already started are not affected. The Pipe owning the Integration, if any, is updated instead.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("suspend expects exactly one Integration name")
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	return &cmd, &options
+}
+
+func newCronResumeCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *cronSuspendCommandOptions) {
+	options := cronSuspendCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+		suspend:        false,
+	}
+
+	cmd := cobra.Command{
+		Use:     "resume <integration>",
+		Short:   "Resume the scheduled executions of an Integration running as a CronJob",
+		Long:    `Resume the scheduled executions of an Integration running as a CronJob, previously suspended. The Pipe owning the Integration, if any, is updated instead.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("resume expects exactly one Integration name")
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	return &cmd, &options
+}
+
+type cronSuspendCommandOptions struct {
+	*RootCmdOptions
+
+	suspend bool
+}
+
+func (o *cronSuspendCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	existing, err := getIntegration(o.Context, c, args[0], o.Namespace)
+	if err != nil {
+		return err
+	}
+
+	kind := v1.IntegrationKind
+	name := existing.Name
+	if creatorKind, creatorName := findCreator(existing); creatorKind == v1.PipeKind && creatorName != "" {
+		// The Pipe would revert any change to the Integration it owns
+		kind, name = v1.PipeKind, creatorName
+		err = o.patchPipe(o.Context, c, existing.Namespace, name)
+	} else {
+		it := existing.DeepCopy()
+		it.Spec.Traits.Cron = o.cronTrait(it.Spec.Traits.Cron)
+		err = c.Patch(o.Context, it, ctrl.MergeFrom(existing))
+	}
+	if err != nil {
+		return fmt.Errorf("could not update %s %s: %w", kind, name, err)
+	}
+
+	if o.suspend {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s %s suspended\n", kind, name)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s %s resumed\n", kind, name)
+	}
+
+	return nil
+}
+
+// patchPipe sets the cron trait suspend parameter of the Pipe owning the Integration, either in its traits when
+// declared, or in its trait annotations otherwise.
+func (o *cronSuspendCommandOptions) patchPipe(ctx context.Context, c client.Client, namespace string, name string) error {
+	existing := v1.Pipe{}
+	if err := c.Get(ctx, ctrl.ObjectKey{Namespace: namespace, Name: name}, &existing); err != nil {
+		return err
+	}
+	pipe := existing.DeepCopy()
+	switch {
+	case pipe.Spec.Traits != nil:
+		pipe.Spec.Traits.Cron = o.cronTrait(pipe.Spec.Traits.Cron)
+	case o.suspend:
+		if pipe.Annotations == nil {
+			pipe.Annotations = make(map[string]string)
+		}
+		pipe.Annotations[cronSuspendTraitAnnotation] = "true"
+	default:
+		delete(pipe.Annotations, cronSuspendTraitAnnotation)
+	}
+
+	return c.Patch(ctx, pipe, ctrl.MergeFrom(&existing))
+}
+
+// cronTrait returns a copy of the given cron trait configuration with the suspend parameter set.
+func (o *cronSuspendCommandOptions) cronTrait(cron *traitv1.CronTrait) *traitv1.CronTrait {
+	if cron == nil {
+		cron = &traitv1.CronTrait{}
+	} else {
+		cron = cron.DeepCopy()
+	}
+	if o.suspend {
+		cron.Suspend = ptr.To(true)
+	} else {
+		cron.Suspend = nil
+	}
+
+	return cron
+}
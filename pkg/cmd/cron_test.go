@@ -0,0 +1,167 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const cmdCron = "cron"
+
+func executeCronCmd(t *testing.T, initObjs []runtime.Object, args ...string) (client.Client, string, error) {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	rootCmd.AddCommand(newCmdCron(options))
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	output, err := ExecuteCommand(rootCmd, append([]string{cmdCron}, args...)...)
+
+	return fakeClient, output, err
+}
+
+func TestCronSuspendResume(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	it.Spec.Traits.Cron = &traitv1.CronTrait{Schedule: "*/5 * * * *"}
+	c, output, err := executeCronCmd(t, []runtime.Object{&it}, "suspend", "my-it")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Integration my-it suspended")
+
+	updated := v1.NewIntegration("default", "my-it")
+	require.NoError(t, c.Get(context.Background(), k8sclient.ObjectKeyFromObject(&updated), &updated))
+	assert.True(t, ptr.Deref(updated.Spec.Traits.Cron.Suspend, false))
+	assert.Equal(t, "*/5 * * * *", updated.Spec.Traits.Cron.Schedule)
+
+	c, output, err = executeCronCmd(t, []runtime.Object{&updated}, "resume", "my-it")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Integration my-it resumed")
+	require.NoError(t, c.Get(context.Background(), k8sclient.ObjectKeyFromObject(&updated), &updated))
+	assert.Nil(t, updated.Spec.Traits.Cron.Suspend)
+}
+
+func TestCronSuspendResumePipe(t *testing.T) {
+	pipe := v1.NewPipe("default", "my-pipe")
+	it := v1.NewIntegration("default", "my-pipe")
+	it.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Kind:       v1.PipeKind,
+		Name:       "my-pipe",
+	}}
+	c, output, err := executeCronCmd(t, []runtime.Object{&it, &pipe}, "suspend", "my-pipe")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Pipe my-pipe suspended")
+
+	updated := v1.NewPipe("default", "my-pipe")
+	require.NoError(t, c.Get(context.Background(), k8sclient.ObjectKeyFromObject(&updated), &updated))
+	assert.Equal(t, "true", updated.Annotations["trait.camel.apache.org/cron.suspend"])
+
+	c, output, err = executeCronCmd(t, []runtime.Object{&it, &updated}, "resume", "my-pipe")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Pipe my-pipe resumed")
+	require.NoError(t, c.Get(context.Background(), k8sclient.ObjectKeyFromObject(&updated), &updated))
+	assert.NotContains(t, updated.Annotations, "trait.camel.apache.org/cron.suspend")
+
+	// the traits of the Pipe take precedence over its trait annotations
+	updated.Spec.Traits = &v1.Traits{Cron: &traitv1.CronTrait{Schedule: "*/5 * * * *"}}
+	c, _, err = executeCronCmd(t, []runtime.Object{&it, &updated}, "suspend", "my-pipe")
+	require.NoError(t, err)
+	require.NoError(t, c.Get(context.Background(), k8sclient.ObjectKeyFromObject(&updated), &updated))
+	assert.True(t, ptr.Deref(updated.Spec.Traits.Cron.Suspend, false))
+	assert.Equal(t, "*/5 * * * *", updated.Spec.Traits.Cron.Schedule)
+
+	// the Integration is left unchanged
+	require.NoError(t, c.Get(context.Background(), k8sclient.ObjectKeyFromObject(&it), &it))
+	assert.Nil(t, it.Spec.Traits.Cron)
+}
+
+func TestCronTrigger(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	cronJob := batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "my-it", UID: "123"},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "*/5 * * * *",
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					BackoffLimit: ptr.To(int32(2)),
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{v1.IntegrationLabel: "my-it"}},
+					},
+				},
+			},
+		},
+	}
+	c, output, err := executeCronCmd(t, []runtime.Object{&it, &cronJob}, "trigger", "my-it")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Integration my-it triggered with Job my-it-manual-")
+
+	jobs := batchv1.JobList{}
+	require.NoError(t, c.List(context.Background(), &jobs, k8sclient.InNamespace("default")))
+	require.Len(t, jobs.Items, 1)
+	job := jobs.Items[0]
+	assert.True(t, kubernetes.IsManualJob(job))
+	assert.Equal(t, "my-it", job.Labels[v1.IntegrationLabel])
+	assert.Equal(t, "my-it", job.OwnerReferences[0].Name)
+	assert.Equal(t, int32(2), *job.Spec.BackoffLimit)
+}
+
+func TestCronTriggerNotCronJob(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	_, _, err := executeCronCmd(t, []runtime.Object{&it}, "trigger", "my-it")
+	require.Error(t, err)
+	assert.Equal(t, "integration my-it is not running as a CronJob", err.Error())
+}
+
+func TestCronHistory(t *testing.T) {
+	start := metav1.NewTime(time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC))
+	it := v1.NewIntegration("default", "my-it")
+	it.Status.Cron = &v1.CronStatus{
+		ConsecutiveFailures: 1,
+		Executions: []v1.CronExecution{
+			{Job: "my-it-manual-abcde", Manual: true, Outcome: v1.CronExecutionOutcomeRunning, StartTime: &start},
+			{Job: "my-it-29345", Pod: "my-it-29345-xyz", Outcome: v1.CronExecutionOutcomeFailed, StartTime: &start, CompletionTime: &start},
+		},
+	}
+	it.Status.SetCondition(v1.IntegrationConditionCronJobExecutions, corev1.ConditionFalse,
+		v1.IntegrationConditionCronJobConsecutiveFailuresReason, "1 consecutive executions failed")
+	_, output, err := executeCronCmd(t, []runtime.Object{&it}, "history", "my-it")
+	require.NoError(t, err)
+	assert.Regexp(t, `my-it-manual-abcde\s+manual\s+2026-10-19T10:00:00Z\s+-\s+Running`, output)
+	assert.Regexp(t, `my-it-29345\s+schedule\s+2026-10-19T10:00:00Z\s+2026-10-19T10:00:00Z\s+Failed\s+my-it-29345-xyz`, output)
+	assert.Contains(t, output, "Consecutive failures: 1")
+	assert.Contains(t, output, "Warning: 1 consecutive executions failed")
+}
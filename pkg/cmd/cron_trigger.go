@@ -0,0 +1,84 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	batchv1 "k8s.io/api/batch/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func newCronTriggerCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *cronTriggerCommandOptions) {
+	options := cronTriggerCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "trigger <integration>",
+		Short:   "Run an Integration running as a CronJob immediately",
+		Long:    `Run an Integration running as a CronJob immediately, by creating a Job out of the CronJob template, regardless of its schedule.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("trigger expects exactly one Integration name")
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	return &cmd, &options
+}
+
+type cronTriggerCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (o *cronTriggerCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	it, err := getIntegration(o.Context, c, args[0], o.Namespace)
+	if err != nil {
+		return err
+	}
+	cronJob := batchv1.CronJob{}
+	if err := c.Get(o.Context, k8sclient.ObjectKey{Namespace: it.Namespace, Name: it.Name}, &cronJob); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("integration %s is not running as a CronJob", it.Name)
+		}
+
+		return err
+	}
+
+	job := kubernetes.NewManualJobFromCronJob(&cronJob)
+	if err := c.Create(o.Context, job); err != nil {
+		return fmt.Errorf("could not trigger Integration %s: %w", it.Name, err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Integration %s triggered with Job %s\n", it.Name, job.Name)
+
+	return nil
+}
@@ -0,0 +1,196 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/metadata"
+	"github.com/apache/camel-k/v2/pkg/util"
+)
+
+const (
+	dependenciesFormatTable = "table"
+	dependenciesFormatJSON  = "json"
+	dependenciesFormatYAML  = "yaml"
+)
+
+func newCmdDependencies(rootCmdOptions *RootCmdOptions) (*cobra.Command, *dependenciesCmdOptions) {
+	options := dependenciesCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:   "dependencies [integration]",
+		Short: "Explain why the dependencies of an Integration are required",
+		Long: `Show the origin of each dependency of an Integration: the source and line, URI, rule or language requiring it, ` +
+			`or the Kamelet, trait or capability adding it. The origins are read from the Integration status when the ` +
+			`dependencies provenance is enabled (-t dependencies.provenance=true), otherwise they are computed from the Integration sources.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(); err != nil {
+				return err
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().String("dependency", "", "Only show the dependencies containing the given text")
+	cmd.Flags().StringP("output", "o", dependenciesFormatTable, "Output format. One of: table|json|yaml")
+
+	return &cmd, &options
+}
+
+type dependenciesCmdOptions struct {
+	*RootCmdOptions
+	Dependency   string `mapstructure:"dependency" yaml:",omitempty"`
+	OutputFormat string `mapstructure:"output" yaml:",omitempty"`
+}
+
+func (o *dependenciesCmdOptions) validate() error {
+	switch o.OutputFormat {
+	case dependenciesFormatTable, dependenciesFormatJSON, dependenciesFormatYAML:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format option '%s', should be one of: table|json|yaml", o.OutputFormat)
+	}
+}
+
+func (o *dependenciesCmdOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	it := v1.NewIntegration(o.Namespace, args[0])
+	if err := c.Get(o.Context, ctrl.ObjectKeyFromObject(&it), &it); err != nil {
+		return err
+	}
+
+	origins := it.Status.DependencyOrigins
+	if len(origins) == 0 {
+		origins, err = o.computeDependencyOrigins(c, &it)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.ErrOrStderr(), "Dependency origins computed from the Integration sources: "+
+			"enable the dependencies provenance (-t dependencies.provenance=true) to include the Kamelets, traits and capabilities")
+	}
+	origins = withUnknownDependencies(origins, it.Status.Dependencies)
+	if o.Dependency != "" {
+		origins = slices.DeleteFunc(origins, func(origin v1.DependencyOrigin) bool {
+			return !strings.Contains(origin.Dependency, o.Dependency)
+		})
+	}
+	slices.SortStableFunc(origins, func(a, b v1.DependencyOrigin) int {
+		return cmp.Or(
+			strings.Compare(a.Dependency, b.Dependency),
+			strings.Compare(string(a.Type), string(b.Type)),
+		)
+	})
+
+	switch o.OutputFormat {
+	case dependenciesFormatJSON:
+		data, err := json.MarshalIndent(origins, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(data))
+
+		return nil
+	case dependenciesFormatYAML:
+		data, err := json.Marshal(origins)
+		if err != nil {
+			return err
+		}
+		yamlData, err := util.JSONToYAML(data)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+
+		return nil
+	default:
+		return printDependencyOrigins(cmd, origins)
+	}
+}
+
+// computeDependencyOrigins inspects the Integration sources to find the origin of the dependencies they require.
+func (o *dependenciesCmdOptions) computeDependencyOrigins(c ctrl.Reader, it *v1.Integration) ([]v1.DependencyOrigin, error) {
+	origins := make([]v1.DependencyOrigin, 0)
+	for _, d := range it.Spec.Dependencies {
+		origins = append(origins, v1.DependencyOrigin{Dependency: d, Type: v1.DependencyOriginSpec})
+	}
+	sources := it.OriginalSourcesOnly()
+	if len(sources) == 0 {
+		return origins, nil
+	}
+	for i := range sources {
+		if err := resolveTopologySource(o.Context, c, it.Namespace, &sources[i]); err != nil {
+			return nil, err
+		}
+	}
+	catalog, err := createCamelCatalog()
+	if err != nil {
+		return nil, err
+	}
+	meta, err := metadata.ExtractAll(catalog, sources)
+	if err != nil {
+		return nil, fmt.Errorf("cannot inspect the Integration sources: %w", err)
+	}
+
+	return append(origins, meta.DependencyOrigins...), nil
+}
+
+// withUnknownDependencies adds the dependencies which have no recorded origin.
+func withUnknownDependencies(origins []v1.DependencyOrigin, dependencies []string) []v1.DependencyOrigin {
+	for _, d := range dependencies {
+		if !slices.ContainsFunc(origins, func(origin v1.DependencyOrigin) bool { return origin.Dependency == d }) {
+			origins = append(origins, v1.DependencyOrigin{Dependency: d})
+		}
+	}
+
+	return origins
+}
+
+func printDependencyOrigins(cmd *cobra.Command, origins []v1.DependencyOrigin) error {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "DEPENDENCY\tORIGIN\tDETAIL\tLOCATION")
+	for _, origin := range origins {
+		originType := string(origin.Type)
+		if originType == "" {
+			originType = "unknown"
+		}
+		location := origin.Source
+		if location != "" && origin.Line > 0 {
+			location = fmt.Sprintf("%s:%d", origin.Source, origin.Line)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", origin.Dependency, originType, origin.Detail, location)
+	}
+
+	return w.Flush()
+}
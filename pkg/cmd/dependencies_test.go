@@ -0,0 +1,99 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+const cmdDependencies = "dependencies"
+
+func executeDependenciesCmd(t *testing.T, initObjs []runtime.Object, args ...string) (string, error) {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	dependenciesCmd, _ := newCmdDependencies(options)
+	rootCmd.AddCommand(dependenciesCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return ExecuteCommand(rootCmd, append([]string{cmdDependencies}, args...)...)
+}
+
+func TestDependenciesFromStatus(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	it.Status.Dependencies = []string{"camel:kafka", "camel:log", "mvn:org.foo:bar"}
+	it.Status.DependencyOrigins = []v1.DependencyOrigin{
+		{Dependency: "camel:kafka", Type: v1.DependencyOriginURI, Source: "route.yaml", Line: 3, Detail: "kafka:orders"},
+		{Dependency: "camel:log", Type: v1.DependencyOriginKamelet, Detail: "log-sink"},
+	}
+
+	output, err := executeDependenciesCmd(t, []runtime.Object{&it}, "my-it")
+	require.NoError(t, err)
+	assert.Contains(t, output, "DEPENDENCY")
+	assert.Regexp(t, `camel:kafka\s+uri\s+kafka:orders\s+route.yaml:3`, output)
+	assert.Regexp(t, `camel:log\s+kamelet\s+log-sink`, output)
+	assert.Regexp(t, `mvn:org.foo:bar\s+unknown`, output)
+	assert.NotContains(t, output, "computed from the Integration sources")
+}
+
+func TestDependenciesFromSources(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	it.Spec.Dependencies = []string{"mvn:org.foo:bar"}
+	it.Spec.Sources = []v1.SourceSpec{
+		v1.NewSourceSpec("route.yaml", `
+- from:
+    uri: "timer:tick"
+    steps:
+      - to: "kafka:orders?brokers=my-cluster:9092"
+`, v1.LanguageYaml),
+	}
+
+	output, err := executeDependenciesCmd(t, []runtime.Object{&it}, "my-it", "--dependency", "kafka", "-o", "json")
+	require.NoError(t, err)
+	// the hint is printed before the JSON document
+	hint, document, found := strings.Cut(output, "\n")
+	require.True(t, found)
+	assert.Contains(t, hint, "computed from the Integration sources")
+	var origins []v1.DependencyOrigin
+	require.NoError(t, json.Unmarshal([]byte(document), &origins))
+	assert.Equal(t, []v1.DependencyOrigin{
+		{
+			Dependency: "camel:kafka",
+			Type:       v1.DependencyOriginURI,
+			Source:     "route.yaml",
+			Line:       5,
+			Detail:     "kafka:orders?brokers=my-cluster:9092",
+		},
+	}, origins)
+}
+
+func TestDependenciesInvalidOutput(t *testing.T) {
+	_, err := executeDependenciesCmd(t, nil, "my-it", "-o", "xml")
+	require.EqualError(t, err, "invalid output format option 'xml', should be one of: table|json|yaml")
+}
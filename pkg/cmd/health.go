@@ -0,0 +1,182 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util"
+)
+
+const (
+	healthFormatTable = "table"
+	healthFormatJSON  = "json"
+	healthFormatYAML  = "yaml"
+)
+
+func newCmdHealth(rootCmdOptions *RootCmdOptions) (*cobra.Command, *healthCmdOptions) {
+	options := healthCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:   "health [integration]",
+		Short: "Show the health of the routes, consumers and components of an Integration",
+		Long: `Show the health checks of the Camel runtime in each Pod of an Integration, and the last changes of their status. ` +
+			`The health checks are reported by the operator from the readiness probe of the Pods, enabled by the health trait.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(); err != nil {
+				return err
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().Bool("down", false, "Only show the health checks which are down")
+	cmd.Flags().Bool("details", false, "Show the details reported by the health checks which are down")
+	cmd.Flags().StringP("output", "o", healthFormatTable, "Output format. One of: table|json|yaml")
+
+	return &cmd, &options
+}
+
+type healthCmdOptions struct {
+	*RootCmdOptions
+	Down         bool   `mapstructure:"down" yaml:",omitempty"`
+	Details      bool   `mapstructure:"details" yaml:",omitempty"`
+	OutputFormat string `mapstructure:"output" yaml:",omitempty"`
+}
+
+func (o *healthCmdOptions) validate() error {
+	switch o.OutputFormat {
+	case healthFormatTable, healthFormatJSON, healthFormatYAML:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format option '%s', should be one of: table|json|yaml", o.OutputFormat)
+	}
+}
+
+func (o *healthCmdOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	it := v1.NewIntegration(o.Namespace, args[0])
+	if err := c.Get(o.Context, ctrl.ObjectKeyFromObject(&it), &it); err != nil {
+		return err
+	}
+
+	health := v1.HealthStatus{}
+	if it.Status.Health != nil {
+		health = *it.Status.Health.DeepCopy()
+	}
+	if o.Down {
+		health.Checks = slices.DeleteFunc(health.Checks, func(check v1.HealthCheckReport) bool {
+			return check.Status == v1.HealthCheckStatusUp
+		})
+		for i := range health.Checks {
+			health.Checks[i].Pods = slices.DeleteFunc(health.Checks[i].Pods, func(pod v1.PodHealthCheck) bool {
+				return pod.Status == v1.HealthCheckStatusUp
+			})
+		}
+	}
+
+	switch o.OutputFormat {
+	case healthFormatJSON:
+		data, err := json.MarshalIndent(health, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(data))
+
+		return nil
+	case healthFormatYAML:
+		data, err := json.Marshal(health)
+		if err != nil {
+			return err
+		}
+		yamlData, err := util.JSONToYAML(data)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(cmd.OutOrStdout(), string(yamlData))
+
+		return nil
+	default:
+		if it.Status.Health == nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "No health check reported for Integration %s: make sure the health trait is enabled\n", it.Name)
+
+			return nil
+		}
+
+		return o.printHealth(cmd, health)
+	}
+}
+
+func (o *healthCmdOptions) printHealth(cmd *cobra.Command, health v1.HealthStatus) error {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+	if o.Details {
+		fmt.Fprintln(w, "CHECK\tKIND\tPOD\tSTATUS\tMESSAGE\tDETAILS")
+	} else {
+		fmt.Fprintln(w, "CHECK\tKIND\tPOD\tSTATUS\tMESSAGE")
+	}
+	for _, check := range health.Checks {
+		for _, pod := range check.Pods {
+			if o.Details {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", check.Name, check.Kind, pod.Pod, pod.Status, pod.Message, string(pod.Data))
+			} else {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", check.Name, check.Kind, pod.Pod, pod.Status, pod.Message)
+			}
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(health.ProbeFailures) > 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "\nPods not probed:")
+		w = tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+		fmt.Fprintln(w, "POD\tMESSAGE")
+		for _, f := range health.ProbeFailures {
+			fmt.Fprintf(w, "%s\t%s\n", f.Pod, f.Message)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	if len(health.Transitions) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), "\nLast transitions:")
+	w = tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "TIME\tCHECK\tPOD\tSTATUS\tMESSAGE")
+	for _, t := range health.Transitions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", t.Time.Format(time.RFC3339), t.Check, t.Pod, t.Status, t.Message)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,133 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+const cmdHealth = "health"
+
+func executeHealthCmd(t *testing.T, initObjs []runtime.Object, args ...string) (string, error) {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	healthCmd, _ := newCmdHealth(options)
+	rootCmd.AddCommand(healthCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return ExecuteCommand(rootCmd, append([]string{cmdHealth}, args...)...)
+}
+
+func integrationWithHealth() *v1.Integration {
+	it := v1.NewIntegration("default", "my-it")
+	it.Status.Health = &v1.HealthStatus{
+		Checks: []v1.HealthCheckReport{
+			{
+				Name:   "context",
+				Kind:   v1.HealthCheckKindContext,
+				ID:     "camel-1",
+				Status: v1.HealthCheckStatusUp,
+				Pods: []v1.PodHealthCheck{
+					{Pod: "my-it-a", Status: v1.HealthCheckStatusUp},
+					{Pod: "my-it-b", Status: v1.HealthCheckStatusUp},
+				},
+			},
+			{
+				Name:   "route:route1",
+				Kind:   v1.HealthCheckKindRoute,
+				ID:     "route1",
+				Status: v1.HealthCheckStatusDown,
+				Pods: []v1.PodHealthCheck{
+					{Pod: "my-it-a", Status: v1.HealthCheckStatusUp},
+					{
+						Pod:     "my-it-b",
+						Status:  v1.HealthCheckStatusDown,
+						Message: "route is stopped",
+						Data:    v1.RawMessage(`{"route.status":"Stopped"}`),
+					},
+				},
+			},
+		},
+		Transitions: []v1.HealthCheckTransition{
+			{
+				Check:   "route:route1",
+				Pod:     "my-it-b",
+				Status:  v1.HealthCheckStatusDown,
+				Message: "route is stopped",
+				Time:    metav1.NewTime(time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)),
+			},
+		},
+		ProbeFailures: []v1.PodHealthProbeFailure{
+			{Pod: "my-it-c", Message: "readiness probe failed: connection refused"},
+		},
+	}
+
+	return &it
+}
+
+func TestHealthTable(t *testing.T) {
+	output, err := executeHealthCmd(t, []runtime.Object{integrationWithHealth()}, "my-it")
+	require.NoError(t, err)
+	assert.Regexp(t, `context\s+Context\s+my-it-a\s+UP`, output)
+	assert.Regexp(t, `route:route1\s+Route\s+my-it-b\s+DOWN\s+route is stopped`, output)
+	assert.Regexp(t, `my-it-c\s+readiness probe failed: connection refused`, output)
+	assert.Regexp(t, `2026-01-01T10:00:00Z\s+route:route1\s+my-it-b\s+DOWN\s+route is stopped`, output)
+	assert.NotContains(t, output, "route.status")
+}
+
+func TestHealthDownWithDetails(t *testing.T) {
+	output, err := executeHealthCmd(t, []runtime.Object{integrationWithHealth()}, "my-it", "--down", "--details")
+	require.NoError(t, err)
+	assert.NotContains(t, output, "my-it-a")
+	assert.Regexp(t, `route:route1\s+Route\s+my-it-b\s+DOWN\s+route is stopped\s+\{"route.status":"Stopped"\}`, output)
+}
+
+func TestHealthJSON(t *testing.T) {
+	output, err := executeHealthCmd(t, []runtime.Object{integrationWithHealth()}, "my-it", "-o", "json")
+	require.NoError(t, err)
+	health := v1.HealthStatus{}
+	require.NoError(t, json.Unmarshal([]byte(output), &health))
+	assert.Len(t, health.Checks, 2)
+	assert.Len(t, health.Transitions, 1)
+}
+
+func TestHealthNotReported(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	output, err := executeHealthCmd(t, []runtime.Object{&it}, "my-it")
+	require.NoError(t, err)
+	assert.Contains(t, output, "No health check reported for Integration my-it")
+}
+
+func TestHealthInvalidOutput(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	_, err := executeHealthCmd(t, []runtime.Object{&it}, "my-it", "-o", "xml")
+	require.EqualError(t, err, "invalid output format option 'xml', should be one of: table|json|yaml")
+}
@@ -0,0 +1,206 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/kamelet/repository"
+	"github.com/apache/camel-k/v2/pkg/platform"
+)
+
+func newCmdKamelet(rootCmdOptions *RootCmdOptions) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "kamelet",
+		Short: "Browse the Kamelet catalog",
+		Long:  `Browse the Kamelets available to the Integrations running in the current namespace.`,
+	}
+
+	cmd.AddCommand(cmdOnly(newKameletListCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newKameletSearchCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newKameletShowCmd(rootCmdOptions)))
+
+	return &cmd
+}
+
+// kameletSummary is the short representation of a Kamelet, as printed by the list and search commands.
+type kameletSummary struct {
+	Name         string `json:"name"`
+	Type         string `json:"type,omitempty"`
+	Provider     string `json:"provider,omitempty"`
+	SupportLevel string `json:"supportLevel,omitempty"`
+	Version      string `json:"version,omitempty"`
+	Title        string `json:"title,omitempty"`
+	Description  string `json:"description,omitempty"`
+	Deprecated   bool   `json:"deprecated,omitempty"`
+}
+
+func newKameletSummary(kamelet *v1.Kamelet) kameletSummary {
+	summary := kameletSummary{
+		Name:         kamelet.Name,
+		Type:         kamelet.Labels[v1.KameletTypeLabel],
+		Provider:     kamelet.Annotations[v1.KameletProviderAnnotation],
+		SupportLevel: kamelet.Annotations[v1.KameletSupportLevelAnnotation],
+		Version:      kamelet.Annotations[v1.KameletCatalogVersionAnnotation],
+		Deprecated:   kamelet.IsDeprecated(),
+	}
+	if kamelet.Spec.Definition != nil {
+		summary.Title = kamelet.Spec.Definition.Title
+		summary.Description = kamelet.Spec.Definition.Description
+	}
+
+	return summary
+}
+
+// kameletFilter selects the Kamelets matching all the given (non empty) criteria.
+type kameletFilter struct {
+	Type         string
+	Provider     string
+	SupportLevel string
+	Version      string
+	// Terms must all be contained (case insensitive) in the Kamelet name, title or description
+	Terms []string
+}
+
+func (f kameletFilter) matches(kamelet *v1.Kamelet) bool {
+	if f.Type != "" && !strings.EqualFold(kamelet.Labels[v1.KameletTypeLabel], f.Type) {
+		return false
+	}
+	if f.Provider != "" && !strings.EqualFold(kamelet.Annotations[v1.KameletProviderAnnotation], f.Provider) {
+		return false
+	}
+	if f.SupportLevel != "" && !strings.EqualFold(kamelet.Annotations[v1.KameletSupportLevelAnnotation], f.SupportLevel) {
+		return false
+	}
+	if f.Version != "" && kamelet.Annotations[v1.KameletCatalogVersionAnnotation] != f.Version {
+		if _, ok := kamelet.Spec.Versions[f.Version]; !ok {
+			return false
+		}
+	}
+	if len(f.Terms) > 0 {
+		text := kamelet.Name
+		if kamelet.Spec.Definition != nil {
+			text += "\n" + kamelet.Spec.Definition.Title + "\n" + kamelet.Spec.Definition.Description
+		}
+		text = strings.ToLower(text)
+		for _, term := range f.Terms {
+			if !strings.Contains(text, strings.ToLower(term)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// newKameletRepository returns the repository looking up Kamelets in the given namespace and in the operator one.
+// When the operator namespace is not provided, the namespace of the default platform is used, if any.
+func newKameletRepository(o *RootCmdOptions, operatorNamespace string) (repository.KameletRepository, error) {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return nil, err
+	}
+	if operatorNamespace == "" {
+		// the platform may not be visible to the user: in such case we only look into the current namespace
+		if pl, _ := platform.LookupForPlatformName(o.Context, c, platform.DefaultPlatformName); pl != nil {
+			operatorNamespace = pl.Namespace
+		}
+	}
+
+	return repository.New(o.Context, c, o.Namespace, operatorNamespace)
+}
+
+func findKamelets(ctx context.Context, repo repository.KameletRepository, filter kameletFilter) ([]*v1.Kamelet, error) {
+	names, err := repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kamelets := make([]*v1.Kamelet, 0, len(names))
+	for _, name := range names {
+		kamelet, err := repo.Get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if kamelet != nil && filter.matches(kamelet) {
+			kamelets = append(kamelets, kamelet)
+		}
+	}
+
+	return kamelets, nil
+}
+
+func validateKameletOutputFormat(format string) error {
+	if format != "" && format != "json" {
+		return fmt.Errorf("invalid output format option '%s', should be one of: json", format)
+	}
+
+	return nil
+}
+
+func printKamelets(out io.Writer, kamelets []*v1.Kamelet, format string) error {
+	summaries := make([]kameletSummary, 0, len(kamelets))
+	for _, kamelet := range kamelets {
+		summaries = append(summaries, newKameletSummary(kamelet))
+	}
+	if format == "json" {
+		return printKameletJSON(out, summaries)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "NAME\tTYPE\tPROVIDER\tSUPPORT LEVEL\tVERSION\tTITLE")
+	for _, s := range summaries {
+		title := s.Title
+		if s.Deprecated {
+			title += " (deprecated)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", s.Name, s.Type, s.Provider, s.SupportLevel, s.Version, title)
+	}
+
+	return w.Flush()
+}
+
+func printKameletJSON(out io.Writer, value any) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, string(data))
+
+	return nil
+}
+
+// jsonValueString renders a JSON value as a plain string, without quoting strings.
+func jsonValueString(value *v1.JSON) string {
+	if value == nil || len(value.RawMessage) == 0 {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(value.RawMessage, &s); err == nil {
+		return s
+	}
+
+	return string(value.RawMessage)
+}
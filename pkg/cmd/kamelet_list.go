@@ -0,0 +1,91 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func newKameletListCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kameletListCommandOptions) {
+	options := kameletListCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List the available Kamelets",
+		Long:    `List the Kamelets available in the current namespace, in the operator namespace and in the default catalog.`,
+		Args:    cobra.NoArgs,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(cmd, args); err != nil {
+				return err
+			}
+
+			return options.run(cmd, nil)
+		},
+	}
+
+	addKameletFilterFlags(&cmd)
+
+	return &cmd, &options
+}
+
+func addKameletFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String("type", "", "Only include Kamelets of the given type. One of: source|sink|action")
+	cmd.Flags().String("provider", "", "Only include Kamelets from the given provider")
+	cmd.Flags().String("support-level", "", "Only include Kamelets with the given support level (ie, Stable, Preview)")
+	cmd.Flags().String("kamelet-version", "", "Only include Kamelets belonging to the given catalog version or providing the given version")
+	cmd.Flags().String("operator-namespace", "", "The namespace of the operator Kamelet catalog, defaults to the namespace of the default platform")
+	cmd.Flags().StringP("output", "o", "", "Output format. One of: json")
+}
+
+type kameletListCommandOptions struct {
+	*RootCmdOptions
+
+	Type              string `mapstructure:"type"`
+	Provider          string `mapstructure:"provider"`
+	SupportLevel      string `mapstructure:"support-level"`
+	KameletVersion    string `mapstructure:"kamelet-version"`
+	OperatorNamespace string `mapstructure:"operator-namespace"`
+	OutputFormat      string `mapstructure:"output"`
+}
+
+func (command *kameletListCommandOptions) validate(cmd *cobra.Command, args []string) error {
+	return validateKameletOutputFormat(command.OutputFormat)
+}
+
+func (command *kameletListCommandOptions) run(cmd *cobra.Command, terms []string) error {
+	repo, err := newKameletRepository(command.RootCmdOptions, command.OperatorNamespace)
+	if err != nil {
+		return err
+	}
+	filter := kameletFilter{
+		Type:         command.Type,
+		Provider:     command.Provider,
+		SupportLevel: command.SupportLevel,
+		Version:      command.KameletVersion,
+		Terms:        terms,
+	}
+	kamelets, err := findKamelets(command.Context, repo, filter)
+	if err != nil {
+		return err
+	}
+
+	return printKamelets(cmd.OutOrStdout(), kamelets, command.OutputFormat)
+}
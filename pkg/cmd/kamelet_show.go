@@ -0,0 +1,257 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func newKameletShowCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kameletShowCommandOptions) {
+	options := kameletShowCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "show <name>",
+		Short:   "Show the details of a Kamelet",
+		Long:    `Show the properties, data types and dependencies of a Kamelet, together with an example of how to bind it.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(cmd, args); err != nil {
+				return err
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().String("operator-namespace", "", "The namespace of the operator Kamelet catalog, defaults to the namespace of the default platform")
+	cmd.Flags().StringP("output", "o", "", "Output format. One of: json")
+
+	return &cmd, &options
+}
+
+type kameletShowCommandOptions struct {
+	*RootCmdOptions
+
+	OperatorNamespace string `mapstructure:"operator-namespace"`
+	OutputFormat      string `mapstructure:"output"`
+}
+
+// kameletDetail is the full representation of a Kamelet, as printed by the show command.
+type kameletDetail struct {
+	kameletSummary
+
+	Deprecation  *v1.KameletDeprecation `json:"deprecation,omitempty"`
+	Properties   []kameletProperty      `json:"properties,omitempty"`
+	DataTypes    []kameletDataTypes     `json:"dataTypes,omitempty"`
+	Dependencies []string               `json:"dependencies,omitempty"`
+	Example      string                 `json:"example"`
+}
+
+type kameletProperty struct {
+	Name        string   `json:"name"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	Format      string   `json:"format,omitempty"`
+	Required    bool     `json:"required"`
+	Default     string   `json:"default,omitempty"`
+	Example     string   `json:"example,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+	Deprecated  bool     `json:"deprecated,omitempty"`
+}
+
+type kameletDataTypes struct {
+	Slot    string   `json:"slot"`
+	Default string   `json:"default,omitempty"`
+	Types   []string `json:"types,omitempty"`
+}
+
+func (command *kameletShowCommandOptions) validate(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("show expects exactly one Kamelet name")
+	}
+
+	return validateKameletOutputFormat(command.OutputFormat)
+}
+
+func (command *kameletShowCommandOptions) run(cmd *cobra.Command, args []string) error {
+	repo, err := newKameletRepository(command.RootCmdOptions, command.OperatorNamespace)
+	if err != nil {
+		return err
+	}
+	kamelet, err := repo.Get(command.Context, args[0])
+	if err != nil {
+		return err
+	}
+	if kamelet == nil {
+		return fmt.Errorf("kamelet %q not found in %s", args[0], repo.String())
+	}
+
+	detail := newKameletDetail(kamelet)
+	if command.OutputFormat == "json" {
+		return printKameletJSON(cmd.OutOrStdout(), detail)
+	}
+
+	return printKameletDetail(cmd.OutOrStdout(), detail)
+}
+
+func newKameletDetail(kamelet *v1.Kamelet) kameletDetail {
+	detail := kameletDetail{
+		kameletSummary: newKameletSummary(kamelet),
+		Deprecation:    kamelet.Spec.Deprecation,
+		Dependencies:   kamelet.Spec.Dependencies,
+	}
+	if def := kamelet.Spec.Definition; def != nil {
+		for _, name := range kamelet.SortedDefinitionPropertiesKeys() {
+			prop := def.Properties[name]
+			p := kameletProperty{
+				Name:        name,
+				Title:       prop.Title,
+				Description: prop.Description,
+				Type:        prop.Type,
+				Format:      prop.Format,
+				Required:    slices.Contains(def.Required, name),
+				Default:     jsonValueString(prop.Default),
+				Example:     jsonValueString(prop.Example),
+				Deprecated:  prop.Deprecated,
+			}
+			for i := range prop.Enum {
+				p.Enum = append(p.Enum, jsonValueString(&prop.Enum[i]))
+			}
+			detail.Properties = append(detail.Properties, p)
+		}
+	}
+	for _, slot := range kamelet.SortedTypesKeys() {
+		spec := kamelet.Spec.DataTypes[slot]
+		detail.DataTypes = append(detail.DataTypes, kameletDataTypes{
+			Slot:    string(slot),
+			Default: spec.Default,
+			Types:   slices.Sorted(maps.Keys(spec.Types)),
+		})
+	}
+	detail.Example = kameletBindExample(kamelet.Name, detail.Type, detail.Properties)
+
+	return detail
+}
+
+// kameletBindExample returns a kamel bind command line using the Kamelet with all its required properties.
+func kameletBindExample(name string, kameletType string, properties []kameletProperty) string {
+	var args []string
+	var prefix string
+	switch kameletType {
+	case v1.KameletTypeSink:
+		args = []string{"timer-source", name}
+		prefix = sinkKey
+	case v1.KameletTypeAction:
+		args = []string{"timer-source", "log-sink", "--step", name}
+		prefix = stepKeyPrefix + "0"
+	default:
+		args = []string{name, "log-sink"}
+		prefix = sourceKey
+	}
+	if kameletType == v1.KameletTypeSink || kameletType == v1.KameletTypeAction {
+		args = append(args, "-p", `source.message="Hello"`)
+	}
+	for _, p := range properties {
+		if !p.Required {
+			continue
+		}
+		value := p.Example
+		if value == "" {
+			value = p.Default
+		}
+		if value == "" {
+			value = "<" + p.Name + ">"
+		}
+		args = append(args, "-p", fmt.Sprintf("%s.%s=%q", prefix, p.Name, value))
+	}
+
+	return "kamel bind " + strings.Join(args, " ")
+}
+
+func printKameletDetail(out io.Writer, detail kameletDetail) error {
+	w := tabwriter.NewWriter(out, 0, 8, 1, '\t', 0)
+	fmt.Fprintf(w, "Name:\t%s\n", detail.Name)
+	fmt.Fprintf(w, "Title:\t%s\n", detail.Title)
+	fmt.Fprintf(w, "Type:\t%s\n", detail.Type)
+	fmt.Fprintf(w, "Provider:\t%s\n", detail.Provider)
+	fmt.Fprintf(w, "Support Level:\t%s\n", detail.SupportLevel)
+	fmt.Fprintf(w, "Version:\t%s\n", detail.Version)
+	if detail.Deprecation != nil {
+		deprecation := detail.Deprecation.Message
+		if detail.Deprecation.Replacement != "" {
+			deprecation += fmt.Sprintf(" (replaced by %s)", detail.Deprecation.Replacement)
+		}
+		fmt.Fprintf(w, "Deprecated:\t%s\n", deprecation)
+	}
+	fmt.Fprintf(w, "Description:\t%s\n", detail.Description)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(detail.Properties) > 0 {
+		fmt.Fprintln(out, "\nProperties:")
+		w = tabwriter.NewWriter(out, 0, 8, 1, '\t', 0)
+		fmt.Fprintln(w, "NAME\tTYPE\tREQUIRED\tDEFAULT\tENUM\tTITLE")
+		for _, p := range detail.Properties {
+			title := p.Title
+			if p.Deprecated {
+				title += " (deprecated)"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\t%s\n", p.Name, p.Type, p.Required, p.Default, strings.Join(p.Enum, ","), title)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if len(detail.DataTypes) > 0 {
+		fmt.Fprintln(out, "\nData Types:")
+		w = tabwriter.NewWriter(out, 0, 8, 1, '\t', 0)
+		fmt.Fprintln(w, "SLOT\tDEFAULT\tTYPES")
+		for _, dt := range detail.DataTypes {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", dt.Slot, dt.Default, strings.Join(dt.Types, ","))
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if len(detail.Dependencies) > 0 {
+		fmt.Fprintln(out, "\nDependencies:")
+		for _, d := range detail.Dependencies {
+			fmt.Fprintf(out, "  %s\n", d)
+		}
+	}
+
+	fmt.Fprintf(out, "\nExample:\n  %s\n", detail.Example)
+
+	return nil
+}
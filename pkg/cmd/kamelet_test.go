@@ -0,0 +1,217 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+const cmdKamelet = "kamelet"
+
+func executeKameletCmd(t *testing.T, initObjs []runtime.Object, args ...string) (string, error) {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	rootCmd.AddCommand(newCmdKamelet(options))
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return ExecuteCommand(rootCmd, append([]string{cmdKamelet}, args...)...)
+}
+
+func executeKameletListCmd(t *testing.T, args ...string) []string {
+	t.Helper()
+	output, err := executeKameletCmd(t, testKamelets(), args...)
+	require.NoError(t, err)
+	var summaries []kameletSummary
+	require.NoError(t, json.Unmarshal([]byte(output), &summaries))
+	names := make([]string, 0, len(summaries))
+	for _, s := range summaries {
+		names = append(names, s.Name)
+	}
+
+	return names
+}
+
+func testKamelets() []runtime.Object {
+	timer := v1.NewKamelet("default", "timer-source")
+	timer.Labels = map[string]string{v1.KameletTypeLabel: v1.KameletTypeSource}
+	timer.Annotations = map[string]string{
+		v1.KameletProviderAnnotation:       "Apache Software Foundation",
+		v1.KameletSupportLevelAnnotation:   "Stable",
+		v1.KameletCatalogVersionAnnotation: "4.8.0",
+	}
+	timer.Spec.Definition = &v1.JSONSchemaProps{
+		Title:       "Timer Source",
+		Description: "Produces periodic messages with a custom payload.",
+		Required:    []string{"message"},
+		Properties: map[string]v1.JSONSchemaProp{
+			"message": {
+				Title:   "Message",
+				Type:    "string",
+				Example: &v1.JSON{RawMessage: []byte(`"hello world"`)},
+			},
+			"period": {
+				Title:   "Period",
+				Type:    "integer",
+				Default: &v1.JSON{RawMessage: []byte(`1000`)},
+			},
+			"contentType": {
+				Title:   "Content Type",
+				Type:    "string",
+				Default: &v1.JSON{RawMessage: []byte(`"text/plain"`)},
+				Enum: []v1.JSON{
+					{RawMessage: []byte(`"text/plain"`)},
+					{RawMessage: []byte(`"application/json"`)},
+				},
+			},
+		},
+	}
+	timer.Spec.DataTypes = map[v1.TypeSlot]v1.DataTypesSpec{
+		v1.TypeSlotOut: {
+			Default: "text",
+			Types: map[string]v1.DataTypeSpec{
+				"text":   {},
+				"binary": {},
+			},
+		},
+	}
+	timer.Spec.Dependencies = []string{"camel:timer", "camel:kamelet"}
+
+	log := v1.NewKamelet("default", "log-sink")
+	log.Labels = map[string]string{v1.KameletTypeLabel: v1.KameletTypeSink}
+	log.Annotations = map[string]string{
+		v1.KameletProviderAnnotation:       "Apache Software Foundation",
+		v1.KameletSupportLevelAnnotation:   "Stable",
+		v1.KameletCatalogVersionAnnotation: "4.8.0",
+	}
+	log.Spec.Definition = &v1.JSONSchemaProps{
+		Title:       "Log Sink",
+		Description: "Logs the messages it receives.",
+	}
+
+	http := v1.NewKamelet("default", "http-sink")
+	http.Labels = map[string]string{v1.KameletTypeLabel: v1.KameletTypeSink}
+	http.Annotations = map[string]string{
+		v1.KameletProviderAnnotation:     "Community",
+		v1.KameletSupportLevelAnnotation: "Preview",
+	}
+	http.Spec.Definition = &v1.JSONSchemaProps{
+		Title:       "HTTP Sink",
+		Description: "Forwards the messages to an HTTP endpoint.",
+		Required:    []string{"url"},
+		Properties: map[string]v1.JSONSchemaProp{
+			"url": {Title: "URL", Type: "string"},
+		},
+	}
+	http.Spec.Versions = map[string]v1.KameletSpecBase{
+		"v2": {},
+	}
+
+	return []runtime.Object{&timer, &log, &http}
+}
+
+func TestKameletList(t *testing.T) {
+	output, err := executeKameletCmd(t, testKamelets(), "list")
+	require.NoError(t, err)
+	assert.Equal(t, "NAME\t\tTYPE\tPROVIDER\t\t\tSUPPORT LEVEL\tVERSION\tTITLE\n"+
+		"http-sink\tsink\tCommunity\t\t\tPreview\t\t\tHTTP Sink\n"+
+		"log-sink\tsink\tApache Software Foundation\tStable\t\t4.8.0\tLog Sink\n"+
+		"timer-source\tsource\tApache Software Foundation\tStable\t\t4.8.0\tTimer Source\n", output)
+}
+
+func TestKameletListFilters(t *testing.T) {
+	assert.Equal(t, []string{"log-sink"},
+		executeKameletListCmd(t, "list", "--type", "sink", "--provider", "apache software foundation", "-o", "json"))
+	assert.Equal(t, []string{"http-sink"},
+		executeKameletListCmd(t, "list", "--support-level", "Preview", "-o", "json"))
+	assert.Equal(t, []string{"http-sink"},
+		executeKameletListCmd(t, "list", "--kamelet-version", "v2", "-o", "json"))
+	assert.Equal(t, []string{"log-sink", "timer-source"},
+		executeKameletListCmd(t, "list", "--kamelet-version", "4.8.0", "-o", "json"))
+
+	_, err := executeKameletCmd(t, testKamelets(), "list", "-o", "yaml")
+	require.EqualError(t, err, "invalid output format option 'yaml', should be one of: json")
+}
+
+func TestKameletSearch(t *testing.T) {
+	assert.Equal(t, []string{"http-sink", "log-sink", "timer-source"},
+		executeKameletListCmd(t, "search", "MESSAGES", "-o", "json"))
+	assert.Equal(t, []string{"http-sink"},
+		executeKameletListCmd(t, "search", "messages", "http", "-o", "json"))
+	assert.Empty(t, executeKameletListCmd(t, "search", "periodic", "--type", "sink", "-o", "json"))
+
+	_, err := executeKameletCmd(t, testKamelets(), "search")
+	require.Error(t, err)
+}
+
+func TestKameletShow(t *testing.T) {
+	output, err := executeKameletCmd(t, testKamelets(), "show", "timer-source")
+	require.NoError(t, err)
+	assert.Equal(t, `Name:		timer-source
+Title:		Timer Source
+Type:		source
+Provider:	Apache Software Foundation
+Support Level:	Stable
+Version:	4.8.0
+Description:	Produces periodic messages with a custom payload.
+
+Properties:
+NAME		TYPE	REQUIRED	DEFAULT		ENUM				TITLE
+contentType	string	false		text/plain	text/plain,application/json	Content Type
+message		string	true								Message
+period		integer	false		1000						Period
+
+Data Types:
+SLOT	DEFAULT	TYPES
+out	text	binary,text
+
+Dependencies:
+  camel:timer
+  camel:kamelet
+
+Example:
+  kamel bind timer-source log-sink -p source.message="hello world"
+`, output)
+}
+
+func TestKameletShowJSON(t *testing.T) {
+	output, err := executeKameletCmd(t, testKamelets(), "show", "http-sink", "-o", "json")
+	require.NoError(t, err)
+	var detail kameletDetail
+	require.NoError(t, json.Unmarshal([]byte(output), &detail))
+	assert.Equal(t, "http-sink", detail.Name)
+	assert.Equal(t, "Community", detail.Provider)
+	require.Len(t, detail.Properties, 1)
+	assert.Equal(t, "url", detail.Properties[0].Name)
+	assert.True(t, detail.Properties[0].Required)
+	assert.Equal(t, `kamel bind timer-source http-sink -p source.message="Hello" -p sink.url="<url>"`, detail.Example)
+
+	_, err = executeKameletCmd(t, testKamelets(), "show", "missing-sink")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `kamelet "missing-sink" not found`)
+}
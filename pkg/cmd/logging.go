@@ -0,0 +1,100 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+)
+
+// rootLoggerName is the name used on the command line for the root logger.
+const rootLoggerName = "root"
+
+func newCmdLogging(rootCmdOptions *RootCmdOptions) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "logging",
+		Short: "Change the logger levels of a running Integration",
+		Long: `Change the logger levels of a running Integration, on all its replicas and without restarting it.
+The Integration must expose the Jolokia agent (on the container port named "jolokia", or 8778).`,
+	}
+
+	cmd.AddCommand(cmdOnly(newLoggingSetCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newLoggingResetCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newLoggingGetCmd(rootCmdOptions)))
+
+	return &cmd
+}
+
+// updateLogLevels replaces the logger levels requested for the Integration with the result of the given function,
+// dropping the expired ones.
+func updateLogLevels(ctx context.Context, c client.Client, name, namespace string, update func([]v1.LogLevel) []v1.LogLevel) error {
+	existing, err := getIntegration(ctx, c, name, namespace)
+	if err != nil {
+		return err
+	}
+	levels, err := existing.GetLogLevels()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	active := make([]v1.LogLevel, 0, len(levels))
+	for _, l := range levels {
+		if l.Expiration == nil || l.Expiration.After(now) {
+			active = append(active, l)
+		}
+	}
+
+	it := existing.DeepCopy()
+	if err := it.SetLogLevels(update(active)); err != nil {
+		return err
+	}
+
+	return c.Patch(ctx, it, ctrl.MergeFrom(existing))
+}
+
+func loggerDisplayName(logger string) string {
+	if logger == "" {
+		return rootLoggerName
+	}
+
+	return logger
+}
+
+func loggerName(name string) string {
+	if name == rootLoggerName {
+		return ""
+	}
+
+	return name
+}
+
+func formatExpiration(l v1.LogLevel) string {
+	if l.Expiration == nil {
+		return "never"
+	}
+
+	return fmt.Sprintf("%s (in %s)", l.Expiration.Format(time.RFC3339), time.Until(l.Expiration.Time).Round(time.Second))
+}
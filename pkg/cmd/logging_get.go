@@ -0,0 +1,85 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func newLoggingGetCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *loggingGetCommandOptions) {
+	options := loggingGetCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "get <integration>",
+		Short:   "Show the logger levels changed at runtime",
+		Long:    `Show the logger levels changed at runtime of an Integration, as reported by the operator.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("get expects exactly one Integration name")
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	return &cmd, &options
+}
+
+type loggingGetCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (o *loggingGetCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	it, err := getIntegration(o.Context, c, args[0], o.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if len(it.Status.LogLevels) == 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "No logger level changed for Integration %s\n", it.Name)
+	} else {
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+		fmt.Fprintln(w, "LOGGER\tLEVEL\tREVERTED\tPODS")
+		for _, l := range it.Status.LogLevels {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", loggerDisplayName(l.Logger), l.Level, formatExpiration(l), strings.Join(l.Pods, ","))
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	if condition := it.Status.GetCondition(v1.IntegrationConditionLogLevels); condition != nil &&
+		condition.Reason == v1.IntegrationConditionLogLevelsFailedReason {
+		fmt.Fprintf(cmd.OutOrStdout(), "\nWarning: %s\n", condition.Message)
+	}
+
+	return nil
+}
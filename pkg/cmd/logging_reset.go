@@ -0,0 +1,82 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func newLoggingResetCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *loggingResetCommandOptions) {
+	options := loggingResetCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "reset <integration> [logger] ...",
+		Short:   "Revert the levels of loggers of a running Integration",
+		Long:    `Revert the levels of the given loggers of a running Integration, or of all of them when no logger is given.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("reset expects an Integration name")
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	return &cmd, &options
+}
+
+type loggingResetCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (o *loggingResetCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	loggers := make([]string, 0, len(args)-1)
+	for _, name := range args[1:] {
+		loggers = append(loggers, loggerName(name))
+	}
+
+	err = updateLogLevels(o.Context, c, args[0], o.Namespace, func(active []v1.LogLevel) []v1.LogLevel {
+		if len(loggers) == 0 {
+			return nil
+		}
+
+		return slices.DeleteFunc(active, func(l v1.LogLevel) bool {
+			return slices.Contains(loggers, l.Logger)
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("could not reset the logger levels of Integration %s: %w", args[0], err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Logger levels of Integration %s reset\n", args[0])
+
+	return nil
+}
@@ -0,0 +1,141 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// logLevels are the levels a logger can be set to.
+var logLevels = []string{"ALL", "TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL", "OFF"}
+
+func newLoggingSetCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *loggingSetCommandOptions) {
+	options := loggingSetCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:   "set <integration> <logger>=<level> ...",
+		Short: "Set the level of loggers of a running Integration",
+		Long: `Set the level of loggers of a running Integration, on all its replicas and without restarting it.
+Use "root" as the name of the root logger. The levels are reverted after the given duration, if any.`,
+		Example: `  kamel logging set my-integration org.apache.camel=DEBUG --duration 15m`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().Duration("duration", 0, "The duration after which the levels are reverted, such as 15m (never by default)")
+
+	return &cmd, &options
+}
+
+type loggingSetCommandOptions struct {
+	*RootCmdOptions
+
+	Duration time.Duration `mapstructure:"duration"`
+}
+
+func (o *loggingSetCommandOptions) validate(args []string) error {
+	if len(args) < 2 {
+		return errors.New("set expects an Integration name and at least one <logger>=<level> pair")
+	}
+	if o.Duration < 0 {
+		return errors.New("the duration must be positive")
+	}
+	_, err := parseLogLevels(args[1:])
+
+	return err
+}
+
+func (o *loggingSetCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	levels, err := parseLogLevels(args[1:])
+	if err != nil {
+		return err
+	}
+	if o.Duration > 0 {
+		expiration := metav1.NewTime(time.Now().Add(o.Duration)).Rfc3339Copy()
+		for i := range levels {
+			levels[i].Expiration = &expiration
+		}
+	}
+
+	err = updateLogLevels(o.Context, c, args[0], o.Namespace, func(active []v1.LogLevel) []v1.LogLevel {
+		active = slices.DeleteFunc(active, func(l v1.LogLevel) bool {
+			return slices.ContainsFunc(levels, func(n v1.LogLevel) bool {
+				return n.Logger == l.Logger
+			})
+		})
+
+		return append(active, levels...)
+	})
+	if err != nil {
+		return fmt.Errorf("could not set the logger levels of Integration %s: %w", args[0], err)
+	}
+
+	for _, l := range levels {
+		fmt.Fprintf(cmd.OutOrStdout(), "Logger %s set to %s, reverted: %s\n", loggerDisplayName(l.Logger), l.Level, formatExpiration(l))
+	}
+
+	return nil
+}
+
+// parseLogLevels parses the <logger>=<level> pairs given on the command line.
+func parseLogLevels(pairs []string) ([]v1.LogLevel, error) {
+	levels := make([]v1.LogLevel, 0, len(pairs))
+	for _, pair := range pairs {
+		logger, level, ok := strings.Cut(pair, "=")
+		if !ok || logger == "" {
+			return nil, fmt.Errorf("invalid logger level %q, expected <logger>=<level>", pair)
+		}
+		level = strings.ToUpper(level)
+		if !slices.Contains(logLevels, level) {
+			return nil, fmt.Errorf("invalid level %q for logger %s, expected one of %s", level, logger, strings.Join(logLevels, ", "))
+		}
+		l := v1.LogLevel{
+			Logger: loggerName(logger),
+			Level:  level,
+		}
+		if i := slices.IndexFunc(levels, func(n v1.LogLevel) bool { return n.Logger == l.Logger }); i >= 0 {
+			levels[i] = l
+		} else {
+			levels = append(levels, l)
+		}
+	}
+
+	return levels, nil
+}
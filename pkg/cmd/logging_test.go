@@ -0,0 +1,137 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+const cmdLogging = "logging"
+
+func executeLoggingCmd(t *testing.T, initObjs []runtime.Object, args ...string) (client.Client, string, error) {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	rootCmd.AddCommand(newCmdLogging(options))
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	output, err := ExecuteCommand(rootCmd, append([]string{cmdLogging}, args...)...)
+
+	return fakeClient, output, err
+}
+
+func getTestLogLevels(t *testing.T, c client.Client) []v1.LogLevel {
+	t.Helper()
+	it := v1.NewIntegration("default", "my-it")
+	require.NoError(t, c.Get(context.Background(), k8sclient.ObjectKeyFromObject(&it), &it))
+	levels, err := it.GetLogLevels()
+	require.NoError(t, err)
+
+	return levels
+}
+
+func TestLoggingSet(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	c, output, err := executeLoggingCmd(t, []runtime.Object{&it}, "set", "my-it", "org.apache.camel=debug", "root=WARN", "--duration", "15m")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Logger org.apache.camel set to DEBUG")
+	assert.Contains(t, output, "Logger root set to WARN")
+
+	levels := getTestLogLevels(t, c)
+	require.Len(t, levels, 2)
+	assert.Equal(t, "org.apache.camel", levels[0].Logger)
+	assert.Equal(t, "DEBUG", levels[0].Level)
+	assert.Empty(t, levels[1].Logger)
+	require.NotNil(t, levels[0].Expiration)
+	assert.WithinDuration(t, time.Now().Add(15*time.Minute), levels[0].Expiration.Time, time.Minute)
+}
+
+func TestLoggingSetMergesLevels(t *testing.T) {
+	expired := metav1.NewTime(time.Now().Add(-time.Minute))
+	it := v1.NewIntegration("default", "my-it")
+	require.NoError(t, it.SetLogLevels([]v1.LogLevel{
+		{Logger: "org.apache.camel", Level: "DEBUG"},
+		{Logger: "com.acme", Level: "TRACE"},
+		{Logger: "org.apache.kafka", Level: "DEBUG", Expiration: &expired},
+	}))
+	c, _, err := executeLoggingCmd(t, []runtime.Object{&it}, "set", "my-it", "org.apache.camel=INFO")
+	require.NoError(t, err)
+
+	assert.Equal(t, []v1.LogLevel{
+		{Logger: "com.acme", Level: "TRACE"},
+		{Logger: "org.apache.camel", Level: "INFO"},
+	}, getTestLogLevels(t, c))
+}
+
+func TestLoggingSetInvalid(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	_, _, err := executeLoggingCmd(t, []runtime.Object{&it}, "set", "my-it", "org.apache.camel=VERBOSE")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid level "VERBOSE" for logger org.apache.camel`)
+
+	_, _, err = executeLoggingCmd(t, []runtime.Object{&it}, "set", "my-it", "org.apache.camel")
+	require.Error(t, err)
+
+	_, _, err = executeLoggingCmd(t, []runtime.Object{&it}, "set", "my-it")
+	require.Error(t, err)
+}
+
+func TestLoggingReset(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	require.NoError(t, it.SetLogLevels([]v1.LogLevel{
+		{Logger: "org.apache.camel", Level: "DEBUG"},
+		{Logger: "", Level: "WARN"},
+	}))
+	c, _, err := executeLoggingCmd(t, []runtime.Object{&it}, "reset", "my-it", "root")
+	require.NoError(t, err)
+	assert.Equal(t, []v1.LogLevel{{Logger: "org.apache.camel", Level: "DEBUG"}}, getTestLogLevels(t, c))
+
+	c, _, err = executeLoggingCmd(t, []runtime.Object{&it}, "reset", "my-it")
+	require.NoError(t, err)
+	assert.Empty(t, getTestLogLevels(t, c))
+}
+
+func TestLoggingGet(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	it.Status.LogLevels = []v1.LogLevel{
+		{Logger: "org.apache.camel", Level: "DEBUG", Pods: []string{"my-it-1", "my-it-2"}},
+	}
+	it.Status.SetCondition(v1.IntegrationConditionLogLevels, corev1.ConditionFalse,
+		v1.IntegrationConditionLogLevelsFailedReason, `cannot set logger "org.apache.camel" to DEBUG in Pod my-it-3`)
+	_, output, err := executeLoggingCmd(t, []runtime.Object{&it}, "get", "my-it")
+	require.NoError(t, err)
+	assert.Contains(t, output, "LOGGER")
+	assert.Regexp(t, `org.apache.camel\s+DEBUG\s+never\s+my-it-1,my-it-2`, output)
+	assert.Contains(t, output, "Warning: cannot set logger")
+}
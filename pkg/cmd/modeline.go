@@ -25,7 +25,9 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/apache/camel-k/v2/pkg/client"
 	"github.com/apache/camel-k/v2/pkg/cmd/source"
+	"github.com/apache/camel-k/v2/pkg/trait"
 	"github.com/apache/camel-k/v2/pkg/util"
 	"github.com/apache/camel-k/v2/pkg/util/modeline"
 
@@ -135,6 +137,9 @@ func createKamelWithModelineCommand(ctx context.Context, args []string) (*cobra.
 	if err != nil {
 		return rootCmd, nil, fmt.Errorf("cannot read sources: %w", err)
 	}
+	if err := validateModelineOptions(ctx, target, opts); err != nil {
+		return rootCmd, nil, err
+	}
 
 	// Extract list of property/trait names already specified by the user.
 	cliParamNames := []string{}
@@ -192,8 +197,8 @@ func createKamelWithModelineCommand(ctx context.Context, args []string) (*cobra.
 	return rootCmd, args, nil
 }
 
-func extractModelineOptions(ctx context.Context, sources []string, cmd *cobra.Command) ([]modeline.Option, error) {
-	opts := make([]modeline.Option, 0)
+func extractModelineOptions(ctx context.Context, sources []string, cmd *cobra.Command) ([]modeline.LocatedOption, error) {
+	opts := make([]modeline.LocatedOption, 0)
 
 	resolvedSources, err := source.Resolve(ctx, sources, false, cmd)
 	if err != nil {
@@ -217,14 +222,18 @@ func extractModelineOptions(ctx context.Context, sources []string, cmd *cobra.Co
 	return opts, nil
 }
 
-func extractModelineOptionsFromSource(resolvedSource source.Source) ([]modeline.Option, error) {
-	ops, err := modeline.Parse(resolvedSource.Name, resolvedSource.Content)
+func extractModelineOptionsFromSource(resolvedSource source.Source) ([]modeline.LocatedOption, error) {
+	ops, err := modeline.ParseLocated(resolvedSource.Name, resolvedSource.Content)
 	if err != nil {
 		return ops, fmt.Errorf("cannot process file %s: %w", resolvedSource.Location, err)
 	}
 	for i, o := range ops {
+		if resolvedSource.Location != "" {
+			o.Source = resolvedSource.Location
+			ops[i] = o
+		}
 		if disallowedOptions[o.Name] {
-			return ops, fmt.Errorf("option %q is disallowed in modeline", o.Name)
+			return ops, fmt.Errorf("%s: option %q is disallowed in modeline", o.Location(), o.Name)
 		}
 
 		if fileOptions[o.Name] && resolvedSource.Local {
@@ -249,6 +258,56 @@ func extractModelineOptionsFromSource(resolvedSource source.Source) ([]modeline.
 	return ops, nil
 }
 
+// validateModelineOptions checks that the modeline options are flags of the target command, reporting the location
+// of the faulty ones. The trait options are also validated against the trait catalog, including the external traits
+// registered in the cluster when the options target the `addons` section.
+func validateModelineOptions(ctx context.Context, cmd *cobra.Command, opts []modeline.LocatedOption) error {
+	catalog := trait.NewCatalog(nil)
+	externalTraitsLoaded := false
+	for _, o := range opts {
+		if nonRunOptions[o.Name] {
+			continue
+		}
+		var flag *pflag.Flag
+		if len(o.Name) == 1 {
+			flag = cmd.Flags().ShorthandLookup(o.Name)
+		} else {
+			flag = cmd.Flag(o.Name)
+		}
+		if flag == nil {
+			return fmt.Errorf("%s: unknown modeline option %q", o.Location(), o.Name)
+		}
+		if flag.Name != "trait" {
+			continue
+		}
+		if strings.HasPrefix(o.Value, "addons.") && !externalTraitsLoaded {
+			externalTraitsLoaded = true
+			if err := loadExternalTraits(ctx, cmd, catalog); err != nil {
+				return fmt.Errorf("%s: cannot validate trait option %q: %w", o.Location(), o.Value, err)
+			}
+		}
+		if err := trait.ValidateTraitOption(catalog, o.Value); err != nil {
+			return fmt.Errorf("%s: invalid trait option %q: %w", o.Location(), o.Value, err)
+		}
+	}
+
+	return nil
+}
+
+// loadExternalTraits adds the external traits registered in the cluster to the catalog.
+func loadExternalTraits(ctx context.Context, cmd *cobra.Command, catalog *trait.Catalog) error {
+	kubeConfig, err := cmd.Flags().GetString("kube-config")
+	if err != nil {
+		return err
+	}
+	c, err := client.NewOutOfClusterClient(kubeConfig)
+	if err != nil {
+		return err
+	}
+
+	return catalog.LoadExternalTraits(ctx, c, "")
+}
+
 func getRefPathOrProperty(pathOrProperty string) string {
 	if strings.HasPrefix(pathOrProperty, "file:") {
 		return strings.Replace(pathOrProperty, "file:", "", 1)
@@ -265,7 +324,7 @@ func getFullPathOrProperty(pathOrProperty string, fullPath string) string {
 	return pathOrProperty
 }
 
-func expandModelineEnvVarOptions(ops []modeline.Option) ([]modeline.Option, error) {
+func expandModelineEnvVarOptions(ops []modeline.LocatedOption) ([]modeline.LocatedOption, error) {
 	// List of additional command line options with expanded values for variables
 	// marked as immediate environment variables.
 	//
@@ -274,7 +333,7 @@ func expandModelineEnvVarOptions(ops []modeline.Option) ([]modeline.Option, erro
 	// Values marked as {{env:ENV_VAR}} should be added too in their un-evaluated state.
 	// Their evaluation will occur at integration runtime.
 	//
-	listWithExpandedOptions := []modeline.Option{}
+	listWithExpandedOptions := []modeline.LocatedOption{}
 	for _, opt := range ops {
 		// Eliminate white spaces:
 		compactOptValue := strings.ReplaceAll(opt.Value, " ", "")
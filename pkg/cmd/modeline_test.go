@@ -288,18 +288,18 @@ func TestModelineRunDuplicateTraits(t *testing.T) {
 		require.NoError(t, err)
 
 		file := `
-		// camel-k: trait=trait1=false
-		// camel-k: trait=trait2=false
-		// camel-k: trait=foo=bar
+		// camel-k: trait=service.enabled=false
+		// camel-k: trait=route.enabled=false
+		// camel-k: trait=container.name=bar
 	`
 		fileName := filepath.Join(subDir, "simple.java")
 		err = os.WriteFile(fileName, []byte(file), 0o400)
 		require.NoError(t, err)
 
-		cmd, flags, err := NewKamelWithModelineCommand(context.TODO(), []string{"kamel", "run", fileName, "-t", "trait1=true", "--trait", "trait2=true"})
+		cmd, flags, err := NewKamelWithModelineCommand(context.TODO(), []string{"kamel", "run", fileName, "-t", "service.enabled=true", "--trait", "route.enabled=true"})
 		require.NoError(t, err)
 		assert.NotNil(t, cmd)
-		assert.Equal(t, []string{"run", fileName, "-t", "trait1=true", "--trait", "trait2=true", "--trait=foo=bar"}, flags)
+		assert.Equal(t, []string{"run", fileName, "-t", "service.enabled=true", "--trait", "route.enabled=true", "--trait=container.name=bar"}, flags)
 
 		return nil
 	})
@@ -423,3 +423,59 @@ func TestModelineQuotedPodTemplate(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestModelineRunUnknownOption(t *testing.T) {
+	err := util.WithTempDir("camel-k-test-", func(dir string) error {
+		file := `
+		// camel-k: dependency=mvn:org.my:lib:1.0
+		// camel-k: dependencies=mvn:org.my:lib:2.0
+	`
+		fileName := filepath.Join(dir, "simple.java")
+		err := os.WriteFile(fileName, []byte(file), 0o400)
+		require.NoError(t, err)
+
+		_, _, err = NewKamelWithModelineCommand(context.TODO(), []string{"kamel", "run", fileName})
+		require.EqualError(t, err, fileName+`:3: unknown modeline option "dependencies"`)
+
+		return nil
+	})
+
+	require.NoError(t, err)
+}
+
+func TestModelineRunDisallowedOption(t *testing.T) {
+	err := util.WithTempDir("camel-k-test-", func(dir string) error {
+		file := `
+		// camel-k: dev
+	`
+		fileName := filepath.Join(dir, "simple.java")
+		err := os.WriteFile(fileName, []byte(file), 0o400)
+		require.NoError(t, err)
+
+		_, _, err = NewKamelWithModelineCommand(context.TODO(), []string{"kamel", "run", fileName})
+		require.EqualError(t, err, "cannot read sources: "+fileName+`:2: option "dev" is disallowed in modeline`)
+
+		return nil
+	})
+
+	require.NoError(t, err)
+}
+
+func TestModelineRunInvalidTraitOption(t *testing.T) {
+	err := util.WithTempDir("camel-k-test-", func(dir string) error {
+		file := `
+		// camel-k: trait=service.enabled=false
+		// camel-k: trait=service.foo=bar
+	`
+		fileName := filepath.Join(dir, "simple.java")
+		err := os.WriteFile(fileName, []byte(file), 0o400)
+		require.NoError(t, err)
+
+		_, _, err = NewKamelWithModelineCommand(context.TODO(), []string{"kamel", "run", fileName})
+		require.EqualError(t, err, fileName+`:3: invalid trait option "service.foo=bar": unknown property of trait service: foo`)
+
+		return nil
+	})
+
+	require.NoError(t, err)
+}
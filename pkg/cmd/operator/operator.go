@@ -65,6 +65,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/defaults"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	logutil "github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/source"
 )
 
 var log = logutil.Log.WithName("cmd")
@@ -163,6 +164,9 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 		log.Info("WARN: Camel K operator is running outside a container. Some features (eg, running build in pod mode) may be disabled!")
 	}
 
+	err = loadSecurityRules(ctx, bootstrapClient)
+	exitOnError(err, "cannot load the security rules")
+
 	if !leaderElection {
 		log.Info("Leader election is disabled!")
 	}
@@ -187,9 +191,10 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 	}
 
 	selectors := map[ctrl.Object]cache.ByObject{
-		&corev1.Pod{}:        selector,
-		&appsv1.Deployment{}: selector,
-		&batchv1.Job{}:       selector,
+		&corev1.Pod{}:         selector,
+		&appsv1.Deployment{}:  selector,
+		&appsv1.StatefulSet{}: selector,
+		&batchv1.Job{}:        selector,
 	}
 
 	if ok, err := kubernetes.IsAPIResourceInstalled(bootstrapClient, servingv1.SchemeGroupVersion.String(),
@@ -332,6 +337,30 @@ func getOperatorImage(ctx context.Context, kubeClient client.Client) (string, er
 	)
 }
 
+// loadSecurityRules replaces the security rules shipped with the operator by the table referenced by SECURITY_RULES.
+func loadSecurityRules(ctx context.Context, c client.Client) error {
+	vs, err := platform.SecurityRules()
+	if err != nil {
+		return err
+	}
+	if vs.ConfigMapKeyRef == nil && vs.SecretKeyRef == nil {
+		source.SetSecurityRules(nil)
+
+		return nil
+	}
+	data, err := kubernetes.ResolveValueSource(ctx, c, platform.GetOperatorNamespace(), &vs)
+	if err != nil {
+		return fmt.Errorf("cannot read the security rules: %w", err)
+	}
+	rules, err := source.ParseSecurityRules([]byte(data))
+	if err != nil {
+		return err
+	}
+	source.SetSecurityRules(rules)
+
+	return nil
+}
+
 func exitOnError(err error, msg string) {
 	if err != nil {
 		log.Error(err, msg)
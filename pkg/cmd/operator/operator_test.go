@@ -23,6 +23,7 @@ import (
 
 	"github.com/apache/camel-k/v2/pkg/internal"
 	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/source"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -221,3 +222,42 @@ func TestGetOperatorImage(t *testing.T) {
 		assert.Empty(t, image)
 	})
 }
+
+func TestLoadSecurityRules(t *testing.T) {
+	t.Setenv("NAMESPACE", "operator-ns")
+	defer source.SetSecurityRules(nil)
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "operator-ns",
+			Name:      "security-rules",
+		},
+		Data: map[string]string{
+			"rules.yaml": "rules:\n- category: mail\n  scheme: smtp\n",
+			"invalid":    "rules:\n- scheme: smtp\n",
+		},
+	}
+	c, err := internal.NewFakeClient(cm)
+	require.NoError(t, err)
+	defaults, err := source.DefaultSecurityRules()
+	require.NoError(t, err)
+
+	// the shipped rules are kept by default
+	require.NoError(t, loadSecurityRules(context.TODO(), c))
+	rules, err := source.SecurityRules()
+	require.NoError(t, err)
+	assert.Equal(t, defaults, rules)
+
+	t.Setenv("SECURITY_RULES", "configmap:security-rules@rules.yaml")
+	require.NoError(t, loadSecurityRules(context.TODO(), c))
+	rules, err = source.SecurityRules()
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, "mail", rules[0].Category)
+
+	t.Setenv("SECURITY_RULES", "configmap:security-rules@invalid")
+	require.EqualError(t, loadSecurityRules(context.TODO(), c), "security rule 0: missing category")
+
+	t.Setenv("SECURITY_RULES", "configmap:missing@rules.yaml")
+	require.ErrorContains(t, loadSecurityRules(context.TODO(), c), "cannot read the security rules")
+}
@@ -139,7 +139,13 @@ func addKamelSubcommands(cmd *cobra.Command, options *RootCmdOptions) {
 	cmd.AddCommand(cmdOnly(newCmdGet(options)))
 	cmd.AddCommand(cmdOnly(newCmdDelete(options)))
 	cmd.AddCommand(cmdOnly(newCmdLog(options)))
+	cmd.AddCommand(newCmdLogging(options))
+	cmd.AddCommand(newCmdCron(options))
 	cmd.AddCommand(newCmdKit(options))
+	cmd.AddCommand(newCmdKamelet(options))
+	cmd.AddCommand(cmdOnly(newCmdTopology(options)))
+	cmd.AddCommand(cmdOnly(newCmdDependencies(options)))
+	cmd.AddCommand(cmdOnly(newCmdHealth(options)))
 	cmd.AddCommand(cmdOnly(newCmdReset(options)))
 	cmd.AddCommand(cmdOnly(newCmdRebuild(options)))
 	cmd.AddCommand(cmdOnly(newCmdOperator(options)))
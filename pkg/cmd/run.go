@@ -26,6 +26,7 @@ import (
 	"os"
 	"os/signal"
 	"path"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
@@ -65,7 +66,7 @@ func newCmdRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *runCmdOptions)
 	}
 
 	cmd := cobra.Command{
-		Use:               "run [file to run]",
+		Use:               "run [file or project directory to run]",
 		Short:             "Build and run the Integration on Kubernetes.",
 		Long:              `Build and run the Integration on Kubernetes.`,
 		Args:              options.validateArgs,
@@ -109,6 +110,10 @@ func newCmdRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *runCmdOptions)
 	cmd.Flags().StringArray("label", nil, "Add a label to the integration. E.g. \"--label my.company=hello\"")
 	cmd.Flags().StringArray("source", nil, "Add source file to your integration, "+
 		"this is added to the list of files listed as arguments of the command")
+	cmd.Flags().String("openapi", "", "Add an OpenAPI 3 document from which the REST services of your integration are generated, "+
+		"either a local file, an URL or a Configmap (syntax: configmap:name/key)")
+	cmd.Flags().Bool("openapi-client-request-validation", false, "Reject the requests not matching the content types, the required parameters, the enum values "+
+		"or the JSON body schemas of the operations declared in the OpenAPI document")
 	cmd.Flags().String("pod-template", "", "[Deprecated] The path of the YAML file containing a PodSpec template to be used for the Integration pods")
 	cmd.Flags().String("service-account", "", "The SA to use to run this Integration")
 	cmd.Flags().String("git", "", "A Git repository containing the project to build.")
@@ -163,11 +168,13 @@ type runCmdOptions struct {
 	Traits          []string `mapstructure:"traits"             yaml:",omitempty"`
 	Volumes         []string `mapstructure:"volumes"            yaml:",omitempty"`
 	// Deprecated: won't be supported in the future
-	EnvVars           []string `mapstructure:"envs"                 yaml:",omitempty"`
-	Labels            []string `mapstructure:"labels"               yaml:",omitempty"`
-	Annotations       []string `mapstructure:"annotations"          yaml:",omitempty"`
-	Sources           []string `mapstructure:"sources"              yaml:",omitempty"`
-	DontRunAfterBuild bool     `mapstructure:"dont-run-after-build" yaml:",omitempty"`
+	EnvVars                        []string `mapstructure:"envs"                              yaml:",omitempty"`
+	Labels                         []string `mapstructure:"labels"                            yaml:",omitempty"`
+	Annotations                    []string `mapstructure:"annotations"                       yaml:",omitempty"`
+	Sources                        []string `mapstructure:"sources"                           yaml:",omitempty"`
+	DontRunAfterBuild              bool     `mapstructure:"dont-run-after-build"              yaml:",omitempty"`
+	OpenAPI                        string   `mapstructure:"openapi"                           yaml:",omitempty"`
+	OpenAPIClientRequestValidation bool     `mapstructure:"openapi-client-request-validation" yaml:",omitempty"`
 }
 
 func (o *runCmdOptions) decode(cmd *cobra.Command, args []string) error {
@@ -264,6 +271,10 @@ func (o *runCmdOptions) validate(cmd *cobra.Command) error {
 		return errors.New("cannot use --dev with -o/--output option")
 	}
 
+	if o.OpenAPIClientRequestValidation && o.OpenAPI == "" {
+		return errors.New("cannot use --openapi-client-request-validation without --openapi option")
+	}
+
 	for _, label := range o.Labels {
 		parts := strings.Split(label, "=")
 		if len(parts) != 2 {
@@ -384,14 +395,17 @@ func (o *runCmdOptions) run(cmd *cobra.Command, args []string) error {
 	}
 	if o.Wait || o.Dev {
 		for {
-			integrationPhase, err := o.waitForIntegrationReady(cmd, c, integration)
+			integrationPhase, job, err := o.waitForIntegrationReady(cmd, c, integration)
 			if err != nil {
 				return err
 			}
 
 			if integrationPhase == nil || *integrationPhase == v1.IntegrationPhaseError {
 				return fmt.Errorf("integration \"%s\" deployment failed", integration.Name)
-			} else if *integrationPhase == v1.IntegrationPhaseRunning {
+			} else if *integrationPhase == v1.IntegrationPhaseFailed {
+				return fmt.Errorf("integration \"%s\" job failed", integration.Name)
+			} else if *integrationPhase == v1.IntegrationPhaseSucceeded ||
+				(*integrationPhase == v1.IntegrationPhaseRunning && !job) {
 				break
 			}
 
@@ -444,7 +458,10 @@ func (o *runCmdOptions) postRun(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func (o *runCmdOptions) waitForIntegrationReady(cmd *cobra.Command, c client.Client, integration *v1.Integration) (*v1.IntegrationPhase, error) {
+// waitForIntegrationReady waits for the Integration to be running, or to run to completion when it is deployed as a Job,
+// and returns the last observed phase, and whether the Integration is deployed as a Job.
+func (o *runCmdOptions) waitForIntegrationReady(cmd *cobra.Command, c client.Client, integration *v1.Integration) (*v1.IntegrationPhase, bool, error) {
+	job := false
 	handler := func(i *v1.Integration) bool {
 		//
 		// TODO when we add health checks, we should Wait until they are passed
@@ -453,14 +470,21 @@ func (o *runCmdOptions) waitForIntegrationReady(cmd *cobra.Command, c client.Cli
 			// TODO remove this log when we make sure that events are always created
 			fmt.Fprintf(cmd.OutOrStdout(), "Progress: integration %q in phase %s\n", integration.Name, string(i.Status.Phase))
 		}
-		if i.Status.Phase == v1.IntegrationPhaseRunning || i.Status.Phase == v1.IntegrationPhaseError {
+		job = i.IsConditionTrue(v1.IntegrationConditionJobAvailable)
+		switch i.Status.Phase {
+		case v1.IntegrationPhaseRunning:
+			// A Job is awaited until it runs to completion
+			return job
+		case v1.IntegrationPhaseError, v1.IntegrationPhaseSucceeded, v1.IntegrationPhaseFailed:
 			return false
 		}
 
 		return true
 	}
 
-	return watch.HandleIntegrationStateChanges(o.Context, c, integration, handler)
+	phase, err := watch.HandleIntegrationStateChanges(o.Context, c, integration, handler)
+
+	return phase, job, err
 }
 
 func (o *runCmdOptions) syncIntegration(cmd *cobra.Command, c client.Client, sources []string) error {
@@ -565,12 +589,17 @@ func (o *runCmdOptions) createOrUpdateIntegration(cmd *cobra.Command, c client.C
 	o.applyLabels(integration)
 	o.applyAnnotations(integration)
 
+	var projectResources []string
 	//nolint:gocritic
 	if o.isManaged() {
 		// Resolve resources
 		if err := o.resolveSources(cmd, sources, integration); err != nil {
 			return nil, err
 		}
+		projectResources, err = o.applyProjects(cmd, c, sources, integration)
+		if err != nil {
+			return nil, err
+		}
 	} else if o.ContainerImage != "" {
 		// Self Managed Integration as the user provided a container image built externally
 		o.Traits = append(o.Traits, "container.image="+o.ContainerImage)
@@ -644,7 +673,7 @@ func (o *runCmdOptions) createOrUpdateIntegration(cmd *cobra.Command, c client.C
 		if string(d) == "{}" {
 			fmt.Fprintln(cmd.OutOrStdout(), `Integration "`+name+`" unchanged`)
 
-			return integration, nil
+			return integration, o.bindProjectResources(c, integration, projectResources)
 		}
 		err = c.Patch(o.Context, integration, patch)
 		if err != nil {
@@ -653,6 +682,10 @@ func (o *runCmdOptions) createOrUpdateIntegration(cmd *cobra.Command, c client.C
 		fmt.Fprintln(cmd.OutOrStdout(), `Integration "`+name+`" updated`)
 	}
 
+	if err := o.bindProjectResources(c, integration, projectResources); err != nil {
+		return nil, err
+	}
+
 	return integration, nil
 }
 
@@ -752,6 +785,14 @@ func (o *runCmdOptions) resolveSources(cmd *cobra.Command, sources []string, it
 		return err
 	}
 
+	if o.OpenAPI != "" {
+		openAPISource, err := o.resolveOpenAPI(cmd)
+		if err != nil {
+			return err
+		}
+		it.Spec.AddSources(openAPISource)
+	}
+
 	for _, source := range resolvedSources {
 		if o.UseFlows && !o.Compression && source.IsYaml() {
 			flows, err := v1.FromYamlDSLString(source.Content)
@@ -773,6 +814,42 @@ func (o *runCmdOptions) resolveSources(cmd *cobra.Command, sources []string, it
 	return nil
 }
 
+// resolveOpenAPI returns the source holding the OpenAPI document, either read from a local file or an URL,
+// or referencing the key of a Configmap.
+func (o *runCmdOptions) resolveOpenAPI(cmd *cobra.Command) (v1.SourceSpec, error) {
+	if ref, ok := strings.CutPrefix(o.OpenAPI, "configmap:"); ok {
+		name, key, _ := strings.Cut(ref, "/")
+		if name == "" || key == "" {
+			return v1.SourceSpec{}, fmt.Errorf("invalid OpenAPI Configmap reference %s: expected configmap:name/key", o.OpenAPI)
+		}
+
+		return v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:       key,
+				ContentRef: name,
+				ContentKey: key,
+			},
+			Type: v1.SourceTypeOpenAPI,
+		}, nil
+	}
+
+	resolved, err := source.Resolve(context.Background(), []string{o.OpenAPI}, false, cmd)
+	if err != nil {
+		return v1.SourceSpec{}, err
+	}
+	if len(resolved) != 1 {
+		return v1.SourceSpec{}, fmt.Errorf("cannot resolve the OpenAPI document %s", o.OpenAPI)
+	}
+
+	return v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name:    resolved[0].Name,
+			Content: resolved[0].Content,
+		},
+		Type: v1.SourceTypeOpenAPI,
+	}, nil
+}
+
 func (o *runCmdOptions) convertOptionsToTraits(cmd *cobra.Command, c client.Client, it *v1.Integration) error {
 	if err := o.parseAndConvertToTrait(cmd, c, it, o.Resources, resource.ParseResource,
 		func(c *resource.Config) string { return c.String() },
@@ -793,6 +870,9 @@ func (o *runCmdOptions) convertOptionsToTraits(cmd *cobra.Command, c client.Clie
 		return err
 	}
 
+	if o.OpenAPIClientRequestValidation {
+		o.Traits = append(o.Traits, "camel.properties=camel.rest.client-request-validation=true")
+	}
 	for _, item := range o.Volumes {
 		o.Traits = append(o.Traits, "mount.volumes="+item)
 	}
@@ -904,7 +984,14 @@ func (o *runCmdOptions) GetIntegrationName(sources []string) (string, error) {
 		name = o.IntegrationName
 		name = kubernetes.SanitizeName(name)
 	case len(sources) == 1:
-		name = kubernetes.SanitizeName(sources[0])
+		src := sources[0]
+		if dir, _ := source.IsLocalDir(src); dir {
+			// a project directory may be given as a relative path such as "."
+			if abs, err := filepath.Abs(src); err == nil {
+				src = abs
+			}
+		}
+		name = kubernetes.SanitizeName(src)
 	case o.ContainerImage != "":
 		// Self managed build execution
 		name = kubernetes.SanitizeName(strings.ReplaceAll(o.ContainerImage, ":", "-v"))
@@ -0,0 +1,205 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/cmd/source"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/property"
+)
+
+// applyProjects maps the Camel JBang project directories found among the sources to the Integration.
+// The application properties and the declared Camel version configure the camel trait, the declared dependencies
+// are added to the Integration ones, the Kamelets are created in the Integration namespace and any other file is
+// mounted as a resource. The configuration provided
+// on the command line takes precedence over the one of the projects. The names of the Configmaps holding the
+// resources are returned, so that they can be bound to the Integration once created.
+func (o *runCmdOptions) applyProjects(cmd *cobra.Command, c client.Client, sources []string, it *v1.Integration) ([]string, error) {
+	locations := make([]string, 0, len(sources)+len(o.Sources))
+	locations = append(locations, sources...)
+	locations = append(locations, o.Sources...)
+
+	projectTraits := make([]string, 0)
+	var resources []string
+	resourcesIndex := 0
+	for _, location := range locations {
+		dir, err := source.IsLocalDir(location)
+		if err != nil {
+			return nil, err
+		}
+		if !dir {
+			continue
+		}
+		project, err := source.LoadProject(location)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range project.Properties.Keys() {
+			entry, err := property.EncodePropertyFileEntry(key, project.Properties.GetString(key, ""))
+			if err != nil {
+				return nil, err
+			}
+			projectTraits = append(projectTraits, convertToTrait(entry, "camel.properties"))
+		}
+		if project.CamelVersion != "" {
+			projectTraits = append(projectTraits, convertToTrait(project.CamelVersion, "camel.runtime-version"))
+		}
+		for _, dep := range project.Dependencies {
+			if !slices.Contains(o.Dependencies, dep) {
+				o.Dependencies = append(o.Dependencies, dep)
+			}
+		}
+		for _, location := range project.Kamelets {
+			if err := o.createProjectKamelet(cmd, c, it, location); err != nil {
+				return nil, err
+			}
+		}
+		if len(project.Resources) > 0 {
+			name := it.Name + "-resources"
+			if resourcesIndex > 0 {
+				name = fmt.Sprintf("%s-%d", name, resourcesIndex)
+			}
+			resourcesIndex++
+			if err := o.createProjectResources(cmd, c, it, name, project); err != nil {
+				return nil, err
+			}
+			resources = append(resources, name)
+			projectTraits = append(projectTraits, convertToTrait("configmap:"+name, "mount.resources"))
+		}
+	}
+
+	o.Traits = append(projectTraits, o.Traits...)
+
+	return resources, nil
+}
+
+// createProjectKamelet creates or replaces the Kamelet stored in the given project file in the Integration namespace.
+// The Kamelet is not bound to the Integration, as it may be used by other Integrations.
+func (o *runCmdOptions) createProjectKamelet(cmd *cobra.Command, c client.Client, it *v1.Integration, location string) error {
+	content, err := util.ReadFile(location)
+	if err != nil {
+		return err
+	}
+	data, err := yaml.ToJSON(content)
+	if err != nil {
+		return fmt.Errorf("cannot parse Kamelet %s: %w", location, err)
+	}
+	kamelet := v1.Kamelet{}
+	if err := json.Unmarshal(data, &kamelet); err != nil {
+		return fmt.Errorf("cannot parse Kamelet %s: %w", location, err)
+	}
+	if kamelet.Kind != v1.KameletKind || kamelet.Name == "" {
+		return fmt.Errorf("invalid Kamelet %s: expected a named resource of kind %s", location, v1.KameletKind)
+	}
+	if o.OutputFormat != "" {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: the Kamelet %s must be created from %s\n", kamelet.Name, location)
+
+		return nil
+	}
+
+	kamelet.Namespace = it.Namespace
+	kamelet.ResourceVersion = ""
+	if _, err := kubernetes.ReplaceResource(o.Context, c, &kamelet); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// createProjectResources stores the resource files of the project into a Configmap labelled with the Integration name.
+func (o *runCmdOptions) createProjectResources(cmd *cobra.Command, c client.Client, it *v1.Integration, name string, project *source.Project) error {
+	if o.OutputFormat != "" {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: the resources of project %s must be provided with the Configmap %s\n", project.Dir, name)
+
+		return nil
+	}
+
+	cm := corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: it.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: it.Name,
+			},
+		},
+		Data:       make(map[string]string),
+		BinaryData: make(map[string][]byte),
+	}
+	for _, location := range project.Resources {
+		content, err := util.ReadFile(location)
+		if err != nil {
+			return err
+		}
+		key := filepath.Base(location)
+		if utf8.Valid(content) {
+			cm.Data[key] = string(content)
+		} else {
+			cm.BinaryData[key] = content
+		}
+	}
+
+	if _, err := kubernetes.ReplaceResource(o.Context, c, &cm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindProjectResources makes the Integration own the Configmaps holding the resources of its projects,
+// so that they are garbage collected along with the Integration.
+func (o *runCmdOptions) bindProjectResources(c client.Client, it *v1.Integration, names []string) error {
+	for _, name := range names {
+		cm := corev1.ConfigMap{}
+		if err := c.Get(o.Context, ctrl.ObjectKey{Namespace: it.Namespace, Name: name}, &cm); err != nil {
+			return err
+		}
+		patch := ctrl.MergeFrom(cm.DeepCopy())
+		cm.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+			Name:       it.Name,
+			UID:        it.UID,
+		}}
+		if err := c.Patch(o.Context, &cm, patch); err != nil {
+			return fmt.Errorf("cannot bind the Configmap %s to the Integration %s: %w", name, it.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/internal"
 	"github.com/apache/camel-k/v2/pkg/trait"
 	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/spf13/cobra"
@@ -1001,3 +1002,149 @@ spec:
 status: {}
 `, output)
 }
+
+func TestRunOpenAPI(t *testing.T) {
+	tempDir := t.TempDir()
+	openAPIFile := filepath.Join(tempDir, "petstore.yaml")
+	require.NoError(t, os.WriteFile(openAPIFile, []byte("openapi: 3.0.3\n"), 0o400))
+	sourceFile := filepath.Join(tempDir, "Petstore.java")
+	require.NoError(t, os.WriteFile(sourceFile, []byte(TestSrcContent), 0o400))
+
+	_, runCmd, _ := initializeRunCmdOptionsWithOutput(t)
+	output, err := ExecuteCommand(runCmd, cmdRun, "--name", "petstore", "--openapi", openAPIFile, "--openapi-client-request-validation",
+		"-o", "yaml", sourceFile)
+	require.NoError(t, err)
+	assert.Contains(t, output, `  - content: |
+      openapi: 3.0.3
+    name: petstore.yaml
+    type: openapi
+`)
+	assert.Contains(t, output, `    camel:
+      properties:
+      - camel.rest.client-request-validation=true
+`)
+}
+
+func TestRunOpenAPIConfigmap(t *testing.T) {
+	sourceFile := filepath.Join(t.TempDir(), "Petstore.java")
+	require.NoError(t, os.WriteFile(sourceFile, []byte(TestSrcContent), 0o400))
+
+	_, runCmd, _ := initializeRunCmdOptionsWithOutput(t)
+	output, err := ExecuteCommand(runCmd, cmdRun, "--name", "petstore", "--openapi", "configmap:my-cm/petstore.yaml",
+		"-o", "yaml", sourceFile)
+	require.NoError(t, err)
+	assert.Contains(t, output, `  - contentKey: petstore.yaml
+    contentRef: my-cm
+    name: petstore.yaml
+    type: openapi
+`)
+
+	_, runCmd, _ = initializeRunCmdOptionsWithOutput(t)
+	_, err = ExecuteCommand(runCmd, cmdRun, "--openapi", "configmap:my-cm", "-o", "yaml", sourceFile)
+	require.EqualError(t, err, "invalid OpenAPI Configmap reference configmap:my-cm: expected configmap:name/key")
+}
+
+func TestRunOpenAPIClientRequestValidationWithoutOpenAPI(t *testing.T) {
+	_, runCmd, _ := initializeRunCmdOptionsWithOutput(t)
+	_, err := ExecuteCommand(runCmd, cmdRun, "--openapi-client-request-validation", "-o", "yaml", integrationSource)
+	require.EqualError(t, err, "cannot use --openapi-client-request-validation without --openapi option")
+}
+
+func writeTestProject(t *testing.T) string {
+	t.Helper()
+
+	dir := filepath.Join(t.TempDir(), "my-project")
+	require.NoError(t, os.Mkdir(dir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Hello.java"), []byte("//DEPS org.apache.camel:camel-jackson\n"+TestSrcContent), 0o400))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "application.properties"), []byte(`greeting=Hello
+camel.jbang.dependencies=camel:mail,com.acme:acme-lib:1.0
+camel.jbang.camelVersion=4.8.0
+camel.jbang.platform-http.port=8081
+`), 0o400))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data.json"), []byte(`{"hello":"world"}`), 0o400))
+
+	return dir
+}
+
+func TestRunProjectDirectory(t *testing.T) {
+	dir := writeTestProject(t)
+
+	_, runCmd, _ := initializeRunCmdOptionsWithOutput(t)
+	output, err := ExecuteCommand(runCmd, cmdRun, "-o", "yaml", "-p", "greeting=Hi", dir)
+	require.NoError(t, err)
+	assert.Contains(t, output, "name: my-project\n")
+	assert.Contains(t, output, "name: Hello.java\n")
+	assert.Contains(t, output, `  dependencies:
+  - camel:jackson
+  - camel:mail
+  - mvn:com.acme:acme-lib:1.0
+`)
+	assert.Contains(t, output, `    camel:
+      properties:
+      - greeting = Hello
+      - greeting = Hi
+      runtimeVersion: 4.8.0
+`)
+	assert.Contains(t, output, `    mount:
+      resources:
+      - configmap:my-project-resources
+`)
+	assert.NotContains(t, output, "camel.jbang")
+	assert.Contains(t, output, "Warning: the resources of project "+dir+" must be provided with the Configmap my-project-resources")
+}
+
+func TestRunProjectDirectoryResources(t *testing.T) {
+	dir := writeTestProject(t)
+
+	_, runCmd, options := initializeRunCmdOptionsWithOutput(t)
+	output, err := ExecuteCommand(runCmd, cmdRun, dir)
+	require.NoError(t, err)
+	assert.Contains(t, output, `Integration "my-project" created`)
+
+	c, err := options.GetCmdClient()
+	require.NoError(t, err)
+	cms := corev1.ConfigMapList{}
+	require.NoError(t, c.List(context.Background(), &cms))
+	require.Len(t, cms.Items, 1)
+	cm := cms.Items[0]
+	assert.Equal(t, "my-project-resources", cm.Name)
+	assert.Equal(t, "my-project", cm.Labels[v1.IntegrationLabel])
+	assert.Equal(t, map[string]string{"data.json": `{"hello":"world"}`}, cm.Data)
+	// the Configmap is garbage collected along with the Integration
+	require.Len(t, cm.OwnerReferences, 1)
+	assert.Equal(t, v1.IntegrationKind, cm.OwnerReferences[0].Kind)
+	assert.Equal(t, "my-project", cm.OwnerReferences[0].Name)
+}
+
+func TestRunProjectDirectoryKamelets(t *testing.T) {
+	dir := writeTestProject(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "my-source.kamelet.yaml"), []byte(`apiVersion: camel.apache.org/v1
+kind: Kamelet
+metadata:
+  name: my-source
+spec:
+  template:
+    from:
+      uri: timer:tick
+      steps:
+      - to: kamelet:sink
+`), 0o400))
+
+	_, runCmd, options := initializeRunCmdOptionsWithOutput(t)
+	output, err := ExecuteCommand(runCmd, cmdRun, dir)
+	require.NoError(t, err)
+	assert.Contains(t, output, `Integration "my-project" created`)
+
+	c, err := options.GetCmdClient()
+	require.NoError(t, err)
+	kamelets := v1.KameletList{}
+	require.NoError(t, c.List(context.Background(), &kamelets))
+	require.Len(t, kamelets.Items, 1)
+	assert.Equal(t, "my-source", kamelets.Items[0].Name)
+	assert.NotNil(t, kamelets.Items[0].Spec.Template)
+
+	cms := corev1.ConfigMapList{}
+	require.NoError(t, c.List(context.Background(), &cms))
+	require.Len(t, cms.Items, 1)
+	assert.NotContains(t, cms.Items[0].Data, "my-source.kamelet.yaml")
+}
@@ -0,0 +1,227 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/magiconair/properties"
+
+	"github.com/apache/camel-k/v2/pkg/util"
+)
+
+const (
+	// ProjectPropertiesFile is the name of the file holding the properties of a Camel JBang project.
+	ProjectPropertiesFile = "application.properties"
+
+	jbangPropertyPrefix       = "camel.jbang."
+	jbangDependenciesProperty = jbangPropertyPrefix + "dependencies"
+	jbangDepsComment          = "//DEPS"
+)
+
+// jbangCamelVersionProperties are the properties a Camel JBang project may use to declare its Camel version.
+var jbangCamelVersionProperties = []string{
+	jbangPropertyPrefix + "camelVersion",
+	jbangPropertyPrefix + "camel-version",
+}
+
+// Project represents a Camel JBang project directory, as run by "camel run *".
+type Project struct {
+	// Dir is the directory of the project.
+	Dir string
+	// Sources are the paths of the route sources found in the project.
+	Sources []string
+	// Kamelets are the paths of the Kamelets found in the project, as `*.kamelet.yaml` files.
+	Kamelets []string
+	// Resources are the paths of any other file found in the project.
+	Resources []string
+	// Properties are the application properties of the project, without the Camel JBang specific ones.
+	Properties *properties.Properties
+	// Dependencies are the dependencies declared by the project, in Camel K notation.
+	Dependencies []string
+	// CamelVersion is the Camel version declared by the project, if any.
+	CamelVersion string
+}
+
+// IsLocalDir checks if the provided uri is an existing directory of the local file system.
+func IsLocalDir(uri string) (bool, error) {
+	if hasSupportedScheme(uri) {
+		return false, nil
+	}
+	info, err := os.Stat(uri)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("file system error while looking for %s: %w", uri, err)
+	}
+
+	return info.IsDir(), nil
+}
+
+// LoadProject detects the layout of the Camel JBang project stored in the given directory.
+// Hidden files and sub directories are ignored.
+func LoadProject(dir string) (*Project, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read project directory %s: %w", dir, err)
+	}
+
+	project := Project{
+		Dir:        dir,
+		Properties: properties.NewProperties(),
+	}
+	project.Properties.DisableExpansion = true
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") {
+			continue
+		}
+		location := filepath.Join(dir, name)
+		switch {
+		case name == ProjectPropertiesFile:
+			if err := project.loadProperties(location); err != nil {
+				return nil, err
+			}
+		case isKamelet(name):
+			project.Kamelets = append(project.Kamelets, location)
+		case isRouteSource(name):
+			project.Sources = append(project.Sources, location)
+			if strings.HasSuffix(name, ".java") {
+				if err := project.loadDepsComments(location); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			project.Resources = append(project.Resources, location)
+		}
+	}
+
+	if len(project.Sources) == 0 {
+		return nil, fmt.Errorf("no route source found in project directory %s", dir)
+	}
+
+	return &project, nil
+}
+
+// isKamelet checks if the given file name is a Kamelet as detected by Camel JBang.
+func isKamelet(name string) bool {
+	return strings.HasSuffix(name, ".kamelet.yaml")
+}
+
+// isRouteSource checks if the given file name is a route source as detected by Camel JBang.
+// Kamelets are excluded, as they are created in the cluster rather than run by the Integration.
+func isRouteSource(name string) bool {
+	if isKamelet(name) {
+		return false
+	}
+	ext := filepath.Ext(name)
+
+	return ext == ".yaml" || ext == ".yml" || ext == ".xml" || ext == ".java"
+}
+
+func (p *Project) loadProperties(location string) error {
+	content, err := util.ReadFile(location)
+	if err != nil {
+		return err
+	}
+	props, err := properties.Load(content, properties.UTF8)
+	if err != nil {
+		return fmt.Errorf("cannot parse %s: %w", location, err)
+	}
+	props.DisableExpansion = true
+
+	for _, key := range props.Keys() {
+		value := props.GetString(key, "")
+		switch {
+		case key == jbangDependenciesProperty:
+			for dep := range strings.SplitSeq(value, ",") {
+				p.addDependency(dep)
+			}
+		case slices.Contains(jbangCamelVersionProperties, key):
+			p.CamelVersion = strings.TrimSpace(value)
+		case strings.HasPrefix(key, jbangPropertyPrefix):
+			// only relevant to Camel JBang
+		default:
+			if _, _, err := p.Properties.Set(key, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadDepsComments collects the dependencies declared with //DEPS comments at the top of a Java source.
+func (p *Project) loadDepsComments(location string) error {
+	content, err := util.ReadFile(location)
+	if err != nil {
+		return err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if deps, ok := strings.CutPrefix(line, jbangDepsComment); ok {
+			for dep := range strings.FieldsSeq(strings.ReplaceAll(deps, ",", " ")) {
+				p.addDependency(dep)
+			}
+		}
+	}
+
+	return scanner.Err()
+}
+
+func (p *Project) addDependency(dep string) {
+	dep = ToDependency(dep)
+	if dep != "" && !slices.Contains(p.Dependencies, dep) {
+		p.Dependencies = append(p.Dependencies, dep)
+	}
+}
+
+// ToDependency converts a dependency declared in Camel JBang notation to the Camel K one:
+// Camel components, either as artifact IDs or Maven coordinates, are converted to "camel:name" and
+// any other Maven coordinates are prefixed with "mvn:".
+func ToDependency(dep string) string {
+	dep = strings.TrimSpace(dep)
+	if dep == "" || strings.HasPrefix(dep, "mvn:") || strings.HasPrefix(dep, "camel:") || strings.HasPrefix(dep, "camel-k:") {
+		return dep
+	}
+	parts := strings.Split(dep, ":")
+	switch {
+	case len(parts) >= 2 && parts[0] == "org.apache.camel":
+		return "camel:" + strings.TrimPrefix(parts[1], "camel-")
+	case len(parts) >= 2 && parts[0] == "org.apache.camel.quarkus":
+		return "camel:" + strings.TrimPrefix(parts[1], "camel-quarkus-")
+	case len(parts) >= 3:
+		return "mvn:" + dep
+	case len(parts) == 1 && strings.HasPrefix(dep, "camel-quarkus-"):
+		return "camel:" + strings.TrimPrefix(dep, "camel-quarkus-")
+	case len(parts) == 1 && strings.HasPrefix(dep, "camel-"):
+		return "camel:" + strings.TrimPrefix(dep, "camel-")
+	}
+
+	return dep
+}
@@ -0,0 +1,101 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadProject(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"routes.camel.yaml":      "- from:\n    uri: timer:tick\n",
+		"Hello.java":             "//DEPS org.apache.camel:camel-jackson com.acme:acme-lib:1.0\n//DEPS camel:mail\nclass Hello {}\n",
+		"my-source.kamelet.yaml": "kind: Kamelet\n",
+		"application.properties": "greeting=Hello\ncamel.jbang.dependencies=org.apache.camel.quarkus:camel-quarkus-mail\ncamel.jbang.camel-version=4.8.0\n",
+		"data.json":              "{}",
+		".hidden":                "",
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o400))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "target"), 0o700))
+
+	project, err := LoadProject(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "Hello.java"), filepath.Join(dir, "routes.camel.yaml")}, project.Sources)
+	assert.Equal(t, []string{filepath.Join(dir, "my-source.kamelet.yaml")}, project.Kamelets)
+	assert.Equal(t, []string{filepath.Join(dir, "data.json")}, project.Resources)
+	assert.Equal(t, []string{"camel:jackson", "mvn:com.acme:acme-lib:1.0", "camel:mail"}, project.Dependencies)
+	assert.Equal(t, "4.8.0", project.CamelVersion)
+	assert.Equal(t, []string{"greeting"}, project.Properties.Keys())
+}
+
+func TestLoadProjectKamelets(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "routes.yaml"), []byte("- from:\n    uri: kamelet:my-source\n"), 0o400))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "my-source.kamelet.yaml"), []byte("kind: Kamelet\n"), 0o400))
+
+	project, err := LoadProject(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "routes.yaml")}, project.Sources)
+	assert.Equal(t, []string{filepath.Join(dir, "my-source.kamelet.yaml")}, project.Kamelets)
+	assert.Empty(t, project.Resources)
+}
+
+func TestLoadProjectWithoutSources(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "application.properties"), []byte("greeting=Hello\n"), 0o400))
+
+	_, err := LoadProject(dir)
+	require.EqualError(t, err, "no route source found in project directory "+dir)
+}
+
+func TestToDependency(t *testing.T) {
+	tests := map[string]string{
+		"camel:mail":                                 "camel:mail",
+		"mvn:com.acme:acme-lib:1.0":                  "mvn:com.acme:acme-lib:1.0",
+		"org.apache.camel:camel-jackson":             "camel:jackson",
+		"org.apache.camel:camel-jackson:4.8.0":       "camel:jackson",
+		"org.apache.camel.quarkus:camel-quarkus-sql": "camel:sql",
+		"com.acme:acme-lib:1.0":                      "mvn:com.acme:acme-lib:1.0",
+		" camel-kafka ":                              "camel:kafka",
+		"camel-quarkus-kafka":                        "camel:kafka",
+		"acme-lib":                                   "acme-lib",
+	}
+	for dep, expected := range tests {
+		assert.Equal(t, expected, ToDependency(dep), dep)
+	}
+}
+
+func TestResolveProjectDirectory(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "routes.yaml"), []byte("- from:\n    uri: timer:tick\n"), 0o400))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data.json"), []byte("{}"), 0o400))
+
+	sources, err := Resolve(t.Context(), []string{dir}, false, nil)
+	require.NoError(t, err)
+	require.Len(t, sources, 1)
+	assert.Equal(t, "routes.yaml", sources[0].Name)
+	assert.True(t, sources[0].Local)
+}
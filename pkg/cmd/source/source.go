@@ -132,15 +132,33 @@ func Resolve(ctx context.Context, locations []string, compress bool, cmd *cobra.
 		if err != nil {
 			return sources, err
 		}
+		dir, err := IsLocalDir(location)
+		if err != nil {
+			return sources, err
+		}
 
-		if ok {
+		switch {
+		case dir:
+			// a Camel JBang project, only the route sources are resolved here
+			project, err := LoadProject(location)
+			if err != nil {
+				return sources, err
+			}
+			for _, src := range project.Sources {
+				answer, err := resolveLocal(src, compress)
+				if err != nil {
+					return sources, err
+				}
+				sources = append(sources, answer)
+			}
+		case ok:
 			answer, err := resolveLocal(location, compress)
 			if err != nil {
 				return sources, err
 			}
 
 			sources = append(sources, answer)
-		} else {
+		default:
 			u, err := url.Parse(location)
 			if err != nil {
 				return sources, err
@@ -0,0 +1,252 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/metadata"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/gzip"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/topology"
+)
+
+const (
+	topologyFormatDOT     = "dot"
+	topologyFormatMermaid = "mermaid"
+	topologyFormatJSON    = "json"
+)
+
+func newCmdTopology(rootCmdOptions *RootCmdOptions) (*cobra.Command, *topologyCmdOptions) {
+	options := topologyCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:   "topology",
+		Short: "Show how the Integrations and Pipes of a namespace are linked together",
+		Long: `Show the graph of the Integrations and Pipes of a namespace, linked through the Kafka topics, the Knative channels and brokers, ` +
+			`the Kubernetes Services and the Kamelets they share. The producers and consumers with no counterpart are marked as dangling.`,
+		Args:    cobra.NoArgs,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(); err != nil {
+				return err
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().StringP("output", "o", topologyFormatDOT, "Output format. One of: dot|mermaid|json")
+
+	return &cmd, &options
+}
+
+type topologyCmdOptions struct {
+	*RootCmdOptions
+	OutputFormat string `mapstructure:"output" yaml:",omitempty"`
+}
+
+func (o *topologyCmdOptions) validate() error {
+	switch o.OutputFormat {
+	case topologyFormatDOT, topologyFormatMermaid, topologyFormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format option '%s', should be one of: dot|mermaid|json", o.OutputFormat)
+	}
+}
+
+func (o *topologyCmdOptions) run(cmd *cobra.Command, _ []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	catalog, err := createCamelCatalog()
+	if err != nil {
+		return err
+	}
+
+	builder := topology.NewBuilder(o.Namespace)
+	kamelets, err := c.CamelV1().Kamelets(o.Namespace).List(o.Context, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, k := range kamelets.Items {
+		builder.AddKamelets(k.Name)
+	}
+	if repo, err := newKameletRepository(o.RootCmdOptions, ""); err == nil {
+		if names, err := repo.List(o.Context); err == nil {
+			builder.AddKamelets(names...)
+		}
+	}
+
+	integrations := v1.NewIntegrationList()
+	if err := c.List(o.Context, &integrations, ctrl.InNamespace(o.Namespace)); err != nil {
+		return err
+	}
+	for _, it := range integrations.Items {
+		if isOwnedByPipe(&it) {
+			// represented by its Pipe
+			continue
+		}
+		w, err := integrationWorkload(o.Context, c, catalog, &it)
+		if err != nil {
+			return fmt.Errorf("cannot inspect Integration %s: %w", it.Name, err)
+		}
+		builder.AddWorkload(w)
+	}
+
+	pipes := v1.NewPipeList()
+	if err := c.List(o.Context, &pipes, ctrl.InNamespace(o.Namespace)); err != nil {
+		return err
+	}
+	for _, pipe := range pipes.Items {
+		builder.AddWorkload(pipeWorkload(&pipe))
+	}
+
+	graph := builder.Build()
+	switch o.OutputFormat {
+	case topologyFormatMermaid:
+		return topology.WriteMermaid(cmd.OutOrStdout(), graph)
+	case topologyFormatJSON:
+		return topology.WriteJSON(cmd.OutOrStdout(), graph)
+	default:
+		return topology.WriteDOT(cmd.OutOrStdout(), graph)
+	}
+}
+
+func isOwnedByPipe(it *v1.Integration) bool {
+	for _, ref := range it.OwnerReferences {
+		if ref.Kind == v1.PipeKind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// integrationWorkload inspects the Integration sources to collect the URIs it consumes from and produces to.
+func integrationWorkload(ctx context.Context, c ctrl.Reader, catalog *camel.RuntimeCatalog, it *v1.Integration) (topology.Workload, error) {
+	w := topology.Workload{
+		Kind: topology.NodeKindIntegration,
+		Name: it.Name,
+	}
+	sources := it.OriginalSourcesOnly()
+	for i := range sources {
+		if err := resolveTopologySource(ctx, c, it.Namespace, &sources[i]); err != nil {
+			return w, err
+		}
+	}
+	if len(sources) == 0 {
+		return w, nil
+	}
+	meta, err := metadata.ExtractAll(catalog, sources)
+	if err != nil {
+		return w, err
+	}
+	w.From = meta.FromURIs
+	w.To = meta.ToURIs
+	w.ExposesHTTP = meta.ExposesHTTPServices
+
+	return w, nil
+}
+
+// resolveTopologySource loads the content of a source stored in a ConfigMap, or compressed.
+func resolveTopologySource(ctx context.Context, c ctrl.Reader, namespace string, source *v1.SourceSpec) error {
+	if source.ContentRef != "" {
+		cm, err := kubernetes.GetConfigMap(ctx, c, source.ContentRef, namespace)
+		if err != nil {
+			return err
+		}
+		key := source.ContentKey
+		if key == "" {
+			key = "content"
+		}
+		source.Content = cm.Data[key]
+		source.ContentRef = ""
+	}
+	if source.Compression {
+		content, err := gzip.UncompressBase64([]byte(source.Content))
+		if err != nil {
+			return err
+		}
+		source.Content = string(content)
+		source.Compression = false
+	}
+
+	return nil
+}
+
+// pipeWorkload collects the URIs the Pipe consumes from and produces to, translating the references to equivalent URIs.
+func pipeWorkload(pipe *v1.Pipe) topology.Workload {
+	w := topology.Workload{
+		Kind: topology.NodeKindPipe,
+		Name: pipe.Name,
+	}
+	if uri := pipeEndpointURI(pipe.Spec.Source); uri != "" {
+		w.From = append(w.From, uri)
+	}
+	for _, step := range pipe.Spec.Steps {
+		if uri := pipeEndpointURI(step); uri != "" {
+			w.To = append(w.To, uri)
+		}
+	}
+	if uri := pipeEndpointURI(pipe.Spec.Sink); uri != "" {
+		w.To = append(w.To, uri)
+	}
+
+	return w
+}
+
+func pipeEndpointURI(e v1.Endpoint) string {
+	if e.URI != nil {
+		return *e.URI
+	}
+	if e.Ref == nil {
+		return ""
+	}
+	group := strings.Split(e.Ref.APIVersion, "/")[0]
+	switch {
+	case e.Ref.Kind == "Kamelet":
+		return "kamelet:" + e.Ref.Name
+	case e.Ref.Kind == "KafkaTopic":
+		return "kafka:" + e.Ref.Name
+	case group == "messaging.knative.dev":
+		return "knative:channel/" + e.Ref.Name
+	case e.Ref.Kind == "Broker":
+		if props, err := e.Properties.GetPropertyMap(); err == nil && props["type"] != "" {
+			return fmt.Sprintf("knative:event/%s?name=%s", props["type"], e.Ref.Name)
+		}
+
+		return "knative:event?name=" + e.Ref.Name
+	case e.Ref.Kind == "Service" && group == "serving.knative.dev":
+		return "knative:endpoint/" + e.Ref.Name
+	case e.Ref.Kind == "Service":
+		return "http://" + e.Ref.Name
+	}
+
+	return ""
+}
@@ -0,0 +1,128 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/topology"
+)
+
+const cmdTopology = "topology"
+
+func executeTopologyCmd(t *testing.T, initObjs []runtime.Object, args ...string) (string, error) {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	topologyCmd, _ := newCmdTopology(options)
+	rootCmd.AddCommand(topologyCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return ExecuteCommand(rootCmd, append([]string{cmdTopology}, args...)...)
+}
+
+func testTopologyObjects() []runtime.Object {
+	producer := v1.NewIntegration("default", "producer")
+	producer.Spec.Sources = []v1.SourceSpec{
+		v1.NewSourceSpec("producer.yaml", `
+- from:
+    uri: "timer:tick"
+    steps:
+      - to: "kafka:orders?brokers=my-cluster:9092"
+      - to: "knative:channel/audit"
+`, v1.LanguageYaml),
+	}
+	consumer := v1.NewPipe("default", "consumer")
+	consumer.Spec.Source = v1.Endpoint{
+		Ref: &corev1.ObjectReference{
+			APIVersion: "kafka.strimzi.io/v1beta2",
+			Kind:       "KafkaTopic",
+			Name:       "orders",
+		},
+	}
+	consumer.Spec.Sink = v1.Endpoint{
+		URI: ptr.To("log:info"),
+	}
+	// the Integration generated by the Pipe is represented by the Pipe itself
+	generated := v1.NewIntegration("default", "consumer")
+	generated.OwnerReferences = []metav1.OwnerReference{{APIVersion: v1.SchemeGroupVersion.String(), Kind: v1.PipeKind, Name: "consumer"}}
+	generated.Spec.Sources = []v1.SourceSpec{
+		v1.NewSourceSpec("consumer.yaml", `
+- from:
+    uri: "kafka:orders"
+    steps:
+      - to: "log:info"
+`, v1.LanguageYaml),
+	}
+
+	return []runtime.Object{&producer, &consumer, &generated}
+}
+
+func TestTopologyJSON(t *testing.T) {
+	output, err := executeTopologyCmd(t, testTopologyObjects(), "-o", "json")
+	require.NoError(t, err)
+
+	var graph topology.Graph
+	require.NoError(t, json.Unmarshal([]byte(output), &graph))
+	assert.Equal(t, "default", graph.Namespace)
+	assert.Equal(t, []topology.Node{
+		{ID: "channel/audit", Kind: topology.NodeKindChannel, Name: "audit", Dangling: true},
+		{ID: "integration/producer", Kind: topology.NodeKindIntegration, Name: "producer"},
+		{ID: "kafkatopic/orders", Kind: topology.NodeKindKafkaTopic, Name: "orders"},
+		{ID: "pipe/consumer", Kind: topology.NodeKindPipe, Name: "consumer"},
+	}, graph.Nodes)
+	assert.Equal(t, []topology.Edge{
+		{From: "integration/producer", To: "channel/audit", URI: "knative:channel/audit", Dangling: true},
+		{From: "integration/producer", To: "kafkatopic/orders", URI: "kafka:orders?brokers=my-cluster:9092"},
+		{From: "kafkatopic/orders", To: "pipe/consumer", URI: "kafka:orders"},
+	}, graph.Edges)
+}
+
+func TestTopologyMermaid(t *testing.T) {
+	output, err := executeTopologyCmd(t, testTopologyObjects(), "--output", "mermaid")
+	require.NoError(t, err)
+	assert.Equal(t, `flowchart LR
+  n0(["Channel: audit"])
+  n1["Integration: producer"]
+  n2(["KafkaTopic: orders"])
+  n3["Pipe: consumer"]
+  n1 -.-> n0
+  n1 --> n2
+  n2 --> n3
+  classDef dangling stroke:#f00,stroke-dasharray:5
+  class n0 dangling
+`, output)
+}
+
+func TestTopologyInvalidOutput(t *testing.T) {
+	_, err := executeTopologyCmd(t, nil, "-o", "yaml")
+	require.Error(t, err)
+	assert.Equal(t, "invalid output format option 'yaml', should be one of: dot|mermaid|json", err.Error())
+}
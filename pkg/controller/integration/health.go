@@ -18,9 +18,11 @@ limitations under the License.
 package integration
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -28,6 +30,7 @@ import (
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 )
@@ -36,6 +39,12 @@ const (
 	// HealthCheckErrorMessage key used for propagating error details from Camel health to MicroProfile Health
 	// (See CAMEL-17138).
 	HealthCheckErrorMessage = "error.message"
+
+	// healthTransitionsHistoryLimit is the number of health check transitions reported in the Integration status.
+	healthTransitionsHistoryLimit = 20
+
+	// healthProbeConcurrency is the maximum number of ready Pods probed concurrently for their health checks.
+	healthProbeConcurrency = 10
 )
 
 func NewHealthCheck(body []byte) (*v1.HealthCheck, error) {
@@ -47,6 +56,225 @@ func NewHealthCheck(body []byte) (*v1.HealthCheck, error) {
 	return &health, nil
 }
 
+// healthCheckEntry is a health check of the Camel runtime reported by a Pod, bound to the element it checks.
+type healthCheckEntry struct {
+	name    string
+	kind    v1.HealthCheckKind
+	id      string
+	status  v1.HealthCheckStatus
+	message string
+	data    v1.RawMessage
+}
+
+// updateHealthStatus aggregates the health checks probed in the Pods of the Integration into its status, and records
+// the transitions of the checks since the previous probes. The Pods that could not be probed are reported with the
+// probe error.
+func updateHealthStatus(integration *v1.Integration, probes map[string]*v1.HealthCheck, probeErrors map[string]error, now time.Time) {
+	status := integration.Status.Health
+	if status == nil {
+		status = &v1.HealthStatus{}
+	}
+	type checkKey struct{ check, pod string }
+	previous := make(map[checkKey]v1.HealthCheckStatus)
+	for _, check := range status.Checks {
+		for _, pod := range check.Pods {
+			previous[checkKey{check.Name, pod.Pod}] = pod.Status
+		}
+	}
+
+	reports := make(map[string]*v1.HealthCheckReport)
+	var transitions []v1.HealthCheckTransition
+	for pod, health := range probes {
+		for _, e := range healthCheckEntries(health) {
+			report, ok := reports[e.name]
+			if !ok {
+				report = &v1.HealthCheckReport{
+					Name:   e.name,
+					Kind:   e.kind,
+					ID:     e.id,
+					Status: v1.HealthCheckStatusUp,
+				}
+				reports[e.name] = report
+			}
+			podCheck := v1.PodHealthCheck{
+				Pod:     pod,
+				Status:  e.status,
+				Message: e.message,
+			}
+			if e.status != v1.HealthCheckStatusUp {
+				report.Status = v1.HealthCheckStatusDown
+				podCheck.Data = e.data
+			}
+			report.Pods = append(report.Pods, podCheck)
+
+			key := checkKey{e.name, pod}
+			last, known := previous[key]
+			delete(previous, key)
+			if (known && last != e.status) || (!known && e.status != v1.HealthCheckStatusUp) {
+				transitions = append(transitions, v1.HealthCheckTransition{
+					Check:   e.name,
+					Pod:     pod,
+					Status:  e.status,
+					Message: e.message,
+					Time:    metav1.NewTime(now),
+				})
+			}
+		}
+	}
+
+	// The checks only reported while down, such as the ones grouping the routes, are no longer reported once up again
+	for key, last := range previous {
+		if _, probed := probes[key.pod]; probed && last != v1.HealthCheckStatusUp {
+			transitions = append(transitions, v1.HealthCheckTransition{
+				Check:  key.check,
+				Pod:    key.pod,
+				Status: v1.HealthCheckStatusUp,
+				Time:   metav1.NewTime(now),
+			})
+		}
+	}
+
+	status.Checks = make([]v1.HealthCheckReport, 0, len(reports))
+	for _, report := range reports {
+		slices.SortFunc(report.Pods, func(a, b v1.PodHealthCheck) int {
+			return strings.Compare(a.Pod, b.Pod)
+		})
+		status.Checks = append(status.Checks, *report)
+	}
+	slices.SortFunc(status.Checks, func(a, b v1.HealthCheckReport) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	slices.SortFunc(transitions, func(a, b v1.HealthCheckTransition) int {
+		return cmp.Or(strings.Compare(a.Check, b.Check), strings.Compare(a.Pod, b.Pod))
+	})
+	status.Transitions = append(transitions, status.Transitions...)
+	if len(status.Transitions) > healthTransitionsHistoryLimit {
+		status.Transitions = status.Transitions[:healthTransitionsHistoryLimit]
+	}
+
+	status.ProbeFailures = nil
+	for pod, err := range probeErrors {
+		status.ProbeFailures = append(status.ProbeFailures, v1.PodHealthProbeFailure{
+			Pod:     pod,
+			Message: err.Error(),
+		})
+	}
+	slices.SortFunc(status.ProbeFailures, func(a, b v1.PodHealthProbeFailure) int {
+		return strings.Compare(a.Pod, b.Pod)
+	})
+
+	if len(status.Checks) == 0 && len(status.Transitions) == 0 && len(status.ProbeFailures) == 0 {
+		integration.Status.Health = nil
+
+		return
+	}
+	integration.Status.Health = status
+}
+
+// healthCheckEntries returns the health checks of a probe response, bound to the element they check. The checks
+// grouping the routes or the consumers are split, as they either report the element that is down in their data,
+// or the status of each element when the health checks are fully exposed.
+func healthCheckEntries(health *v1.HealthCheck) []healthCheckEntry {
+	entries := make([]healthCheckEntry, 0, len(health.Checks))
+	names := make(map[string]bool)
+	add := func(e healthCheckEntry) {
+		if !names[e.name] {
+			names[e.name] = true
+			entries = append(entries, e)
+		}
+	}
+	for _, check := range health.Checks {
+		data := make(map[string]interface{})
+		if len(check.Data) > 0 {
+			// the details are informative only
+			_ = json.Unmarshal(check.Data, &data)
+		}
+		kind, id := healthCheckKind(check.Name, data)
+		name := check.Name
+		if id != "" && (kind == v1.HealthCheckKindRoute || kind == v1.HealthCheckKindConsumer) {
+			name = strings.ToLower(string(kind)) + ":" + id
+		}
+		add(healthCheckEntry{
+			name:    name,
+			kind:    kind,
+			id:      id,
+			status:  healthCheckStatus(check.Status),
+			message: healthCheckMessage(data),
+			data:    check.Data,
+		})
+
+		keys := make([]string, 0, len(data))
+		for key := range data {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			value, ok := data[key].(string)
+			if !ok || !strings.HasPrefix(key, "route:") && !strings.HasPrefix(key, "consumer:") {
+				continue
+			}
+			kind, id := healthCheckKind(key, nil)
+			add(healthCheckEntry{
+				name:   key,
+				kind:   kind,
+				id:     id,
+				status: healthCheckStatus(v1.HealthCheckStatus(value)),
+			})
+		}
+	}
+
+	return entries
+}
+
+// healthCheckKind returns the kind of element checked by the given health check, and its identifier.
+func healthCheckKind(name string, data map[string]interface{}) (v1.HealthCheckKind, string) {
+	value := func(key string) string {
+		if s, ok := data[key].(string); ok {
+			return s
+		}
+
+		return ""
+	}
+	switch {
+	case name == "context" || name == "camel-context":
+		return v1.HealthCheckKindContext, value("context.name")
+	case name == "routes" || name == "camel-routes":
+		return v1.HealthCheckKindRoute, value("route.id")
+	case name == "consumers" || name == "camel-consumers":
+		return v1.HealthCheckKindConsumer, value("route.id")
+	case strings.HasPrefix(name, "route:"):
+		return v1.HealthCheckKindRoute, strings.TrimPrefix(name, "route:")
+	case strings.HasPrefix(name, "consumer:"):
+		return v1.HealthCheckKindConsumer, strings.TrimPrefix(name, "consumer:")
+	case strings.HasPrefix(name, "producer:"):
+		return v1.HealthCheckKindComponent, strings.TrimPrefix(name, "producer:")
+	case strings.HasPrefix(name, "camel-"):
+		return v1.HealthCheckKindComponent, strings.TrimPrefix(name, "camel-")
+	default:
+		return v1.HealthCheckKindOther, name
+	}
+}
+
+// healthCheckMessage returns the reason why a health check is down, from the details it reports.
+func healthCheckMessage(data map[string]interface{}) string {
+	if message, ok := data[HealthCheckErrorMessage].(string); ok && message != "" {
+		return message
+	}
+	if state, ok := data["route.status"].(string); ok && state != "" && state != "Started" {
+		return "route is " + strings.ToLower(state)
+	}
+
+	return ""
+}
+
+func healthCheckStatus(status v1.HealthCheckStatus) v1.HealthCheckStatus {
+	if strings.EqualFold(string(status), string(v1.HealthCheckStatusUp)) {
+		return v1.HealthCheckStatusUp
+	}
+
+	return v1.HealthCheckStatusDown
+}
+
 func proxyGetHTTPProbe(ctx context.Context, c kubernetes.Interface, p *corev1.Probe, pod *corev1.Pod, container *corev1.Container) ([]byte, error) {
 	if p.HTTPGet == nil {
 		return nil, fmt.Errorf("missing probe handler for %s/%s", pod.Namespace, pod.Name)
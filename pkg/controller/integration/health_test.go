@@ -18,14 +18,28 @@ limitations under the License.
 package integration
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util/log"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	restclient "k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestNewHealthCheck(t *testing.T) {
@@ -99,3 +113,160 @@ func raw2map(t *testing.T, in camelv1.RawMessage) map[string]interface{} {
 
 	return answer
 }
+
+func TestUpdateHealthStatus(t *testing.T) {
+	it := camelv1.NewIntegration("default", "my-it")
+	down, err := NewHealthCheck([]byte(`{
+		"status": "DOWN",
+		"checks": [
+			{"name": "context", "status": "UP", "data": {"context.name": "camel-1"}},
+			{"name": "camel-routes", "status": "DOWN", "data": {"route.id": "route1", "route.status": "Stopped"}},
+			{"name": "camel-kafka", "status": "DOWN", "data": {"error.message": "Broker may not be available"}}
+		]
+	}`))
+	require.NoError(t, err)
+	up, err := NewHealthCheck([]byte(`{
+		"status": "UP",
+		"checks": [
+			{"name": "context", "status": "UP", "data": {"context.name": "camel-1"}},
+			{"name": "camel-routes", "status": "UP", "data": {"route:route1": "UP", "route:route2": "UP"}},
+			{"name": "camel-kafka", "status": "UP"}
+		]
+	}`))
+	require.NoError(t, err)
+
+	first := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	updateHealthStatus(&it, map[string]*camelv1.HealthCheck{"pod-a": down, "pod-b": up}, nil, first)
+
+	health := it.Status.Health
+	require.NotNil(t, health)
+	names := make([]string, 0, len(health.Checks))
+	for _, check := range health.Checks {
+		names = append(names, check.Name)
+	}
+	assert.Equal(t, []string{"camel-kafka", "camel-routes", "context", "route:route1", "route:route2"}, names)
+
+	kafka := health.Checks[0]
+	assert.Equal(t, camelv1.HealthCheckKindComponent, kafka.Kind)
+	assert.Equal(t, "kafka", kafka.ID)
+	assert.Equal(t, camelv1.HealthCheckStatusDown, kafka.Status)
+	require.Len(t, kafka.Pods, 2)
+	assert.Equal(t, "pod-a", kafka.Pods[0].Pod)
+	assert.Equal(t, "Broker may not be available", kafka.Pods[0].Message)
+	assert.NotEmpty(t, kafka.Pods[0].Data)
+	assert.Equal(t, camelv1.HealthCheckStatusUp, kafka.Pods[1].Status)
+	assert.Empty(t, kafka.Pods[1].Data)
+
+	route := health.Checks[3]
+	assert.Equal(t, camelv1.HealthCheckKindRoute, route.Kind)
+	assert.Equal(t, "route1", route.ID)
+	assert.Equal(t, camelv1.HealthCheckStatusDown, route.Status)
+	require.Len(t, route.Pods, 2)
+	assert.Equal(t, "route is stopped", route.Pods[0].Message)
+
+	require.Len(t, health.Transitions, 2)
+	assert.Equal(t, "camel-kafka", health.Transitions[0].Check)
+	assert.Equal(t, "pod-a", health.Transitions[0].Pod)
+	assert.Equal(t, camelv1.HealthCheckStatusDown, health.Transitions[0].Status)
+	assert.Equal(t, "route:route1", health.Transitions[1].Check)
+
+	// pod-a recovers
+	second := first.Add(time.Minute)
+	updateHealthStatus(&it, map[string]*camelv1.HealthCheck{"pod-a": up, "pod-b": up}, nil, second)
+
+	health = it.Status.Health
+	for _, check := range health.Checks {
+		assert.Equal(t, camelv1.HealthCheckStatusUp, check.Status, check.Name)
+	}
+	require.Len(t, health.Transitions, 4)
+	assert.Equal(t, "camel-kafka", health.Transitions[0].Check)
+	assert.Equal(t, camelv1.HealthCheckStatusUp, health.Transitions[0].Status)
+	assert.Equal(t, second, health.Transitions[0].Time.Time)
+	assert.Equal(t, "route:route1", health.Transitions[1].Check)
+	assert.Equal(t, camelv1.HealthCheckStatusUp, health.Transitions[1].Status)
+	assert.Equal(t, first, health.Transitions[3].Time.Time)
+
+	assert.Empty(t, health.ProbeFailures)
+
+	// pod-a cannot be probed
+	updateHealthStatus(&it, map[string]*camelv1.HealthCheck{"pod-b": up},
+		map[string]error{"pod-a": errors.New("readiness probe failed: context deadline exceeded")}, second)
+	health = it.Status.Health
+	assert.Equal(t, []camelv1.PodHealthProbeFailure{
+		{Pod: "pod-a", Message: "readiness probe failed: context deadline exceeded"},
+	}, health.ProbeFailures)
+	require.Len(t, health.Checks[0].Pods, 1)
+	assert.Equal(t, "pod-b", health.Checks[0].Pods[0].Pod)
+
+	// no Pod probed anymore
+	updateHealthStatus(&it, map[string]*camelv1.HealthCheck{}, nil, second.Add(time.Minute))
+	require.NotNil(t, it.Status.Health)
+	assert.Empty(t, it.Status.Health.Checks)
+	assert.Empty(t, it.Status.Health.ProbeFailures)
+	assert.Len(t, it.Status.Health.Transitions, 4)
+}
+
+func TestProbeHealth(t *testing.T) {
+	c, err := internal.NewFakeClient()
+	require.NoError(t, err)
+	fakeClient, ok := c.(*internal.FakeClient)
+	require.True(t, ok)
+	clientset, ok := fakeClient.Interface.(*fakeclientset.Clientset)
+	require.True(t, ok)
+	clientset.PrependProxyReactor("pods", func(action k8stesting.Action) (bool, restclient.ResponseWrapper, error) {
+		get, ok := action.(k8stesting.ProxyGetAction)
+		require.True(t, ok)
+		assert.Equal(t, "8080", get.GetPort())
+		assert.Equal(t, "/q/health/ready", get.GetPath())
+		if get.GetName() == "pod-b" {
+			return true, healthProbeResponse{err: errors.New("connection refused")}, nil
+		}
+
+		return true, healthProbeResponse{body: `{"status": "UP", "checks": [{"name": "context", "status": "UP"}]}`}, nil
+	})
+
+	pods := make([]*corev1.Pod, 0, 3)
+	for _, name := range []string{"pod-a", "pod-b", "pod-c"} {
+		pods = append(pods, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name: "integration",
+					ReadinessProbe: &corev1.Probe{
+						ProbeHandler: corev1.ProbeHandler{
+							HTTPGet: &corev1.HTTPGetAction{Path: "/q/health/ready", Port: intstr.FromInt32(8080)},
+						},
+						TimeoutSeconds: 1,
+					},
+				}},
+			},
+		})
+	}
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	probes := make(map[string]*camelv1.HealthCheck)
+	probeErrors := make(map[string]error)
+	a.probeHealth(context.TODO(), &trait.Environment{Catalog: trait.NewCatalog(nil)}, pods, probes, probeErrors)
+
+	assert.Len(t, probes, 2)
+	assert.Contains(t, probes, "pod-a")
+	assert.Contains(t, probes, "pod-c")
+	require.Len(t, probeErrors, 1)
+	assert.EqualError(t, probeErrors["pod-b"], "readiness probe failed: connection refused")
+}
+
+// healthProbeResponse is the response of a readiness probe proxied by the API server.
+type healthProbeResponse struct {
+	body string
+	err  error
+}
+
+func (r healthProbeResponse) DoRaw(context.Context) ([]byte, error) {
+	return []byte(r.body), r.err
+}
+
+func (r healthProbeResponse) Stream(context.Context) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(r.body)), r.err
+}
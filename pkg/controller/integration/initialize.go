@@ -20,6 +20,7 @@ package integration
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -70,7 +71,14 @@ func (action *initializeAction) Handle(ctx context.Context, integration *v1.Inte
 		integration.Status.RemoveCondition(v1.IntegrationConditionReady)
 	}
 
-	if _, err := trait.Apply(ctx, action.client, integration, nil); err != nil {
+	if integration.Status.Phase == v1.IntegrationPhaseInitialization {
+		if err := action.resolveModeline(ctx, integration); err != nil {
+			return integration, err
+		}
+	}
+
+	env, err := trait.Apply(ctx, action.client, integration, nil)
+	if err != nil {
 		integration.Status.Phase = v1.IntegrationPhaseError
 		integration.SetReadyCondition(corev1.ConditionFalse,
 			v1.IntegrationConditionInitializationFailedReason, err.Error())
@@ -78,6 +86,22 @@ func (action *initializeAction) Handle(ctx context.Context, integration *v1.Inte
 		return integration, err
 	}
 
+	if integration.Status.Phase == v1.IntegrationPhaseInitialization {
+		resolved, err := action.checkPropertyPlaceholders(env, integration)
+		if err != nil {
+			return integration, err
+		}
+		if !resolved {
+			return integration, nil
+		}
+		if !action.checkSecurityPolicy(env, integration) {
+			return integration, nil
+		}
+		if err := action.checkActivationProfile(env, integration); err != nil {
+			return integration, err
+		}
+	}
+
 	if integration.Status.IntegrationKit == nil {
 		ikt := action.lookupIntegrationKit(integration)
 		integration.SetIntegrationKit(ikt)
@@ -100,6 +124,156 @@ func (action *initializeAction) Handle(ctx context.Context, integration *v1.Inte
 	return integration, nil
 }
 
+// resolveModeline records the modeline options declared in the sources when the Integration opts in for them,
+// so that they are applied by the traits. The invalid options are ignored and reported as an Integration condition.
+func (action *initializeAction) resolveModeline(ctx context.Context, integration *v1.Integration) error {
+	integration.Status.Modeline = nil
+	if !trait.ModelineEnabled(integration) {
+		integration.Status.RemoveCondition(v1.IntegrationConditionModelineApplied)
+
+		return nil
+	}
+
+	options, diagnostics, err := trait.ResolveModeline(ctx, action.client, integration)
+	if err != nil {
+		return err
+	}
+	integration.Status.Modeline = options
+	if profile := trait.ModelineProfile(integration); profile != "" && integration.Spec.Profile == "" {
+		//nolint:staticcheck
+		integration.Status.Profile = profile
+	}
+
+	if len(diagnostics) > 0 {
+		integration.Status.SetCondition(
+			v1.IntegrationConditionModelineApplied,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionModelineInvalidReason,
+			fmt.Sprintf("%d modeline options applied, invalid options ignored: %s", len(options), strings.Join(diagnostics, "; ")),
+		)
+	} else {
+		integration.Status.SetCondition(
+			v1.IntegrationConditionModelineApplied,
+			corev1.ConditionTrue,
+			v1.IntegrationConditionModelineAppliedReason,
+			fmt.Sprintf("%d modeline options applied", len(options)),
+		)
+	}
+
+	return nil
+}
+
+// checkPropertyPlaceholders reports the property placeholders which can't be resolved as an Integration condition.
+// It returns false when the Integration must not be deployed because of the unresolved placeholders.
+func (action *initializeAction) checkPropertyPlaceholders(env *trait.Environment, integration *v1.Integration) (bool, error) {
+	check, err := trait.CheckPropertyPlaceholders(env)
+	if err != nil {
+		return false, err
+	}
+	if check == nil || len(check.Placeholders) == 0 {
+		integration.Status.RemoveCondition(v1.IntegrationConditionPropertiesResolved)
+
+		return true, nil
+	}
+	if len(check.Unresolved) == 0 {
+		integration.Status.SetCondition(
+			v1.IntegrationConditionPropertiesResolved,
+			corev1.ConditionTrue,
+			v1.IntegrationConditionPropertiesResolvedReason,
+			fmt.Sprintf("%d property placeholders resolved", len(check.Placeholders)),
+		)
+
+		return true, nil
+	}
+
+	message := fmt.Sprintf("unresolved property placeholders [%s]", strings.Join(check.Unresolved, ","))
+	if len(check.Missing) > 0 {
+		message += fmt.Sprintf(": mounted resources [%s] not found", strings.Join(check.Missing, ","))
+	}
+	integration.Status.SetCondition(
+		v1.IntegrationConditionPropertiesResolved,
+		corev1.ConditionFalse,
+		v1.IntegrationConditionPropertiesUnresolvedReason,
+		message,
+	)
+	if !check.FailOnUnresolved {
+		return true, nil
+	}
+	integration.Status.Phase = v1.IntegrationPhaseError
+	integration.SetReadyCondition(corev1.ConditionFalse, v1.IntegrationConditionInitializationFailedReason, message)
+
+	return false, nil
+}
+
+// checkSecurityPolicy reports the admission of the risk categories of the Integration by the security policies as an Integration condition.
+// It returns false when the Integration must not be deployed, either because a risk category is denied or because it is not approved yet.
+func (action *initializeAction) checkSecurityPolicy(env *trait.Environment, integration *v1.Integration) bool {
+	check := trait.CheckSecurityPolicy(env)
+	if check == nil || len(check.Categories) == 0 {
+		integration.Status.RemoveCondition(v1.IntegrationConditionSecurityPolicy)
+
+		return true
+	}
+
+	if len(check.Denied) > 0 {
+		message := "risk categories denied by the security policy: " + strings.Join(check.Denied, ", ")
+		integration.Status.SetCondition(
+			v1.IntegrationConditionSecurityPolicy,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionSecurityPolicyDeniedReason,
+			message,
+		)
+		integration.Status.Phase = v1.IntegrationPhaseError
+		integration.SetReadyCondition(corev1.ConditionFalse, v1.IntegrationConditionInitializationFailedReason, message)
+
+		return false
+	}
+	if len(check.Unapproved) > 0 {
+		integration.Status.SetCondition(
+			v1.IntegrationConditionSecurityPolicy,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionSecurityApprovalRequiredReason,
+			fmt.Sprintf("risk categories [%s] require an approval: list them in the %s annotation",
+				strings.Join(check.Unapproved, ","), v1.IntegrationSecurityApprovalAnnotation),
+		)
+
+		return false
+	}
+	integration.Status.SetCondition(
+		v1.IntegrationConditionSecurityPolicy,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionSecurityPolicyAdmittedReason,
+		fmt.Sprintf("risk categories [%s] admitted by the security policy", strings.Join(check.Categories, ",")),
+	)
+
+	return true
+}
+
+// checkActivationProfile reports the activation profile of the Integration consumers as an Integration condition.
+func (action *initializeAction) checkActivationProfile(env *trait.Environment, integration *v1.Integration) error {
+	if !trait.ActivationProfileRequired(env) {
+		integration.Status.RemoveCondition(v1.IntegrationConditionActivationProfile)
+
+		return nil
+	}
+	profile, err := trait.ComputeActivationProfile(env)
+	if err != nil {
+		return err
+	}
+	if len(profile.Activations) == 0 {
+		integration.Status.RemoveCondition(v1.IntegrationConditionActivationProfile)
+
+		return nil
+	}
+	status, reason := corev1.ConditionFalse, v1.IntegrationConditionAlwaysActiveReason
+	if profile.Eligible() {
+		status, reason = corev1.ConditionTrue, v1.IntegrationConditionEventDrivenReason
+	}
+	integration.Status.SetCondition(v1.IntegrationConditionActivationProfile, status, reason, profile.String())
+
+	return nil
+}
+
 func (action *initializeAction) lookupIntegrationKit(integration *v1.Integration) *v1.IntegrationKit {
 	if integration.Spec.IntegrationKit == nil || integration.Spec.IntegrationKit.Name == "" {
 		return nil
@@ -151,6 +325,21 @@ func getCamelAppImportingCondition(kind, message string) []v1.IntegrationConditi
 				Message: message,
 			},
 		}
+	case "StatefulSet":
+		return []v1.IntegrationCondition{
+			{
+				Type:    v1.IntegrationConditionStatefulSetAvailable,
+				Status:  corev1.ConditionTrue,
+				Reason:  v1.IntegrationConditionStatefulSetAvailableReason,
+				Message: message,
+			},
+			{
+				Type:    v1.IntegrationConditionReady,
+				Status:  corev1.ConditionTrue,
+				Reason:  v1.IntegrationConditionStatefulSetReadyReason,
+				Message: message,
+			},
+		}
 	case "CronJob":
 		return []v1.IntegrationCondition{
 			{
@@ -19,12 +19,17 @@ package integration
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/controller/pipe"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	"k8s.io/utils/ptr"
 
 	"github.com/apache/camel-k/v2/pkg/util/log"
 
@@ -188,3 +193,467 @@ func TestCamelImportUnsupportedKind(t *testing.T) {
 	assert.Equal(t, v1.IntegrationConditionImportingKindAvailableReason, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Reason)
 	assert.Equal(t, "Unsupported SomeKind import kind", handledIt.Status.GetCondition(v1.IntegrationConditionReady).Message)
 }
+
+func TestInitializeUnresolvedPropertyPlaceholders(t *testing.T) {
+	it := newPlaceholdersIntegration(nil)
+	c, err := internal.NewFakeClient(it, newPlaceholdersCatalog())
+	require.NoError(t, err)
+
+	a := initializeAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseBuildingKit, handledIt.Status.Phase)
+	cond := handledIt.Status.GetCondition(v1.IntegrationConditionPropertiesResolved)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionPropertiesUnresolvedReason, cond.Reason)
+	assert.Equal(t, "unresolved property placeholders [my.message,secret:my-secret/token]", cond.Message)
+}
+
+func TestInitializeFailOnUnresolvedPropertyPlaceholders(t *testing.T) {
+	it := newPlaceholdersIntegration(&traitv1.CamelTrait{
+		FailOnUnresolvedProperties: ptr.To(true),
+		Properties:                 []string{"my.message=hello"},
+	})
+	c, err := internal.NewFakeClient(it, newPlaceholdersCatalog())
+	require.NoError(t, err)
+
+	a := initializeAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseError, handledIt.Status.Phase)
+	ready := handledIt.Status.GetCondition(v1.IntegrationConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, corev1.ConditionFalse, ready.Status)
+	assert.Equal(t, v1.IntegrationConditionInitializationFailedReason, ready.Reason)
+	assert.Equal(t, "unresolved property placeholders [secret:my-secret/token]", ready.Message)
+}
+
+func TestInitializeResolvedPropertyPlaceholders(t *testing.T) {
+	it := newPlaceholdersIntegration(&traitv1.CamelTrait{
+		FailOnUnresolvedProperties: ptr.To(true),
+		Properties:                 []string{"my.message=hello"},
+	})
+	it.Spec.Traits.Mount = &traitv1.MountTrait{
+		Configs: []string{"secret:my-secret"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-secret",
+		},
+		Data: map[string][]byte{
+			"token": []byte("s3cr3t"),
+		},
+	}
+	c, err := internal.NewFakeClient(it, newPlaceholdersCatalog(), secret)
+	require.NoError(t, err)
+
+	a := initializeAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseBuildingKit, handledIt.Status.Phase)
+	cond := handledIt.Status.GetCondition(v1.IntegrationConditionPropertiesResolved)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionPropertiesResolvedReason, cond.Reason)
+}
+
+func TestInitializeMissingMountedResourcePropertyPlaceholders(t *testing.T) {
+	it := newPlaceholdersIntegration(&traitv1.CamelTrait{
+		Properties: []string{"my.message=hello"},
+	})
+	it.Spec.Traits.Mount = &traitv1.MountTrait{
+		Configs: []string{"secret:my-secret"},
+	}
+	c, err := internal.NewFakeClient(it, newPlaceholdersCatalog())
+	require.NoError(t, err)
+
+	a := initializeAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	cond := handledIt.Status.GetCondition(v1.IntegrationConditionPropertiesResolved)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, "unresolved property placeholders [secret:my-secret/token]: mounted resources [secret:my-secret] not found", cond.Message)
+}
+
+func newPlaceholdersIntegration(camelTrait *traitv1.CamelTrait) *v1.Integration {
+	return &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-it",
+		},
+		Spec: v1.IntegrationSpec{
+			Sources: []v1.SourceSpec{
+				v1.NewSourceSpec("route.yaml", `
+- from:
+    uri: "timer:tick?period={{my.period:1000}}"
+    steps:
+      - setBody:
+          simple: "{{my.message}} {{?my.suffix}}"
+      - setHeader:
+          name: token
+          simple: "{{secret:my-secret/token}}"
+      - to: "log:info"
+`, v1.LanguageYaml),
+			},
+			Traits: v1.Traits{
+				Camel: camelTrait,
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Phase:           v1.IntegrationPhaseInitialization,
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			RuntimeVersion:  defaults.CamelKRuntimeCatalogVersion,
+		},
+	}
+}
+
+func newPlaceholdersCatalog() *v1.CamelCatalog {
+	return &v1.CamelCatalog{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.CamelCatalogKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "camel-k-catalog",
+		},
+		Spec: v1.CamelCatalogSpec{
+			Runtime: v1.RuntimeSpec{
+				Provider: v1.RuntimeProviderQuarkus,
+				Version:  defaults.CamelKRuntimeCatalogVersion,
+			},
+		},
+	}
+}
+
+func TestInitializeActivationProfile(t *testing.T) {
+	it := newPlaceholdersIntegration(nil)
+	it.Spec.Sources = []v1.SourceSpec{
+		v1.NewSourceSpec("route.yaml", `
+- from:
+    uri: "kafka:orders?brokers=my-cluster:9092"
+    steps:
+      - to: "log:info"
+- from:
+    uri: "cron:tab?schedule=0/5 * * * ?"
+    steps:
+      - to: "log:info"
+`, v1.LanguageYaml),
+	}
+	it.Spec.Traits.Cron = &traitv1.CronTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(false)},
+	}
+	it.Spec.Traits.Keda = &traitv1.KedaTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	}
+	c, err := internal.NewFakeClient(it, newPlaceholdersCatalog())
+	require.NoError(t, err)
+
+	a := initializeAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	cond := handledIt.Status.GetCondition(v1.IntegrationConditionActivationProfile)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionEventDrivenReason, cond.Reason)
+	assert.Equal(t, "kafka=kafka,cron=cron", cond.Message)
+}
+
+func TestInitializeActivationProfileAlwaysActive(t *testing.T) {
+	it := newPlaceholdersIntegration(&traitv1.CamelTrait{
+		Properties: []string{"my.message=hello"},
+	})
+	it.Spec.Traits.Keda = &traitv1.KedaTrait{
+		Auto: ptr.To(true),
+	}
+	c, err := internal.NewFakeClient(it, newPlaceholdersCatalog())
+	require.NoError(t, err)
+
+	a := initializeAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	cond := handledIt.Status.GetCondition(v1.IntegrationConditionActivationProfile)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionAlwaysActiveReason, cond.Reason)
+	assert.Equal(t, "timer=none", cond.Message)
+}
+
+func TestInitializeActivationProfileUnparseableURI(t *testing.T) {
+	it := newPlaceholdersIntegration(nil)
+	it.Spec.Sources = []v1.SourceSpec{
+		v1.NewSourceSpec("route.yaml", `
+- from:
+    uri: "kafka:orders?brokers=my-cluster:9092&saslJaasConfig=RAW(a;b)"
+    steps:
+      - to: "log:info"
+`, v1.LanguageYaml),
+	}
+	c, err := internal.NewFakeClient(it, newPlaceholdersCatalog())
+	require.NoError(t, err)
+
+	a := initializeAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	// the keda trait is not enabled, so the activation profile is not computed
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Nil(t, handledIt.Status.GetCondition(v1.IntegrationConditionActivationProfile))
+
+	// when it is, the consumer that cannot be parsed is reported as not activated by any scaler
+	it = newPlaceholdersIntegration(nil)
+	it.Spec.Sources = handledIt.Spec.Sources
+	it.Spec.Traits.Keda = &traitv1.KedaTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	}
+	handledIt, err = a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	cond := handledIt.Status.GetCondition(v1.IntegrationConditionActivationProfile)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionAlwaysActiveReason, cond.Reason)
+}
+
+func TestInitializeModeline(t *testing.T) {
+	it := newPlaceholdersIntegration(nil)
+	it.Annotations = map[string]string{v1.IntegrationModelineAnnotation: "true"}
+	it.Spec.Sources = []v1.SourceSpec{
+		v1.NewSourceSpec("route.yaml", `# camel-k: language=yaml property=my.message=hello
+# camel-k: trait=service.enabled=false
+# camel-k: dependency=mvn:org.acme:lib:1.0 profile=kubernetes
+# camel-k: trait=service.foo=bar
+# camel-k: property=file:app.properties
+- from:
+    uri: "timer:tick"
+    steps:
+      - setBody:
+          simple: "{{my.message}}"
+      - to: "log:info"
+`, v1.LanguageYaml),
+	}
+	c, err := internal.NewFakeClient(it, newPlaceholdersCatalog())
+	require.NoError(t, err)
+
+	a := initializeAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Equal(t, []v1.ModelineOption{
+		{Name: "property", Value: "my.message=hello", Source: "route.yaml", Line: 1},
+		{Name: "trait", Value: "service.enabled=false", Source: "route.yaml", Line: 2},
+		{Name: "dependency", Value: "mvn:org.acme:lib:1.0", Source: "route.yaml", Line: 3},
+		{Name: "profile", Value: "kubernetes", Source: "route.yaml", Line: 3},
+	}, handledIt.Status.Modeline)
+	//nolint:staticcheck
+	assert.Equal(t, v1.TraitProfileKubernetes, handledIt.Status.Profile)
+	assert.Contains(t, handledIt.Status.Dependencies, "mvn:org.acme:lib:1.0")
+
+	cond := handledIt.Status.GetCondition(v1.IntegrationConditionModelineApplied)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionModelineInvalidReason, cond.Reason)
+	assert.Equal(t, `4 modeline options applied, invalid options ignored: `+
+		`route.yaml:4: invalid trait option "service.foo=bar": unknown property of trait service: foo; `+
+		`route.yaml:5: option "property" only supports key=value properties when applied by the operator`, cond.Message)
+
+	// the property declared by the modeline resolves the placeholder
+	cond = handledIt.Status.GetCondition(v1.IntegrationConditionPropertiesResolved)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+}
+
+func TestInitializeModelineDisabled(t *testing.T) {
+	it := newPlaceholdersIntegration(nil)
+	it.Spec.Sources = []v1.SourceSpec{
+		v1.NewSourceSpec("route.yaml", `# camel-k: property=my.message=hello
+- from:
+    uri: "timer:tick"
+    steps:
+      - setBody:
+          simple: "{{my.message}}"
+      - to: "log:info"
+`, v1.LanguageYaml),
+	}
+	c, err := internal.NewFakeClient(it, newPlaceholdersCatalog())
+	require.NoError(t, err)
+
+	a := initializeAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Empty(t, handledIt.Status.Modeline)
+	assert.Nil(t, handledIt.Status.GetCondition(v1.IntegrationConditionModelineApplied))
+	cond := handledIt.Status.GetCondition(v1.IntegrationConditionPropertiesResolved)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+}
+
+func newSecurityPolicyIntegration(annotations map[string]string) *v1.Integration {
+	it := newPlaceholdersIntegration(nil)
+	it.Annotations = annotations
+	it.Spec.Sources = []v1.SourceSpec{
+		v1.NewSourceSpec("route.yaml", `
+- from:
+    uri: "timer:tick"
+    steps:
+      - to: "exec:ls"
+      - to: "file:/var/data/outbox"
+`, v1.LanguageYaml),
+	}
+
+	return it
+}
+
+func TestInitializeSecurityPolicy(t *testing.T) {
+	t.Setenv("SECURITY_APPROVAL_RISK_CATEGORIES", "command-execution")
+
+	it := newSecurityPolicyIntegration(nil)
+	c, err := internal.NewFakeClient(it, newPlaceholdersCatalog())
+	require.NoError(t, err)
+
+	a := initializeAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseInitialization, handledIt.Status.Phase)
+	assert.Contains(t, handledIt.Status.Capabilities, "risk:command-execution")
+	assert.Contains(t, handledIt.Status.Capabilities, "risk:filesystem-access")
+	cond := handledIt.Status.GetCondition(v1.IntegrationConditionSecurityPolicy)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionSecurityApprovalRequiredReason, cond.Reason)
+	assert.Equal(t, "risk categories [command-execution] require an approval: "+
+		"list them in the camel.apache.org/security-approval annotation", cond.Message)
+
+	it = newSecurityPolicyIntegration(map[string]string{v1.IntegrationSecurityApprovalAnnotation: "command-execution"})
+	c, err = internal.NewFakeClient(it, newPlaceholdersCatalog())
+	require.NoError(t, err)
+	a.InjectClient(c)
+	handledIt, err = a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseBuildingKit, handledIt.Status.Phase)
+	cond = handledIt.Status.GetCondition(v1.IntegrationConditionSecurityPolicy)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, "risk categories [command-execution,filesystem-access] admitted by the security policy", cond.Message)
+}
+
+func TestInitializeSecurityPolicyDenied(t *testing.T) {
+	t.Setenv("SECURITY_DENIED_RISK_CATEGORIES", "command-execution")
+
+	it := newSecurityPolicyIntegration(map[string]string{v1.IntegrationSecurityApprovalAnnotation: "*"})
+	c, err := internal.NewFakeClient(it, newPlaceholdersCatalog())
+	require.NoError(t, err)
+
+	a := initializeAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseError, handledIt.Status.Phase)
+	cond := handledIt.Status.GetCondition(v1.IntegrationConditionSecurityPolicy)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionSecurityPolicyDeniedReason, cond.Reason)
+	assert.Equal(t, "risk categories denied by the security policy: command-execution (operator)", cond.Message)
+}
+
+func TestInitializeSecurityPolicyPipeKamelet(t *testing.T) {
+	t.Setenv("SECURITY_DENIED_RISK_CATEGORIES", "command-execution")
+
+	kamelet := &v1.Kamelet{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.KameletKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "exec-source",
+			Labels:    map[string]string{v1.KameletTypeLabel: v1.KameletTypeSource},
+		},
+		Spec: v1.KameletSpec{
+			KameletSpecBase: v1.KameletSpecBase{
+				Template: &v1.Template{
+					RawMessage: []byte(`{"from": {"uri": "timer:tick", "steps": [{"to": "exec:{{executable}}"}, {"to": "kamelet:sink"}]}}`),
+				},
+			},
+		},
+	}
+	p := &v1.Pipe{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.PipeKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-pipe",
+		},
+		Spec: v1.PipeSpec{
+			Source: v1.Endpoint{
+				Ref: &corev1.ObjectReference{
+					APIVersion: v1.SchemeGroupVersion.String(),
+					Kind:       v1.KameletKind,
+					Name:       "exec-source",
+				},
+				Properties: asEndpointProperties(t, map[string]string{"executable": "ls"}),
+			},
+			Sink: v1.Endpoint{
+				URI: ptr.To("log:info"),
+			},
+		},
+	}
+	c, err := internal.NewFakeClient(kamelet, p, newPlaceholdersCatalog())
+	require.NoError(t, err)
+	it, err := pipe.CreateIntegrationFor(context.TODO(), c, p)
+	require.NoError(t, err)
+	it.Status = v1.IntegrationStatus{
+		Phase:           v1.IntegrationPhaseInitialization,
+		RuntimeProvider: v1.RuntimeProviderQuarkus,
+		RuntimeVersion:  defaults.CamelKRuntimeCatalogVersion,
+	}
+	c, err = internal.NewFakeClient(kamelet, p, it, newPlaceholdersCatalog())
+	require.NoError(t, err)
+
+	a := initializeAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseError, handledIt.Status.Phase)
+	assert.Contains(t, handledIt.Status.Capabilities, "risk:command-execution")
+	cond := handledIt.Status.GetCondition(v1.IntegrationConditionSecurityPolicy)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionSecurityPolicyDeniedReason, cond.Reason)
+	assert.Equal(t, "risk categories denied by the security policy: command-execution (operator)", cond.Message)
+}
+
+func asEndpointProperties(t *testing.T, props map[string]string) *v1.EndpointProperties {
+	t.Helper()
+	data, err := json.Marshal(props)
+	require.NoError(t, err)
+
+	return &v1.EndpointProperties{RawMessage: data}
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"reflect"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -122,6 +123,14 @@ func integrationUpdateFunc(c client.Client, old *v1.Integration, it *v1.Integrat
 	if !sameTraits {
 		return true
 	}
+	// The approval of the security sensitive components may unlock an Integration waiting for it
+	if old.Annotations[v1.IntegrationSecurityApprovalAnnotation] != it.Annotations[v1.IntegrationSecurityApprovalAnnotation] {
+		return true
+	}
+	// The logger levels are changed at runtime, without a new generation
+	if old.Annotations[v1.IntegrationLogLevelsAnnotation] != it.Annotations[v1.IntegrationLogLevelsAnnotation] {
+		return true
+	}
 
 	// Ignore updates to the integration status in which case metadata.Generation does not change,
 	// or except when the integration phase changes as it's used to transition from one phase
@@ -409,6 +418,10 @@ func watchIntegrationResources(c client.Client, b *builder.Builder) {
 			})).
 		// Watch for the owned Deployments
 		Owns(&appsv1.Deployment{}, builder.WithPredicates(StatusChangedPredicate{})).
+		// Watch for the owned StatefulSets
+		Owns(&appsv1.StatefulSet{}, builder.WithPredicates(StatusChangedPredicate{})).
+		// Watch for the owned Jobs
+		Owns(&batchv1.Job{}, builder.WithPredicates(StatusChangedPredicate{})).
 		// Watch for the owned Builds
 		Owns(&v1.Build{}, builder.WithPredicates(StatusChangedPredicate{}))
 }
@@ -477,6 +490,7 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 		if k8serrors.IsNotFound(err) {
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			deleteCronJobMetrics(request.Namespace, request.Name)
 			// Return and don't requeue
 			return reconcile.Result{}, nil
 		}
@@ -499,6 +513,7 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 		actions = r.syntheticActions
 	}
 
+	result := reconcile.Result{}
 	for _, a := range actions {
 		a.InjectClient(r.client)
 		a.InjectLogger(targetLog)
@@ -526,6 +541,7 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 
 				return reconcile.Result{}, err
 			}
+			result.RequeueAfter = requeueDelay(newTarget)
 		}
 
 		// handle one action at time so the resource
@@ -535,7 +551,30 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 		break
 	}
 
-	return reconcile.Result{}, nil
+	return result, nil
+}
+
+// requeueDelay returns the delay until the Integration must be reconciled again, regardless of any change.
+func requeueDelay(it *v1.Integration) time.Duration {
+	delay := rolloutAnalysisDelay(it)
+	if d := logLevelsExpirationDelay(it); d > 0 && (delay == 0 || d < delay) {
+		delay = d
+	}
+	if d := cronScheduleDelay(it); d > 0 && (delay == 0 || d < delay) {
+		delay = d
+	}
+
+	return delay
+}
+
+// rolloutAnalysisDelay returns the delay until the next analysis of the version being delivered, if any.
+func rolloutAnalysisDelay(it *v1.Integration) time.Duration {
+	rollout := it.Status.Rollout
+	if rollout == nil || rollout.Phase != v1.RolloutPhaseProgressing || rollout.NextAnalysisTime == nil {
+		return 0
+	}
+
+	return max(time.Until(rollout.NextAnalysisTime.Time), time.Second)
 }
 
 func (r *reconcileIntegration) update(ctx context.Context, base *v1.Integration, target *v1.Integration, log *log.Logger) error {
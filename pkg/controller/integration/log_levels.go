@@ -0,0 +1,243 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	kubeutil "github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	// jolokiaPortName is the name of the container port exposing the Jolokia agent.
+	jolokiaPortName = "jolokia"
+	// jolokiaDefaultPort is the default port of the Jolokia agent.
+	jolokiaDefaultPort = 8778
+	// jolokiaLoggingMBean is the platform MBean managing the logger levels.
+	jolokiaLoggingMBean = "java.util.logging:type=Logging"
+	// jolokiaTimeout is the maximum time to wait for the Jolokia agent of a Pod.
+	jolokiaTimeout = 5 * time.Second
+	// logLevelsRetryDelay is the delay before retrying to revert the expired logger levels.
+	logLevelsRetryDelay = 30 * time.Second
+)
+
+// setLoggerLevelFunc changes the level of a logger of the Integration running in the given Pod,
+// an empty level resetting the logger to the level of its parent.
+type setLoggerLevelFunc func(ctx context.Context, pod *corev1.Pod, logger string, level string) error
+
+// reconcileLogLevels applies the logger levels listed in the log-levels annotation to the running Pods of the
+// Integration, and reverts the ones removed from the annotation or expired. The levels in effect are recorded
+// in the Integration status, together with the Pods they are applied to.
+func reconcileLogLevels(ctx context.Context, integration *v1.Integration, pods []corev1.Pod, setLevel setLoggerLevelFunc) {
+	requested, err := integration.GetLogLevels()
+	if err != nil {
+		integration.Status.SetCondition(v1.IntegrationConditionLogLevels, corev1.ConditionFalse,
+			v1.IntegrationConditionLogLevelsFailedReason, err.Error())
+
+		return
+	}
+	if len(requested) == 0 && len(integration.Status.LogLevels) == 0 {
+		integration.Status.RemoveCondition(v1.IntegrationConditionLogLevels)
+
+		return
+	}
+
+	now := time.Now()
+	desired := make(map[string]v1.LogLevel, len(requested))
+	for _, l := range requested {
+		if l.Expiration == nil || l.Expiration.After(now) {
+			desired[l.Logger] = l
+		}
+	}
+	ready := make(map[string]*corev1.Pod, len(pods))
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp == nil && kubeutil.GetPodCondition(*pod, corev1.PodReady).Status == corev1.ConditionTrue {
+			ready[pod.Name] = pod
+		}
+	}
+
+	var failures []string
+	applied := make(map[string][]string)
+	status := make([]v1.LogLevel, 0, len(desired))
+	// Revert the levels no longer requested on the Pods still running
+	for _, current := range integration.Status.LogLevels {
+		if l, ok := desired[current.Logger]; ok {
+			if strings.EqualFold(l.Level, current.Level) {
+				applied[current.Logger] = current.Pods
+			}
+
+			continue
+		}
+		var remaining []string
+		for _, name := range current.Pods {
+			pod, ok := ready[name]
+			if !ok {
+				continue
+			}
+			if err := setLevel(ctx, pod, current.Logger, ""); err != nil {
+				failures = append(failures, fmt.Sprintf("cannot revert logger %q in Pod %s: %v", current.Logger, name, err))
+				remaining = append(remaining, name)
+			}
+		}
+		if len(remaining) > 0 {
+			// keep track of the Pods the level could not be reverted on, to retry later
+			current.Pods = remaining
+			status = append(status, current)
+		}
+	}
+	// Apply the requested levels on the Pods they are not applied to yet
+	for _, l := range requested {
+		if _, ok := desired[l.Logger]; !ok {
+			continue
+		}
+		var appliedPods []string
+		for _, name := range applied[l.Logger] {
+			if _, ok := ready[name]; ok {
+				appliedPods = append(appliedPods, name)
+			}
+		}
+		for _, name := range sortedPodNames(ready) {
+			if slices.Contains(appliedPods, name) {
+				continue
+			}
+			if err := setLevel(ctx, ready[name], l.Logger, strings.ToUpper(l.Level)); err != nil {
+				failures = append(failures, fmt.Sprintf("cannot set logger %q to %s in Pod %s: %v", l.Logger, l.Level, name, err))
+
+				continue
+			}
+			appliedPods = append(appliedPods, name)
+		}
+		slices.Sort(appliedPods)
+		l.Pods = appliedPods
+		status = append(status, l)
+		delete(desired, l.Logger)
+	}
+
+	integration.Status.LogLevels = status
+	switch {
+	case len(failures) > 0:
+		integration.Status.SetCondition(v1.IntegrationConditionLogLevels, corev1.ConditionFalse,
+			v1.IntegrationConditionLogLevelsFailedReason, strings.Join(failures, "; "))
+	case len(status) > 0:
+		integration.Status.SetCondition(v1.IntegrationConditionLogLevels, corev1.ConditionTrue,
+			v1.IntegrationConditionLogLevelsAppliedReason, fmt.Sprintf("%d logger levels applied", len(status)))
+	default:
+		integration.Status.RemoveCondition(v1.IntegrationConditionLogLevels)
+	}
+}
+
+// logLevelsExpirationDelay returns the delay until the next logger level to revert expires, if any.
+func logLevelsExpirationDelay(it *v1.Integration) time.Duration {
+	var delay time.Duration
+	for _, l := range it.Status.LogLevels {
+		if l.Expiration == nil {
+			continue
+		}
+		d := time.Until(l.Expiration.Time)
+		if d <= 0 {
+			// the level could not be reverted yet
+			d = logLevelsRetryDelay
+		}
+		if delay == 0 || d < delay {
+			delay = d
+		}
+	}
+
+	return delay
+}
+
+func sortedPodNames(pods map[string]*corev1.Pod) []string {
+	names := make([]string, 0, len(pods))
+	for name := range pods {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// jolokiaResponse is the response of the Jolokia agent to an exec request.
+type jolokiaResponse struct {
+	Status int    `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// newJolokiaLoggerLevelSetter returns a function changing the logger levels through the Jolokia agent of the Pods,
+// reached via the API server Pod proxy.
+func newJolokiaLoggerLevelSetter(c kubernetes.Interface) setLoggerLevelFunc {
+	return func(ctx context.Context, pod *corev1.Pod, logger string, level string) error {
+		port := jolokiaDefaultPort
+		for _, container := range pod.Spec.Containers {
+			if p, err := findPortByName(&container, jolokiaPortName); err == nil {
+				port = p
+
+				break
+			}
+		}
+		value := "[null]"
+		if level != "" {
+			value = escapeJolokiaArgument(level)
+		}
+		path := fmt.Sprintf("/jolokia/exec/%s/setLoggerLevel/%s/%s", jolokiaLoggingMBean, escapeJolokiaArgument(logger), value)
+
+		callCtx, cancel := context.WithTimeout(ctx, jolokiaTimeout)
+		defer cancel()
+		body, err := c.CoreV1().
+			Pods(pod.Namespace).
+			ProxyGet("http", pod.Name, strconv.Itoa(port), path, nil).
+			DoRaw(callCtx)
+		if err != nil {
+			return err
+		}
+		var response jolokiaResponse
+		if err := json.Unmarshal(body, &response); err != nil {
+			return fmt.Errorf("invalid Jolokia response: %w", err)
+		}
+		if response.Status != http.StatusOK {
+			if response.Error != "" {
+				return errors.New(response.Error)
+			}
+
+			return fmt.Errorf("jolokia returned status %d", response.Status)
+		}
+
+		return nil
+	}
+}
+
+// escapeJolokiaArgument escapes an argument of a Jolokia GET request, as per the Jolokia protocol.
+func escapeJolokiaArgument(arg string) string {
+	if arg == "" {
+		return `""`
+	}
+
+	return strings.NewReplacer("!", "!!", "/", "!/", `"`, `!"`).Replace(arg)
+}
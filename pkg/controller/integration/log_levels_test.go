@@ -0,0 +1,161 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// fakeLoggerLevels records the logger levels set in each Pod.
+type fakeLoggerLevels struct {
+	levels map[string]map[string]string
+	fail   map[string]bool
+}
+
+func newFakeLoggerLevels() *fakeLoggerLevels {
+	return &fakeLoggerLevels{
+		levels: make(map[string]map[string]string),
+		fail:   make(map[string]bool),
+	}
+}
+
+func (f *fakeLoggerLevels) set(ctx context.Context, pod *corev1.Pod, logger string, level string) error {
+	if f.fail[pod.Name] {
+		return errors.New("connection refused")
+	}
+	if f.levels[pod.Name] == nil {
+		f.levels[pod.Name] = make(map[string]string)
+	}
+	if level == "" {
+		delete(f.levels[pod.Name], logger)
+	} else {
+		f.levels[pod.Name][logger] = level
+	}
+
+	return nil
+}
+
+func readyPod(name string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      name,
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func TestReconcileLogLevels(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	expiration := metav1.NewTime(time.Now().Add(15 * time.Minute)).Rfc3339Copy()
+	require.NoError(t, it.SetLogLevels([]v1.LogLevel{
+		{Logger: "org.apache.camel", Level: "debug", Expiration: &expiration},
+		{Logger: "com.acme", Level: "TRACE"},
+	}))
+	f := newFakeLoggerLevels()
+
+	reconcileLogLevels(context.Background(), &it, []corev1.Pod{readyPod("pod-1")}, f.set)
+	assert.Equal(t, map[string]string{"org.apache.camel": "DEBUG", "com.acme": "TRACE"}, f.levels["pod-1"])
+	require.Len(t, it.Status.LogLevels, 2)
+	assert.Equal(t, "org.apache.camel", it.Status.LogLevels[0].Logger)
+	assert.Equal(t, []string{"pod-1"}, it.Status.LogLevels[0].Pods)
+	assert.True(t, expiration.Equal(it.Status.LogLevels[0].Expiration))
+	assert.True(t, it.IsConditionTrue(v1.IntegrationConditionLogLevels))
+	assert.InDelta(t, 15*time.Minute, logLevelsExpirationDelay(&it), float64(time.Minute))
+
+	// a new replica gets the levels as well, the other one is left untouched
+	f.levels["pod-1"]["com.acme"] = "INFO"
+	reconcileLogLevels(context.Background(), &it, []corev1.Pod{readyPod("pod-1"), readyPod("pod-2")}, f.set)
+	assert.Equal(t, "INFO", f.levels["pod-1"]["com.acme"])
+	assert.Equal(t, map[string]string{"org.apache.camel": "DEBUG", "com.acme": "TRACE"}, f.levels["pod-2"])
+	assert.Equal(t, []string{"pod-1", "pod-2"}, it.Status.LogLevels[1].Pods)
+
+	// the logger removed from the annotation is reverted
+	require.NoError(t, it.SetLogLevels([]v1.LogLevel{
+		{Logger: "org.apache.camel", Level: "debug", Expiration: &expiration},
+	}))
+	reconcileLogLevels(context.Background(), &it, []corev1.Pod{readyPod("pod-1"), readyPod("pod-2")}, f.set)
+	assert.Equal(t, map[string]string{"org.apache.camel": "DEBUG"}, f.levels["pod-2"])
+	require.Len(t, it.Status.LogLevels, 1)
+	assert.Equal(t, "org.apache.camel", it.Status.LogLevels[0].Logger)
+}
+
+func TestReconcileLogLevelsExpiration(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	expiration := metav1.NewTime(time.Now().Add(-time.Second))
+	require.NoError(t, it.SetLogLevels([]v1.LogLevel{
+		{Logger: "org.apache.camel", Level: "DEBUG", Expiration: &expiration},
+	}))
+	it.Status.LogLevels = []v1.LogLevel{
+		{Logger: "org.apache.camel", Level: "DEBUG", Expiration: &expiration, Pods: []string{"pod-1", "pod-2"}},
+	}
+	f := newFakeLoggerLevels()
+	f.levels["pod-1"] = map[string]string{"org.apache.camel": "DEBUG"}
+	f.levels["pod-2"] = map[string]string{"org.apache.camel": "DEBUG"}
+	f.fail["pod-2"] = true
+
+	reconcileLogLevels(context.Background(), &it, []corev1.Pod{readyPod("pod-1"), readyPod("pod-2")}, f.set)
+	assert.Empty(t, f.levels["pod-1"])
+	// the Pod the level could not be reverted on is kept, to retry later
+	require.Len(t, it.Status.LogLevels, 1)
+	assert.Equal(t, []string{"pod-2"}, it.Status.LogLevels[0].Pods)
+	condition := it.Status.GetCondition(v1.IntegrationConditionLogLevels)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Contains(t, condition.Message, `cannot revert logger "org.apache.camel" in Pod pod-2`)
+	assert.Equal(t, logLevelsRetryDelay, logLevelsExpirationDelay(&it))
+
+	f.fail["pod-2"] = false
+	reconcileLogLevels(context.Background(), &it, []corev1.Pod{readyPod("pod-1"), readyPod("pod-2")}, f.set)
+	assert.Empty(t, f.levels["pod-2"])
+	assert.Empty(t, it.Status.LogLevels)
+	assert.Nil(t, it.Status.GetCondition(v1.IntegrationConditionLogLevels))
+	assert.Zero(t, logLevelsExpirationDelay(&it))
+}
+
+func TestReconcileLogLevelsInvalidAnnotation(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	it.Annotations = map[string]string{v1.IntegrationLogLevelsAnnotation: "org.apache.camel=DEBUG"}
+
+	reconcileLogLevels(context.Background(), &it, nil, newFakeLoggerLevels().set)
+	condition := it.Status.GetCondition(v1.IntegrationConditionLogLevels)
+	require.NotNil(t, condition)
+	assert.Equal(t, v1.IntegrationConditionLogLevelsFailedReason, condition.Reason)
+}
+
+func TestEscapeJolokiaArgument(t *testing.T) {
+	assert.Equal(t, "org.apache.camel", escapeJolokiaArgument("org.apache.camel"))
+	assert.Equal(t, `""`, escapeJolokiaArgument(""))
+	assert.Equal(t, "a!/b!!c", escapeJolokiaArgument("a/b!c"))
+}
@@ -24,6 +24,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/prometheus/client_golang/prometheus"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 )
 
 var (
@@ -50,11 +52,31 @@ var (
 			"id",
 		},
 	)
+
+	cronJobMissedSchedules = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "camel_k_integration_cronjob_missed_schedules",
+			Help: "Number of scheduled executions missed by a CronJob integration since its last execution",
+		}, []string{
+			"namespace",
+			"id",
+		},
+	)
+
+	cronJobConsecutiveFailures = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "camel_k_integration_cronjob_consecutive_failures",
+			Help: "Number of executions of a CronJob integration that failed in a row",
+		}, []string{
+			"namespace",
+			"id",
+		},
+	)
 )
 
 func init() {
 	// Register custom metrics with the global prometheus registry
-	metrics.Registry.MustRegister(timeToFirstReadiness, integration)
+	metrics.Registry.MustRegister(timeToFirstReadiness, integration, cronJobMissedSchedules, cronJobConsecutiveFailures)
 }
 
 func updateIntegrationPhase(iID string, p string) {
@@ -68,3 +90,23 @@ func updateIntegrationPhase(iID string, p string) {
 		integration.With(labels).Inc()
 	}
 }
+
+func updateCronJobMetrics(it *v1.Integration) {
+	if it.Status.Cron == nil {
+		deleteCronJobMetrics(it.Namespace, it.Name)
+
+		return
+	}
+	labels := prometheus.Labels{
+		"namespace": it.Namespace,
+		"id":        it.Name,
+	}
+	cronJobMissedSchedules.With(labels).Set(float64(it.Status.Cron.MissedSchedules))
+	cronJobConsecutiveFailures.With(labels).Set(float64(it.Status.Cron.ConsecutiveFailures))
+}
+
+// deleteCronJobMetrics removes the CronJob metrics of an Integration which is deleted or no longer runs as a CronJob.
+func deleteCronJobMetrics(namespace string, name string) {
+	cronJobMissedSchedules.DeleteLabelValues(namespace, name)
+	cronJobConsecutiveFailures.DeleteLabelValues(namespace, name)
+}
@@ -23,6 +23,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 )
 
 // getMetricValue returns the sum of the Counter metrics associated with the Collector
@@ -98,3 +101,39 @@ func Test_updateIntegrationPhase(t *testing.T) {
 		})
 	}
 }
+
+func Test_updateCronJobMetrics(t *testing.T) {
+	it := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cron-it"},
+		Status: v1.IntegrationStatus{
+			Cron: &v1.CronStatus{MissedSchedules: 2, ConsecutiveFailures: 3},
+		},
+	}
+	labels := prometheus.Labels{"namespace": "ns", "id": "cron-it"}
+
+	updateCronJobMetrics(it)
+	assert.Equal(t, float64(2), gaugeValue(t, cronJobMissedSchedules, labels))
+	assert.Equal(t, float64(3), gaugeValue(t, cronJobConsecutiveFailures, labels))
+
+	// the metrics are removed when the Integration no longer runs as a CronJob
+	it.Status.Cron = nil
+	updateCronJobMetrics(it)
+	assert.False(t, cronJobMissedSchedules.Delete(labels))
+	assert.False(t, cronJobConsecutiveFailures.Delete(labels))
+
+	// and when it's deleted
+	it.Status.Cron = &v1.CronStatus{MissedSchedules: 1}
+	updateCronJobMetrics(it)
+	deleteCronJobMetrics("ns", "cron-it")
+	assert.False(t, cronJobMissedSchedules.Delete(labels))
+	assert.False(t, cronJobConsecutiveFailures.Delete(labels))
+}
+
+func gaugeValue(t *testing.T, gauge *prometheus.GaugeVec, labels prometheus.Labels) float64 {
+	t.Helper()
+
+	m := dto.Metric{}
+	assert.NoError(t, gauge.With(labels).Write(&m))
+
+	return m.GetGauge().GetValue()
+}
@@ -24,7 +24,10 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"golang.org/x/sync/errgroup"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -63,7 +66,9 @@ func (action *monitorAction) Name() string {
 func (action *monitorAction) CanHandle(integration *v1.Integration) bool {
 	return integration.Status.Phase == v1.IntegrationPhaseDeploying ||
 		integration.Status.Phase == v1.IntegrationPhaseRunning ||
-		integration.Status.Phase == v1.IntegrationPhaseError
+		integration.Status.Phase == v1.IntegrationPhaseError ||
+		integration.Status.Phase == v1.IntegrationPhaseSucceeded ||
+		integration.Status.Phase == v1.IntegrationPhaseFailed
 }
 
 //nolint:nestif
@@ -74,6 +79,11 @@ func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integra
 		// Only check if the Integration requires a rebuild
 		return action.checkDigestAndRebuild(ctx, integration, nil)
 	}
+	// When the Integration ran to completion, it must not be deployed again
+	// unless it has changed
+	if integration.Status.Phase == v1.IntegrationPhaseSucceeded || integration.Status.Phase == v1.IntegrationPhaseFailed {
+		return action.checkDigestAndRebuild(ctx, integration, nil)
+	}
 
 	var kit *v1.IntegrationKit
 	var err error
@@ -232,6 +242,19 @@ func (action *monitorAction) monitorPods(ctx context.Context, environment *trait
 	}
 	integration.Status.Replicas = replicas
 
+	// Change the logger levels at runtime, as requested with the log-levels annotation
+	reconcileLogLevels(ctx, integration, runningPods.Items, newJolokiaLoggerLevelSetter(action.client))
+
+	// Record the executions of Integrations running as a CronJob
+	if cronJob, ok := controller.(*cronJobController); ok {
+		if err := cronJob.updateExecutions(ctx, time.Now()); err != nil {
+			return nil, err
+		}
+	} else if integration.Status.Cron != nil {
+		integration.Status.Cron = nil
+		updateCronJobMetrics(integration)
+	}
+
 	// Reconcile Integration phase and ready condition
 	if integration.Status.Phase == v1.IntegrationPhaseDeploying {
 		integration.Status.Phase = v1.IntegrationPhaseRunning
@@ -385,6 +408,26 @@ func (action *monitorAction) newController(env *trait.Environment, integration *
 			obj:         deploy,
 			integration: integration,
 		}
+	case integration.IsConditionTrue(v1.IntegrationConditionJobAvailable):
+		obj = getUpdatedController(env, &batchv1.Job{})
+		job, ok := obj.(*batchv1.Job)
+		if !ok {
+			return nil, fmt.Errorf("type assertion failed, not a Job: %v", obj)
+		}
+		controller = &jobController{
+			obj:         job,
+			integration: integration,
+		}
+	case integration.IsConditionTrue(v1.IntegrationConditionStatefulSetAvailable):
+		obj = getUpdatedController(env, &appsv1.StatefulSet{})
+		sts, ok := obj.(*appsv1.StatefulSet)
+		if !ok {
+			return nil, fmt.Errorf("type assertion failed, not a StatefulSet: %v", obj)
+		}
+		controller = &statefulSetController{
+			obj:         sts,
+			integration: integration,
+		}
 	case integration.IsConditionTrue(v1.IntegrationConditionKnativeServiceAvailable):
 		obj = getUpdatedController(env, &servingv1.Service{})
 		svc, ok := obj.(*servingv1.Service)
@@ -512,8 +555,10 @@ func arePodsFailingStatuses(integration *v1.Integration, pendingPods []corev1.Po
 	return false
 }
 
-// probeReadiness calls the readiness probes of the non-ready Pods directly to retrieve insights from the Camel runtime.
+// probeReadiness calls the readiness probes of the Pods directly to retrieve insights from the Camel runtime.
 // The func return the number of readyPods, the success of the probe and any error may have happened during its execution.
+// The non-ready Pods are probed to report why they are not ready, and the ready ones, concurrently, to report their
+// health checks. The health checks reported by the probes are recorded in the Integration status.
 //
 //nolint:staticcheck
 func (action *monitorAction) probeReadiness(ctx context.Context, environment *trait.Environment, integration *v1.Integration, pods []corev1.Pod) (int32, bool, error) {
@@ -530,6 +575,9 @@ func (action *monitorAction) probeReadiness(ctx context.Context, environment *tr
 	runtimeReady := true
 	runtimeFailed := false
 	probeReadinessOk := true
+	probes := make(map[string]*v1.HealthCheck, len(pods))
+	probeErrors := make(map[string]error)
+	ready := make([]*corev1.Pod, 0, len(pods))
 
 	for i := range pods {
 		pod := &pods[i]
@@ -541,9 +589,10 @@ func (action *monitorAction) probeReadiness(ctx context.Context, environment *tr
 				break
 			}
 		}
-		// If it's in ready status, then we don't care to probe.
-		if ready := kubernetes.GetPodCondition(*pod, corev1.PodReady); ready.Status == corev1.ConditionTrue {
+		// The ready Pods are only probed for their health checks, once all the Pods are inspected
+		if condition := kubernetes.GetPodCondition(*pod, corev1.PodReady); condition.Status == corev1.ConditionTrue {
 			readyPods++
+			ready = append(ready, pod)
 
 			continue
 		}
@@ -597,6 +646,10 @@ func (action *monitorAction) probeReadiness(ctx context.Context, environment *tr
 			//     }]
 			//   }
 			if err == nil {
+				if health, err := NewHealthCheck(body); err == nil {
+					probes[pod.Name] = health
+				}
+
 				continue
 			}
 
@@ -617,6 +670,7 @@ func (action *monitorAction) probeReadiness(ctx context.Context, environment *tr
 			if err != nil {
 				return readyPods, false, err
 			}
+			probes[pod.Name] = health
 			for _, check := range health.Checks {
 				if check.Status == v1.HealthCheckStatusUp {
 					continue
@@ -630,6 +684,9 @@ func (action *monitorAction) probeReadiness(ctx context.Context, environment *tr
 		}
 	}
 
+	action.probeHealth(ctx, environment, ready, probes, probeErrors)
+	updateHealthStatus(integration, probes, probeErrors, time.Now())
+
 	if runtimeFailed {
 		probeReadinessOk = false
 		readyCondition.Reason = v1.IntegrationConditionErrorReason
@@ -648,6 +705,55 @@ func (action *monitorAction) probeReadiness(ctx context.Context, environment *tr
 	return readyPods, probeReadinessOk, nil
 }
 
+// probeHealth calls the readiness probes of the ready Pods, at most healthProbeConcurrency at a time, to retrieve the
+// health checks of the Camel runtime. The errors of the Pods that cannot be probed are reported instead.
+func (action *monitorAction) probeHealth(
+	ctx context.Context, environment *trait.Environment, pods []*corev1.Pod,
+	probes map[string]*v1.HealthCheck, probeErrors map[string]error,
+) {
+	var lock sync.Mutex
+	var g errgroup.Group
+	g.SetLimit(healthProbeConcurrency)
+	for _, pod := range pods {
+		g.Go(func() error {
+			health, err := action.probePodHealth(ctx, environment, pod)
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				action.L.Debugf("Cannot probe the health of Pod %s/%s: %v", pod.Namespace, pod.Name, err)
+				probeErrors[pod.Name] = err
+			} else if health != nil {
+				probes[pod.Name] = health
+			}
+
+			return nil
+		})
+	}
+	_ = g.Wait()
+}
+
+// probePodHealth calls the readiness probe of a ready Pod. No health check is returned when the Pod has no HTTP
+// readiness probe or is terminating.
+func (action *monitorAction) probePodHealth(ctx context.Context, environment *trait.Environment, pod *corev1.Pod) (*v1.HealthCheck, error) {
+	if pod.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	container := getIntegrationContainer(environment, pod)
+	if container == nil || container.ReadinessProbe == nil || container.ReadinessProbe.HTTPGet == nil {
+		return nil, nil
+	}
+	body, err := proxyGetHTTPProbe(ctx, action.client, container.ReadinessProbe, pod, container)
+	if err != nil {
+		return nil, fmt.Errorf("readiness probe failed: %w", err)
+	}
+	health, err := NewHealthCheck(body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse the readiness probe response: %w", err)
+	}
+
+	return health, nil
+}
+
 func findHighestPriorityReadyKit(kits []v1.IntegrationKit) (*v1.IntegrationKit, error) {
 	if len(kits) == 0 {
 		return nil, nil
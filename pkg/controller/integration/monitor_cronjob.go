@@ -20,9 +20,15 @@ package integration
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
+
+	"github.com/robfig/cron/v3"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -31,6 +37,16 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 )
 
+const (
+	// cronExecutionsHistoryLimit is the number of executions reported in the Integration status.
+	cronExecutionsHistoryLimit = 10
+	// cronMissedScheduleGracePeriod is the delay after which a scheduled execution that has not started is considered missed,
+	// unless the CronJob sets a starting deadline.
+	cronMissedScheduleGracePeriod = time.Minute
+	// cronMaxMissedSchedules caps the count of missed schedules, as the Kubernetes CronJob controller does.
+	cronMaxMissedSchedules = 100
+)
+
 type cronJobController struct {
 	obj              *batchv1.CronJob
 	integration      *v1.Integration
@@ -123,3 +139,194 @@ func (c *cronJobController) hasTemplateIntegrationLabel() bool {
 func (c *cronJobController) getControllerName() string {
 	return "CronJob/" + c.obj.Name
 }
+
+// updateExecutions records the last executions of the Integration, from the Jobs created by the CronJob, along with the
+// count of missed schedules and of consecutive failures.
+// The executions whose Job has been garbage collected are kept in the status, until they exceed the history limit.
+func (c *cronJobController) updateExecutions(ctx context.Context, now time.Time) error {
+	jobs := batchv1.JobList{}
+	if err := c.client.List(ctx, &jobs,
+		ctrl.InNamespace(c.integration.Namespace),
+		ctrl.MatchingLabels{v1.IntegrationLabel: c.integration.Name},
+	); err != nil {
+		return err
+	}
+	pods := corev1.PodList{}
+	if err := c.client.List(ctx, &pods,
+		ctrl.InNamespace(c.integration.Namespace),
+		ctrl.MatchingLabels{v1.IntegrationLabel: c.integration.Name},
+	); err != nil {
+		return err
+	}
+	jobPods := make(map[string]corev1.Pod)
+	for _, pod := range pods.Items {
+		job := pod.Labels[batchv1.JobNameLabel]
+		if last, ok := jobPods[job]; job != "" && (!ok || last.CreationTimestamp.Before(&pod.CreationTimestamp)) {
+			jobPods[job] = pod
+		}
+	}
+
+	status := c.integration.Status.Cron
+	if status == nil {
+		status = &v1.CronStatus{}
+	}
+	executions := make(map[string]v1.CronExecution, len(status.Executions)+len(jobs.Items))
+	for _, e := range status.Executions {
+		executions[e.Job] = e
+	}
+	var finished []v1.CronExecution
+	for _, job := range jobs.Items {
+		e := newCronExecution(job, jobPods[job.Name])
+		previous, known := executions[job.Name]
+		if e.Pod == "" {
+			e.Pod = previous.Pod
+		}
+		if e.Outcome != v1.CronExecutionOutcomeRunning && (!known || previous.Outcome == v1.CronExecutionOutcomeRunning) {
+			finished = append(finished, e)
+		}
+		executions[job.Name] = e
+	}
+
+	// Account the executions finished since the last reconciliation, in the order they completed
+	sort.SliceStable(finished, func(i, j int) bool {
+		return executionTime(finished[i].CompletionTime).Before(executionTime(finished[j].CompletionTime))
+	})
+	for _, e := range finished {
+		if e.Outcome == v1.CronExecutionOutcomeFailed {
+			status.ConsecutiveFailures++
+		} else {
+			status.ConsecutiveFailures = 0
+		}
+	}
+
+	status.Executions = make([]v1.CronExecution, 0, len(executions))
+	for _, e := range executions {
+		status.Executions = append(status.Executions, e)
+	}
+	sort.SliceStable(status.Executions, func(i, j int) bool {
+		return executionTime(status.Executions[j].StartTime).Before(executionTime(status.Executions[i].StartTime))
+	})
+	if len(status.Executions) > cronExecutionsHistoryLimit {
+		status.Executions = status.Executions[:cronExecutionsHistoryLimit]
+	}
+
+	missed, next, err := c.missedSchedules(now)
+	if err != nil {
+		return err
+	}
+	status.MissedSchedules = missed
+	status.NextScheduleTime = next
+	c.integration.Status.Cron = status
+
+	c.updateExecutionsCondition()
+	updateCronJobMetrics(c.integration)
+
+	return nil
+}
+
+// missedSchedules returns the number of scheduled executions that did not start since the last one, and the time of the next one.
+func (c *cronJobController) missedSchedules(now time.Time) (int32, *metav1.Time, error) {
+	if ptr.Deref(c.obj.Spec.Suspend, false) || c.obj.Spec.Schedule == "" {
+		return 0, nil, nil
+	}
+	schedule, err := cron.ParseStandard(c.obj.Spec.Schedule)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid schedule %q of %s: %w", c.obj.Spec.Schedule, c.getControllerName(), err)
+	}
+	location := time.UTC
+	if c.obj.Spec.TimeZone != nil {
+		if location, err = time.LoadLocation(*c.obj.Spec.TimeZone); err != nil {
+			return 0, nil, fmt.Errorf("invalid time zone %q of %s: %w", *c.obj.Spec.TimeZone, c.getControllerName(), err)
+		}
+	}
+	grace := cronMissedScheduleGracePeriod
+	if c.obj.Spec.StartingDeadlineSeconds != nil {
+		grace = time.Duration(*c.obj.Spec.StartingDeadlineSeconds) * time.Second
+	}
+
+	last := c.obj.CreationTimestamp.Time
+	if c.obj.Status.LastScheduleTime != nil {
+		last = c.obj.Status.LastScheduleTime.Time
+	}
+	var missed int32
+	next := schedule.Next(last.In(location))
+	for !next.Add(grace).After(now) {
+		missed++
+		if missed == cronMaxMissedSchedules {
+			next = schedule.Next(now.In(location))
+
+			break
+		}
+		next = schedule.Next(next)
+	}
+	nextTime := metav1.NewTime(next)
+
+	return missed, &nextTime, nil
+}
+
+func (c *cronJobController) updateExecutionsCondition() {
+	status := c.integration.Status.Cron
+	switch {
+	case ptr.Deref(c.obj.Spec.Suspend, false):
+		c.integration.Status.SetCondition(v1.IntegrationConditionCronJobExecutions, corev1.ConditionTrue,
+			v1.IntegrationConditionCronJobSuspendedReason, "the scheduled executions are suspended")
+	case status.ConsecutiveFailures > 0:
+		c.integration.Status.SetCondition(v1.IntegrationConditionCronJobExecutions, corev1.ConditionFalse,
+			v1.IntegrationConditionCronJobConsecutiveFailuresReason,
+			fmt.Sprintf("%d consecutive executions failed", status.ConsecutiveFailures))
+	case status.MissedSchedules > 0:
+		c.integration.Status.SetCondition(v1.IntegrationConditionCronJobExecutions, corev1.ConditionFalse,
+			v1.IntegrationConditionCronJobMissedSchedulesReason,
+			fmt.Sprintf("%d scheduled executions missed", status.MissedSchedules))
+	default:
+		c.integration.Status.SetCondition(v1.IntegrationConditionCronJobExecutions, corev1.ConditionTrue,
+			v1.IntegrationConditionCronJobExecutionsSucceededReason, "the scheduled executions succeed")
+	}
+}
+
+func newCronExecution(job batchv1.Job, pod corev1.Pod) v1.CronExecution {
+	e := v1.CronExecution{
+		Job:       job.Name,
+		Pod:       pod.Name,
+		Manual:    kubernetes.IsManualJob(job),
+		Outcome:   v1.CronExecutionOutcomeRunning,
+		StartTime: job.Status.StartTime,
+	}
+	if e.StartTime == nil {
+		e.StartTime = job.CreationTimestamp.DeepCopy()
+	}
+	if failed := kubernetes.GetJobCondition(job, batchv1.JobFailed); failed != nil && failed.Status == corev1.ConditionTrue {
+		e.Outcome = v1.CronExecutionOutcomeFailed
+		e.CompletionTime = failed.LastTransitionTime.DeepCopy()
+	} else if complete := kubernetes.GetJobCondition(job, batchv1.JobComplete); complete != nil && complete.Status == corev1.ConditionTrue {
+		e.Outcome = v1.CronExecutionOutcomeSucceeded
+		e.CompletionTime = job.Status.CompletionTime
+		if e.CompletionTime == nil {
+			e.CompletionTime = complete.LastTransitionTime.DeepCopy()
+		}
+	}
+
+	return e
+}
+
+func executionTime(t *metav1.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return t.Time
+}
+
+// cronScheduleDelay returns the delay until the next scheduled execution of an Integration running as a CronJob is
+// expected to have started, so that missed schedules are detected.
+func cronScheduleDelay(it *v1.Integration) time.Duration {
+	if it.Status.Cron == nil || it.Status.Cron.NextScheduleTime == nil {
+		return 0
+	}
+	if d := time.Until(it.Status.Cron.NextScheduleTime.Add(cronMissedScheduleGracePeriod)); d > 0 {
+		return d
+	}
+
+	// Keep checking while schedules are missed
+	return cronMissedScheduleGracePeriod
+}
@@ -0,0 +1,219 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+var cronTestNow = time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC)
+
+func newCronTestJob(name string, start time.Time, outcome v1.CronExecutionOutcome) *batchv1.Job {
+	startTime := metav1.NewTime(start)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "ns",
+			Name:              name,
+			Labels:            map[string]string{v1.IntegrationLabel: "my-it"},
+			CreationTimestamp: startTime,
+		},
+		Status: batchv1.JobStatus{StartTime: &startTime},
+	}
+	endTime := metav1.NewTime(start.Add(30 * time.Second))
+	switch outcome {
+	case v1.CronExecutionOutcomeSucceeded:
+		job.Status.CompletionTime = &endTime
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue, LastTransitionTime: endTime}}
+	case v1.CronExecutionOutcomeFailed:
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, LastTransitionTime: endTime}}
+	}
+
+	return job
+}
+
+func newCronTestPod(name, job string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      name,
+			Labels: map[string]string{
+				v1.IntegrationLabel:  "my-it",
+				batchv1.JobNameLabel: job,
+			},
+		},
+	}
+}
+
+func newCronTestController(t *testing.T, it *v1.Integration, objs ...runtime.Object) *cronJobController {
+	t.Helper()
+	c, err := internal.NewFakeClient(objs...)
+	require.NoError(t, err)
+	lastSchedule := metav1.NewTime(time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC))
+
+	return &cronJobController{
+		obj: &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-it"},
+			Spec:       batchv1.CronJobSpec{Schedule: "*/10 * * * *"},
+			Status:     batchv1.CronJobStatus{LastScheduleTime: &lastSchedule},
+		},
+		integration: it,
+		client:      c,
+	}
+}
+
+func TestCronJobExecutions(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	manual := newCronTestJob("my-it-manual-abcde", time.Date(2026, 10, 19, 10, 5, 0, 0, time.UTC), v1.CronExecutionOutcomeRunning)
+	manual.Annotations = map[string]string{kubernetes.CronJobInstantiateAnnotation: kubernetes.CronJobInstantiateManual}
+	c := newCronTestController(t, &it,
+		newCronTestJob("my-it-1", time.Date(2026, 10, 19, 9, 50, 0, 0, time.UTC), v1.CronExecutionOutcomeSucceeded),
+		newCronTestJob("my-it-2", time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC), v1.CronExecutionOutcomeFailed),
+		manual,
+		newCronTestPod("my-it-2-xyz", "my-it-2"),
+	)
+
+	require.NoError(t, c.updateExecutions(context.TODO(), cronTestNow))
+
+	status := it.Status.Cron
+	require.NotNil(t, status)
+	require.Len(t, status.Executions, 3)
+	assert.Equal(t, "my-it-manual-abcde", status.Executions[0].Job)
+	assert.True(t, status.Executions[0].Manual)
+	assert.Equal(t, v1.CronExecutionOutcomeRunning, status.Executions[0].Outcome)
+	assert.Nil(t, status.Executions[0].CompletionTime)
+	failed := status.Executions[1]
+	assert.Equal(t, "my-it-2", failed.Job)
+	assert.Equal(t, "my-it-2-xyz", failed.Pod)
+	assert.False(t, failed.Manual)
+	assert.Equal(t, v1.CronExecutionOutcomeFailed, failed.Outcome)
+	assert.True(t, failed.StartTime.Equal(ptr.To(metav1.NewTime(time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)))))
+	assert.True(t, failed.CompletionTime.Equal(ptr.To(metav1.NewTime(time.Date(2026, 10, 19, 10, 0, 30, 0, time.UTC)))))
+	assert.Equal(t, v1.CronExecutionOutcomeSucceeded, status.Executions[2].Outcome)
+
+	assert.Equal(t, int32(1), status.ConsecutiveFailures)
+	assert.Equal(t, int32(2), status.MissedSchedules)
+	assert.Equal(t, time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC), status.NextScheduleTime.UTC())
+
+	condition := it.Status.GetCondition(v1.IntegrationConditionCronJobExecutions)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionCronJobConsecutiveFailuresReason, condition.Reason)
+}
+
+func TestCronJobExecutionsKeepsHistory(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	it.Status.Cron = &v1.CronStatus{
+		ConsecutiveFailures: 2,
+		Executions: []v1.CronExecution{
+			{Job: "my-it-2", Outcome: v1.CronExecutionOutcomeRunning, StartTime: ptr.To(metav1.NewTime(time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)))},
+			{Job: "my-it-1", Outcome: v1.CronExecutionOutcomeFailed, StartTime: ptr.To(metav1.NewTime(time.Date(2026, 10, 19, 9, 50, 0, 0, time.UTC)))},
+		},
+	}
+	// The first Job has been garbage collected, and the second one succeeded
+	c := newCronTestController(t, &it,
+		newCronTestJob("my-it-2", time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC), v1.CronExecutionOutcomeSucceeded),
+	)
+
+	require.NoError(t, c.updateExecutions(context.TODO(), time.Date(2026, 10, 19, 10, 5, 0, 0, time.UTC)))
+
+	status := it.Status.Cron
+	require.Len(t, status.Executions, 2)
+	assert.Equal(t, "my-it-2", status.Executions[0].Job)
+	assert.Equal(t, v1.CronExecutionOutcomeSucceeded, status.Executions[0].Outcome)
+	assert.Equal(t, "my-it-1", status.Executions[1].Job)
+	assert.Zero(t, status.ConsecutiveFailures)
+	assert.Zero(t, status.MissedSchedules)
+	assert.Equal(t, v1.IntegrationConditionCronJobExecutionsSucceededReason,
+		it.Status.GetCondition(v1.IntegrationConditionCronJobExecutions).Reason)
+}
+
+func TestCronJobExecutionsHistoryLimit(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	objs := make([]runtime.Object, 0, cronExecutionsHistoryLimit+2)
+	for i := range cronExecutionsHistoryLimit + 2 {
+		start := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC).Add(time.Duration(i) * 10 * time.Minute)
+		objs = append(objs, newCronTestJob(start.Format("my-it-1504"), start, v1.CronExecutionOutcomeFailed))
+	}
+	c := newCronTestController(t, &it, objs...)
+
+	require.NoError(t, c.updateExecutions(context.TODO(), cronTestNow))
+
+	status := it.Status.Cron
+	assert.Len(t, status.Executions, cronExecutionsHistoryLimit)
+	assert.Equal(t, "my-it-0950", status.Executions[0].Job)
+	assert.Equal(t, int32(cronExecutionsHistoryLimit+2), status.ConsecutiveFailures)
+}
+
+func TestCronJobMissedSchedules(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	c := newCronTestController(t, &it)
+
+	missed, next, err := c.missedSchedules(time.Date(2026, 10, 19, 10, 10, 30, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Zero(t, missed)
+	assert.Equal(t, time.Date(2026, 10, 19, 10, 10, 0, 0, time.UTC), next.UTC())
+
+	missed, next, err = c.missedSchedules(time.Date(2026, 10, 19, 11, 5, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, int32(6), missed)
+	assert.Equal(t, time.Date(2026, 10, 19, 11, 10, 0, 0, time.UTC), next.UTC())
+
+	// The starting deadline replaces the default grace period
+	c.obj.Spec.StartingDeadlineSeconds = ptr.To(int64(600))
+	missed, _, err = c.missedSchedules(time.Date(2026, 10, 19, 11, 5, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), missed)
+
+	c.obj.Spec.TimeZone = ptr.To("Europe/Paris")
+	c.obj.Spec.Schedule = "0 12 * * *"
+	_, next, err = c.missedSchedules(time.Date(2026, 10, 19, 11, 5, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 20, 10, 0, 0, 0, time.UTC), next.UTC())
+
+	c.obj.Spec.Suspend = ptr.To(true)
+	missed, next, err = c.missedSchedules(time.Date(2026, 10, 19, 11, 5, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Zero(t, missed)
+	assert.Nil(t, next)
+}
+
+func TestCronScheduleDelay(t *testing.T) {
+	it := v1.NewIntegration("ns", "my-it")
+	assert.Zero(t, cronScheduleDelay(&it))
+
+	it.Status.Cron = &v1.CronStatus{NextScheduleTime: ptr.To(metav1.NewTime(time.Now().Add(time.Minute)))}
+	assert.InDelta(t, 2*time.Minute, cronScheduleDelay(&it), float64(time.Second))
+
+	it.Status.Cron.NextScheduleTime = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+	assert.Equal(t, cronMissedScheduleGracePeriod, cronScheduleDelay(&it))
+}
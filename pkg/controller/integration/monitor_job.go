@@ -0,0 +1,82 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+type jobController struct {
+	obj         *batchv1.Job
+	integration *v1.Integration
+}
+
+var _ controller = &jobController{}
+
+func (c *jobController) checkReadyCondition(ctx context.Context) (bool, error) {
+	// The Job outcome is final, and reported as a terminal phase of the Integration
+	if complete := kubernetes.GetJobCondition(*c.obj, batchv1.JobComplete); complete != nil &&
+		complete.Status == corev1.ConditionTrue {
+		c.integration.Status.Phase = v1.IntegrationPhaseSucceeded
+		c.integration.SetReadyCondition(corev1.ConditionTrue,
+			v1.IntegrationConditionJobSucceededReason,
+			fmt.Sprintf("job %s completed successfully: %d succeeded pods", c.obj.Name, c.obj.Status.Succeeded))
+
+		return true, nil
+	}
+	if failed := kubernetes.GetJobCondition(*c.obj, batchv1.JobFailed); failed != nil &&
+		failed.Status == corev1.ConditionTrue {
+		c.integration.Status.Phase = v1.IntegrationPhaseFailed
+		c.integration.SetReadyCondition(corev1.ConditionFalse,
+			v1.IntegrationConditionJobFailedReason,
+			fmt.Sprintf("job %s failed: %s", c.obj.Name, failed.Message))
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (c *jobController) updateReadyCondition(readyPods int32) bool {
+	if c.obj.Status.Active > 0 {
+		c.integration.SetReadyCondition(corev1.ConditionTrue,
+			v1.IntegrationConditionJobActiveReason,
+			fmt.Sprintf("job active: %d running pods", c.obj.Status.Active))
+
+		return true
+	}
+	c.integration.SetReadyCondition(corev1.ConditionFalse,
+		v1.IntegrationConditionJobPendingReason, "job pending")
+
+	return false
+}
+
+func (c *jobController) hasTemplateIntegrationLabel() bool {
+	return c.obj.Spec.Template.Labels[v1.IntegrationLabel] != ""
+}
+
+func (c *jobController) getControllerName() string {
+	return "Job/" + c.obj.Name
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -138,6 +139,39 @@ func TestMonitorFailureIntegration(t *testing.T) {
 	assert.Equal(t, v1.IntegrationConditionInitializationFailedReason, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Reason)
 }
 
+func TestMonitorJobReadyCondition(t *testing.T) {
+	it := &v1.Integration{Status: v1.IntegrationStatus{Phase: v1.IntegrationPhaseRunning}}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-it"},
+		Status:     batchv1.JobStatus{Active: 1},
+	}
+	c := &jobController{obj: job, integration: it}
+
+	done, err := c.checkReadyCondition(context.TODO())
+	require.NoError(t, err)
+	assert.False(t, done)
+	assert.True(t, c.updateReadyCondition(0))
+	assert.Equal(t, v1.IntegrationConditionJobActiveReason, it.Status.GetCondition(v1.IntegrationConditionReady).Reason)
+
+	job.Status.Active = 0
+	job.Status.Succeeded = 1
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	done, err = c.checkReadyCondition(context.TODO())
+	require.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, v1.IntegrationPhaseSucceeded, it.Status.Phase)
+	assert.Equal(t, corev1.ConditionTrue, it.Status.GetCondition(v1.IntegrationConditionReady).Status)
+
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "backoff limit exceeded"}}
+	done, err = c.checkReadyCondition(context.TODO())
+	require.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, v1.IntegrationPhaseFailed, it.Status.Phase)
+	assert.Equal(t, corev1.ConditionFalse, it.Status.GetCondition(v1.IntegrationConditionReady).Status)
+	assert.Equal(t, v1.IntegrationConditionJobFailedReason, it.Status.GetCondition(v1.IntegrationConditionReady).Reason)
+}
+
 func nominalEnvironment() (client.Client, *v1.Integration, error) {
 	catalog := &v1.CamelCatalog{
 		TypeMeta: metav1.TypeMeta{
@@ -0,0 +1,200 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"context"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/monitoring"
+)
+
+// Add creates a new Kamelet Controller and adds it to the Manager. The controller is in charge to keep track
+// of the Integrations using each Kamelet.
+func Add(ctx context.Context, mgr manager.Manager, c client.Client) error {
+	return add(mgr, newReconciler(c), c)
+}
+
+func newReconciler(c client.Client) reconcile.Reconciler {
+	return monitoring.NewInstrumentedReconciler(
+		&reconcileKamelet{
+			client: c,
+		},
+		schema.GroupVersionKind{
+			Group:   v1.SchemeGroupVersion.Group,
+			Version: v1.SchemeGroupVersion.Version,
+			Kind:    v1.KameletKind,
+		},
+	)
+}
+
+func add(mgr manager.Manager, r reconcile.Reconciler, c client.Client) error {
+	ctrl, err := controller.New("kamelet-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource Kamelet
+	err = ctrl.Watch(
+		source.Kind(
+			mgr.GetCache(),
+			&v1.Kamelet{},
+			&handler.TypedEnqueueRequestForObject[*v1.Kamelet]{},
+			platform.FilteringFuncs[*v1.Kamelet]{
+				UpdateFunc: func(e event.TypedUpdateEvent[*v1.Kamelet]) bool {
+					// Ignore updates to the Kamelet status, which is owned by this controller
+					return e.ObjectOld.Generation != e.ObjectNew.Generation
+				},
+				DeleteFunc: func(e event.TypedDeleteEvent[*v1.Kamelet]) bool {
+					// Nothing to track for a deleted Kamelet
+					return false
+				},
+			},
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Watch Integrations to refresh the usage of the Kamelets they reference (before and after the change)
+	err = ctrl.Watch(
+		source.Kind(mgr.GetCache(),
+			&v1.Integration{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, it *v1.Integration) []reconcile.Request {
+				return kameletRequestsForIntegration(it)
+			}),
+			platform.FilteringFuncs[*v1.Integration]{
+				UpdateFunc: func(e event.TypedUpdateEvent[*v1.Integration]) bool {
+					return kameletsList(e.ObjectOld) != kameletsList(e.ObjectNew)
+				},
+			},
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// kameletRequestsForIntegration enqueues any Kamelet possibly resolved by the given Integration.
+func kameletRequestsForIntegration(it *v1.Integration) []reconcile.Request {
+	requests := make([]reconcile.Request, 0)
+	names, namespaces := integrationKamelets(it)
+	for _, name := range names {
+		for _, ns := range namespaces {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: ns,
+					Name:      name,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+var _ reconcile.Reconciler = &reconcileKamelet{}
+
+// reconcileKamelet reconciles a Kamelet object.
+type reconcileKamelet struct {
+	// This client, initialized using mgr.Client() above, is a split client
+	// that reads objects from the cache and writes to the API server
+	client client.Client
+}
+
+// Reconcile computes the list of Integrations using the Kamelet and stores it into the Kamelet status.
+func (r *reconcileKamelet) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	rlog := Log.WithValues("request-namespace", request.Namespace, "request-name", request.Name)
+	rlog.Debug("Reconciling Kamelet")
+
+	// Make sure the operator is allowed to act on namespace
+	if ok, err := platform.IsOperatorAllowedOnNamespace(ctx, r.client, request.Namespace); err != nil {
+		return reconcile.Result{}, err
+	} else if !ok {
+		rlog.Info("Ignoring request because namespace is locked")
+
+		return reconcile.Result{}, nil
+	}
+
+	var instance v1.Kamelet
+	if err := r.client.Get(ctx, request.NamespacedName, &instance); err != nil {
+		if k8serrors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, err
+	}
+
+	// Only process resources assigned to the operator
+	if !platform.IsOperatorHandlerConsideringLock(ctx, r.client, request.Namespace, &instance) {
+		rlog.Info("Ignoring request because resource is not assigned to current operator")
+
+		return reconcile.Result{}, nil
+	}
+
+	usage, err := kameletUsage(ctx, r.client, &instance)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not compute usage for Kamelet %s/%s: %w", instance.Namespace, instance.Name, err)
+	}
+
+	if sameUsage(instance.Status.Integrations, usage) {
+		return reconcile.Result{}, nil
+	}
+
+	target := instance.DeepCopy()
+	target.Status.Integrations = usage
+	target.Status.UsageCount = len(usage)
+	if err := r.client.Status().Patch(ctx, target, ctrl.MergeFrom(&instance)); err != nil {
+		return reconcile.Result{}, err
+	}
+	rlog.Debug("Kamelet usage updated", "usage-count", target.Status.UsageCount)
+
+	return reconcile.Result{}, nil
+}
+
+func sameUsage(current, expected []v1.KameletUsage) bool {
+	if len(current) != len(expected) {
+		return false
+	}
+	for i := range current {
+		if current[i] != expected[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,136 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util"
+)
+
+// kameletsList returns the Kamelets list as computed by the kamelets trait execution.
+func kameletsList(it *v1.Integration) string {
+	if it == nil || it.Status.Traits == nil || it.Status.Traits.Kamelets == nil {
+		return ""
+	}
+
+	return it.Status.Traits.Kamelets.List
+}
+
+// integrationKamelets parses the Kamelets used by the Integration and returns their names along with the ordered
+// namespaces where the kamelets trait looks them up: any namespace explicitly referenced (ie, my-kamelet?kameletNamespace=ns),
+// the Integration namespace and finally the operator namespace.
+func integrationKamelets(it *v1.Integration) ([]string, []string) {
+	list := kameletsList(it)
+	if list == "" {
+		return nil, nil
+	}
+	var names []string
+	var namespaces []string
+	for item := range strings.SplitSeq(list, ",") {
+		parsedURL, err := url.Parse(strings.TrimSpace(item))
+		if err != nil {
+			continue
+		}
+		name, _, _ := strings.Cut(parsedURL.Path, "/")
+		if name == "" || !v1.ValidKameletName(name) {
+			continue
+		}
+		util.StringSliceUniqueAdd(&names, name)
+		if ns := parsedURL.Query().Get(v1.KameletNamespaceProperty); ns != "" {
+			util.StringSliceUniqueAdd(&namespaces, ns)
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	for _, ns := range []string{it.Namespace, platform.GetOperatorNamespace()} {
+		if ns != "" {
+			util.StringSliceUniqueAdd(&namespaces, ns)
+		}
+	}
+
+	return names, namespaces
+}
+
+// kameletUsage returns the sorted list of Integrations resolving the given Kamelet.
+func kameletUsage(ctx context.Context, c ctrl.Reader, kamelet *v1.Kamelet) ([]v1.KameletUsage, error) {
+	list := v1.NewIntegrationList()
+	var opts []ctrl.ListOption
+	// Integrations in other namespaces may only use a Kamelet in the operator namespace or
+	// when explicitly referencing its namespace (which requires a global operator)
+	if !platform.IsCurrentOperatorGlobal() && kamelet.Namespace != platform.GetOperatorNamespace() {
+		opts = append(opts, ctrl.InNamespace(kamelet.Namespace))
+	}
+	if err := c.List(ctx, &list, opts...); err != nil {
+		return nil, err
+	}
+
+	usage := make([]v1.KameletUsage, 0)
+	for i := range list.Items {
+		it := &list.Items[i]
+		names, namespaces := integrationKamelets(it)
+		if !slices.Contains(names, kamelet.Name) {
+			continue
+		}
+		ns, err := resolvedNamespace(ctx, c, kamelet.Name, namespaces)
+		if err != nil {
+			return nil, err
+		}
+		if ns == kamelet.Namespace {
+			usage = append(usage, v1.KameletUsage{Namespace: it.Namespace, Name: it.Name})
+		}
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Namespace != usage[j].Namespace {
+			return usage[i].Namespace < usage[j].Namespace
+		}
+
+		return usage[i].Name < usage[j].Name
+	})
+
+	return usage, nil
+}
+
+// resolvedNamespace returns the first namespace containing the named Kamelet, or an empty string if none does.
+func resolvedNamespace(ctx context.Context, c ctrl.Reader, name string, namespaces []string) (string, error) {
+	for _, ns := range namespaces {
+		kamelet := v1.NewKamelet(ns, name)
+		if err := c.Get(ctx, ctrl.ObjectKeyFromObject(&kamelet), &kamelet); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+
+			return "", err
+		}
+
+		return ns, nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,117 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKameletUsage(t *testing.T) {
+	t.Setenv("NAMESPACE", "camel-k")
+
+	kamelet := v1.NewKamelet("camel-k", "timer-source")
+	shadowed := v1.NewKamelet("other", "timer-source")
+	c, err := internal.NewFakeClient(
+		&kamelet,
+		&shadowed,
+		integrationWithKamelets("default", "it-1", "log-sink,timer-source"),
+		integrationWithKamelets("default", "it-2", "timer-source?kameletVersion=v1"),
+		integrationWithKamelets("camel-k", "it-3", "timer-source"),
+		integrationWithKamelets("other", "it-4", "timer-source"),
+		integrationWithKamelets("other", "it-5", "timer-source?kameletNamespace=camel-k"),
+		integrationWithKamelets("default", "it-6", "log-sink"),
+		integrationWithKamelets("default", "it-7", ""),
+	)
+	require.NoError(t, err)
+
+	usage, err := kameletUsage(context.TODO(), c, &kamelet)
+	require.NoError(t, err)
+	assert.Equal(t, []v1.KameletUsage{
+		{Namespace: "camel-k", Name: "it-3"},
+		{Namespace: "default", Name: "it-1"},
+		{Namespace: "default", Name: "it-2"},
+		{Namespace: "other", Name: "it-5"},
+	}, usage)
+
+	usage, err = kameletUsage(context.TODO(), c, &shadowed)
+	require.NoError(t, err)
+	assert.Equal(t, []v1.KameletUsage{
+		{Namespace: "other", Name: "it-4"},
+	}, usage)
+}
+
+func TestKameletRequestsForIntegration(t *testing.T) {
+	t.Setenv("NAMESPACE", "camel-k")
+
+	requests := kameletRequestsForIntegration(
+		integrationWithKamelets("default", "it", "source,timer-source,log-sink?kameletNamespace=shared"))
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: namespacedName("shared", "timer-source")},
+		{NamespacedName: namespacedName("default", "timer-source")},
+		{NamespacedName: namespacedName("camel-k", "timer-source")},
+		{NamespacedName: namespacedName("shared", "log-sink")},
+		{NamespacedName: namespacedName("default", "log-sink")},
+		{NamespacedName: namespacedName("camel-k", "log-sink")},
+	}, requests)
+}
+
+func TestReconcileKameletUsage(t *testing.T) {
+	t.Setenv("NAMESPACE", "camel-k")
+
+	kamelet := v1.NewKamelet("default", "timer-source")
+	c, err := internal.NewFakeClient(
+		&kamelet,
+		integrationWithKamelets("default", "it-1", "timer-source"),
+	)
+	require.NoError(t, err)
+
+	r := &reconcileKamelet{client: c}
+	_, err = r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: namespacedName("default", "timer-source")})
+	require.NoError(t, err)
+
+	updated := v1.NewKamelet("default", "timer-source")
+	require.NoError(t, c.Get(context.TODO(), namespacedName("default", "timer-source"), &updated))
+	assert.Equal(t, 1, updated.Status.UsageCount)
+	assert.Equal(t, []v1.KameletUsage{{Namespace: "default", Name: "it-1"}}, updated.Status.Integrations)
+}
+
+func integrationWithKamelets(namespace, name, list string) *v1.Integration {
+	it := v1.NewIntegration(namespace, name)
+	it.Status.Traits = &v1.Traits{
+		Kamelets: &traitv1.KameletsTrait{
+			List: list,
+		},
+	}
+
+	return &it
+}
+
+func namespacedName(namespace, name string) types.NamespacedName {
+	return types.NamespacedName{Namespace: namespace, Name: name}
+}
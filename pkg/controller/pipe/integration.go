@@ -26,8 +26,10 @@ import (
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/v2/pkg/trait"
 
 	"github.com/apache/camel-k/v2/pkg/client"
@@ -257,11 +259,32 @@ func configureBinding(integration *v1.Integration, bindings ...*bindings.Binding
 
 			integration.Spec.AddConfigurationProperty(entry)
 		}
+		configureBindingConfigs(integration, b.Configs)
 	}
 
 	return nil
 }
 
+// configureBindingConfigs mounts the Secret and ConfigMap keys referenced by endpoint properties. Hot reload is enabled
+// unless explicitly set by the user, so that the Integration is restarted when any referenced value is rotated.
+func configureBindingConfigs(integration *v1.Integration, configs []string) {
+	if len(configs) == 0 {
+		return
+	}
+	// the trait may be shared with the Pipe, it must be copied before being changed
+	mount := &traitv1.MountTrait{}
+	if integration.Spec.Traits.Mount != nil {
+		mount = integration.Spec.Traits.Mount.DeepCopy()
+	}
+	integration.Spec.Traits.Mount = mount
+	for _, c := range configs {
+		util.StringSliceUniqueAdd(&integration.Spec.Traits.Mount.Configs, c)
+	}
+	if integration.Spec.Traits.Mount.HotReload == nil {
+		integration.Spec.Traits.Mount.HotReload = ptr.To(true)
+	}
+}
+
 //nolint:staticcheck
 func determineTraitProfile(ctx context.Context, c client.Client, binding *v1.Pipe) (v1.TraitProfile, error) {
 	pl, err := platform.GetForResource(ctx, c, binding)
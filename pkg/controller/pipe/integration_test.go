@@ -219,6 +219,57 @@ func TestCreateIntegrationForPipeDataTypeOverridden(t *testing.T) {
 	assert.Equal(t, expectedNominalRouteWithDataType(newDataTypeKameletAction), string(dsl))
 }
 
+func TestCreateIntegrationForPipeWithSecretRefProperties(t *testing.T) {
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	pipe := nominalPipe("my-pipe")
+	pipe.Spec.Source.Properties = &v1.EndpointProperties{
+		RawMessage: []byte(`{"user":"admin","password":{"secretKeyRef":{"name":"my-secret","key":"pwd"}}}`),
+	}
+	pipe.Spec.Sink.Properties = &v1.EndpointProperties{
+		RawMessage: []byte(`{"token":{"secretKeyRef":{"name":"my-secret","key":"pwd"}}}`),
+	}
+
+	it, err := CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.NoError(t, err)
+	assert.Contains(t, it.Spec.Configuration, v1.ConfigurationSpec{
+		Type: "property", Value: "camel.kamelet.my-source.source.password = {{secret:my-secret/pwd}}",
+	})
+	assert.Contains(t, it.Spec.Configuration, v1.ConfigurationSpec{
+		Type: "property", Value: "camel.kamelet.my-source.source.user = admin",
+	})
+	assert.Contains(t, it.Spec.Configuration, v1.ConfigurationSpec{
+		Type: "property", Value: "camel.kamelet.my-sink.sink.token = {{secret:my-secret/pwd}}",
+	})
+	require.NotNil(t, it.Spec.Traits.Mount)
+	assert.Equal(t, []string{"secret:my-secret/pwd"}, it.Spec.Traits.Mount.Configs)
+	assert.Equal(t, ptr.To(true), it.Spec.Traits.Mount.HotReload)
+}
+
+func TestCreateIntegrationForPipeWithSecretRefPropertiesNoHotReload(t *testing.T) {
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	pipe := nominalPipe("my-pipe")
+	pipe.Spec.Traits = &v1.Traits{
+		Mount: &trait.MountTrait{
+			Configs:   []string{"configmap:my-cm"},
+			HotReload: ptr.To(false),
+		},
+	}
+	pipe.Spec.Source.Properties = &v1.EndpointProperties{
+		RawMessage: []byte(`{"password":{"secretKeyRef":{"name":"my-secret","key":"pwd"}}}`),
+	}
+
+	it, err := CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"configmap:my-cm", "secret:my-secret/pwd"}, it.Spec.Traits.Mount.Configs)
+	assert.Equal(t, ptr.To(false), it.Spec.Traits.Mount.HotReload)
+	// the Pipe traits must not be altered
+	assert.Equal(t, []string{"configmap:my-cm"}, pipe.Spec.Traits.Mount.Configs)
+}
+
 func nominalPipe(name string) v1.Pipe {
 	pipe := v1.NewPipe("default", name)
 	pipe.Annotations = map[string]string{
@@ -122,11 +122,11 @@ func (action *monitorAction) Handle(ctx context.Context, pipe *v1.Pipe) (*v1.Pip
 	target := pipe.DeepCopy()
 
 	switch it.Status.Phase {
-	case v1.IntegrationPhaseRunning:
+	case v1.IntegrationPhaseRunning, v1.IntegrationPhaseSucceeded:
 		target.Status.Phase = v1.PipePhaseReady
 		setPipeReadyCondition(target, &it)
 
-	case v1.IntegrationPhaseError:
+	case v1.IntegrationPhaseError, v1.IntegrationPhaseFailed:
 		target.Status.Phase = v1.PipePhaseError
 		setPipeReadyCondition(target, &it)
 
@@ -161,6 +161,8 @@ func (action *monitorAction) Handle(ctx context.Context, pipe *v1.Pipe) (*v1.Pip
 		target.Status.SetConditions(c)
 	}
 
+	setPipeKameletsDeprecatedCondition(target, &it)
+
 	// Mirror status replicas and selector
 	target.Status.Replicas = it.Status.Replicas
 	target.Status.Selector = it.Status.Selector
@@ -201,6 +203,26 @@ func setPipeReadyCondition(kb *v1.Pipe, it *v1.Integration) {
 	}
 }
 
+// setPipeKameletsDeprecatedCondition mirrors the Integration Kamelets deprecation notice (if any) into the Pipe.
+func setPipeKameletsDeprecatedCondition(kb *v1.Pipe, it *v1.Integration) {
+	condition := it.Status.GetCondition(v1.IntegrationConditionKameletsDeprecated)
+	if condition == nil {
+		kb.Status.RemoveCondition(v1.PipeConditionKameletsDeprecated)
+
+		return
+	}
+	// The Pipe conditions are not updated when only the message changes
+	if current := kb.Status.GetCondition(v1.PipeConditionKameletsDeprecated); current != nil && current.Message != condition.Message {
+		kb.Status.RemoveCondition(v1.PipeConditionKameletsDeprecated)
+	}
+	kb.Status.SetCondition(
+		v1.PipeConditionKameletsDeprecated,
+		condition.Status,
+		condition.Reason,
+		condition.Message,
+	)
+}
+
 // Deprecated: to be removed in future versions, when we won't support any longer trait annotations into Pipes.
 func (action *monitorAction) checkTraitAnnotationsDeprecatedNotice(pipe *v1.Pipe) {
 	if pipe.Annotations != nil {
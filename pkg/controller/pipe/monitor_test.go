@@ -420,3 +420,61 @@ func TestPipeIntegrationBuildComplete(t *testing.T) {
 	assert.Equal(t, "BuildComplete", handledPipe.Status.GetCondition(v1.PipeConditionReady).Reason)
 	assert.Equal(t, "Integration \"my-pipe\" build completed successfully", handledPipe.Status.GetCondition(v1.PipeConditionReady).Message)
 }
+
+func TestPipeIntegrationKameletsDeprecated(t *testing.T) {
+	pipe := &v1.Pipe{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.PipeKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-pipe",
+		},
+		Spec: v1.PipeSpec{
+			Source: v1.Endpoint{
+				URI: ptr.To("timer:tick"),
+			},
+			Sink: v1.Endpoint{
+				URI: ptr.To("log:info"),
+			},
+		},
+		Status: v1.PipeStatus{
+			Phase: v1.PipePhaseReady,
+		},
+	}
+
+	c, err := internal.NewFakeClient(pipe)
+	require.NoError(t, err)
+	it, err := CreateIntegrationFor(context.TODO(), c, pipe)
+	require.NoError(t, err)
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	it.Status.SetCondition(v1.IntegrationConditionReady, corev1.ConditionTrue, "Running", "Running")
+	it.Status.SetCondition(
+		v1.IntegrationConditionKameletsDeprecated,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionKameletsDeprecatedReason,
+		"Kamelet old-sink is deprecated (use new-sink instead)",
+	)
+	c, err = internal.NewFakeClient(pipe, it)
+	require.NoError(t, err)
+
+	a := NewMonitorAction()
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledPipe, err := a.Handle(context.TODO(), pipe)
+	require.NoError(t, err)
+	cond := handledPipe.Status.GetCondition(v1.PipeConditionKameletsDeprecated)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, "Kamelet old-sink is deprecated (use new-sink instead)", cond.Message)
+
+	// The notice goes away as soon as the Integration does not use deprecated Kamelets any longer
+	it.Status.RemoveCondition(v1.IntegrationConditionKameletsDeprecated)
+	c, err = internal.NewFakeClient(handledPipe, it)
+	require.NoError(t, err)
+	a.InjectClient(c)
+	handledPipe, err = a.Handle(context.TODO(), handledPipe)
+	require.NoError(t, err)
+	assert.Nil(t, handledPipe.Status.GetCondition(v1.PipeConditionKameletsDeprecated))
+}
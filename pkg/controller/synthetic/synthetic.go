@@ -132,7 +132,11 @@ func getInformers(ctx context.Context, cl client.Client, c cache.Cache) ([]cache
 	if err != nil {
 		return nil, err
 	}
-	informers := []cache.Informer{deploy}
+	sts, err := c.GetInformer(ctx, &appsv1.StatefulSet{})
+	if err != nil {
+		return nil, err
+	}
+	informers := []cache.Informer{deploy, sts}
 	// Watch for the CronJob conditionally
 	if ok, err := kubernetes.IsAPIResourceInstalled(cl, batchv1.SchemeGroupVersion.String(), reflect.TypeFor[batchv1.CronJob]().Name()); ok && err == nil {
 		cron, err := c.GetInformer(ctx, &batchv1.CronJob{})
@@ -196,6 +200,10 @@ func nonManagedCamelApplicationFactory(obj ctrl.Object) (nonManagedCamelApplicat
 	if ok {
 		return &nonManagedCamelDeployment{deploy: deploy}, nil
 	}
+	sts, ok := obj.(*appsv1.StatefulSet)
+	if ok {
+		return &nonManagedCamelStatefulSet{sts: sts}, nil
+	}
 	cronjob, ok := obj.(*batchv1.CronJob)
 	if ok {
 		return &NonManagedCamelCronjob{cron: cronjob}, nil
@@ -258,6 +266,56 @@ func (app *nonManagedCamelDeployment) getContainerNameFromDeployment() string {
 	return firstContainerName
 }
 
+// nonManagedCamelStatefulSet represents a stateful Camel application built and deployed outside the operator lifecycle.
+type nonManagedCamelStatefulSet struct {
+	sts *appsv1.StatefulSet
+}
+
+// Integration return an Integration resource fed by the Camel application adapter.
+func (app *nonManagedCamelStatefulSet) Integration() *v1.Integration {
+	it := v1.NewIntegration(app.sts.Namespace, app.sts.Labels[v1.IntegrationLabel])
+	it.SetAnnotations(map[string]string{
+		v1.IntegrationImportedNameLabel: app.sts.Name,
+		v1.IntegrationImportedKindLabel: "StatefulSet",
+		v1.IntegrationSyntheticLabel:    "true",
+	})
+	it.Spec = v1.IntegrationSpec{
+		Traits: v1.Traits{
+			Container: &trait.ContainerTrait{
+				Name: app.getContainerNameFromStatefulSet(),
+			},
+		},
+	}
+	references := []metav1.OwnerReference{
+		{
+			APIVersion: "apps/v1",
+			Kind:       "StatefulSet",
+			Name:       app.sts.Name,
+			UID:        app.sts.UID,
+			Controller: &controller,
+		},
+	}
+	it.SetOwnerReferences(references)
+
+	return &it
+}
+
+// getContainerNameFromStatefulSet returns the container name which is running the Camel application.
+func (app *nonManagedCamelStatefulSet) getContainerNameFromStatefulSet() string {
+	firstContainerName := ""
+	for _, ct := range app.sts.Spec.Template.Spec.Containers {
+		// set as fallback if no container is named as the statefulset
+		if firstContainerName == "" {
+			firstContainerName = ct.Name
+		}
+		if ct.Name == app.sts.Name {
+			return app.sts.Name
+		}
+	}
+
+	return firstContainerName
+}
+
 // NonManagedCamelCronjob represents a cron Camel application built and deployed outside the operator lifecycle.
 type NonManagedCamelCronjob struct {
 	cron *batchv1.CronJob
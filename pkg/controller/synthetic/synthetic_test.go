@@ -133,6 +133,68 @@ func TestNonManagedDeployment(t *testing.T) {
 	assert.Equal(t, expectedIt, *deploymentAdapter.Integration())
 }
 
+func TestNonManagedStatefulSet(t *testing.T) {
+	sts := &appsv1.StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "StatefulSet",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-sts",
+			Labels: map[string]string{
+				v1.IntegrationLabel: "my-imported-it",
+			},
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						v1.IntegrationLabel: "my-imported-it",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "my-cnt",
+							Image: "my-img",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expectedIt := v1.NewIntegration("ns", "my-imported-it")
+	expectedIt.SetAnnotations(map[string]string{
+		v1.IntegrationImportedNameLabel: "my-sts",
+		v1.IntegrationImportedKindLabel: "StatefulSet",
+		v1.IntegrationSyntheticLabel:    "true",
+	})
+	expectedIt.Spec = v1.IntegrationSpec{
+		Traits: v1.Traits{
+			Container: &trait.ContainerTrait{
+				Name: "my-cnt",
+			},
+		},
+	}
+	references := []metav1.OwnerReference{
+		{
+			APIVersion: "apps/v1",
+			Kind:       "StatefulSet",
+			Name:       sts.Name,
+			UID:        sts.UID,
+			Controller: &controller,
+		},
+	}
+	expectedIt.SetOwnerReferences(references)
+
+	statefulSetAdapter, err := nonManagedCamelApplicationFactory(sts)
+	require.NoError(t, err)
+	assert.NotNil(t, statefulSetAdapter)
+	assert.Equal(t, expectedIt, *statefulSetAdapter.Integration())
+}
+
 func TestNonManagedCronJob(t *testing.T) {
 	cron := &batchv1.CronJob{
 		TypeMeta: metav1.TypeMeta{
@@ -32,6 +32,10 @@ func ExtractAll(catalog *camel.RuntimeCatalog, sources []v1.SourceSpec) (Integra
 	meta.ExposesHTTPServices = false
 
 	for _, source := range sources {
+		// OpenAPI documents are turned into generated sources
+		if source.Type == v1.SourceTypeOpenAPI {
+			continue
+		}
 		m, err := extract(catalog, source)
 		if err != nil {
 			return IntegrationMetadata{}, err
@@ -44,6 +48,26 @@ func ExtractAll(catalog *camel.RuntimeCatalog, sources []v1.SourceSpec) (Integra
 	}, nil
 }
 
+// ExtractRoutes returns the structured model of the routes defined in all listed source codes. Only the YAML and XML
+// sources contribute to the model.
+func ExtractRoutes(catalog *camel.RuntimeCatalog, sources []v1.SourceSpec) (*src.RouteModel, error) {
+	routes := &src.RouteModel{}
+	for _, source := range sources {
+		// OpenAPI documents are turned into generated sources
+		if source.Type == v1.SourceTypeOpenAPI {
+			continue
+		}
+		checkSource(source)
+		meta := src.NewMetadataWithRoutes()
+		if err := src.InspectorForLanguage(catalog, source.InferLanguage()).Extract(source, &meta); err != nil {
+			return nil, err
+		}
+		routes.Merge(meta.Routes)
+	}
+
+	return routes, nil
+}
+
 func merge(m1 src.Metadata, m2 src.Metadata) src.Metadata {
 	f := make([]string, 0, len(m1.FromURIs)+len(m2.FromURIs))
 	f = append(f, m1.FromURIs...)
@@ -57,25 +81,35 @@ func merge(m1 src.Metadata, m2 src.Metadata) src.Metadata {
 	k = append(k, m1.Kamelets...)
 	k = append(k, m2.Kamelets...)
 
+	do := make([]v1.DependencyOrigin, 0, len(m1.DependencyOrigins)+len(m2.DependencyOrigins))
+	do = append(do, m1.DependencyOrigins...)
+	do = append(do, m2.DependencyOrigins...)
+
+	rt := make([]string, 0, len(m1.RouteTemplates)+len(m2.RouteTemplates))
+	rt = append(rt, m1.RouteTemplates...)
+	rt = append(rt, m2.RouteTemplates...)
+
+	pp := make([]src.PropertyPlaceholder, 0, len(m1.PropertyPlaceholders)+len(m2.PropertyPlaceholders))
+	pp = append(pp, m1.PropertyPlaceholders...)
+	pp = append(pp, m2.PropertyPlaceholders...)
+
 	return src.Metadata{
 		FromURIs:             f,
 		ToURIs:               t,
 		Dependencies:         sets.Union(m1.Dependencies, m2.Dependencies),
+		DependencyOrigins:    do,
 		RequiredCapabilities: sets.Union(m1.RequiredCapabilities, m2.RequiredCapabilities),
 		ExposesHTTPServices:  m1.ExposesHTTPServices || m2.ExposesHTTPServices,
 		PassiveEndpoints:     m1.PassiveEndpoints && m2.PassiveEndpoints,
 		Kamelets:             k,
+		RouteTemplates:       rt,
+		PropertyPlaceholders: pp,
 	}
 }
 
 // extract returns metadata information from the source code.
 func extract(catalog *camel.RuntimeCatalog, source v1.SourceSpec) (IntegrationMetadata, error) {
-	if source.ContentRef != "" {
-		panic("source must be dereferenced before calling this method")
-	}
-	if source.Compression {
-		panic("source must be uncompressed before calling this method")
-	}
+	checkSource(source)
 
 	language := source.InferLanguage()
 
@@ -86,8 +120,18 @@ func extract(catalog *camel.RuntimeCatalog, source v1.SourceSpec) (IntegrationMe
 	if err := src.InspectorForLanguage(catalog, language).Extract(source, &meta); err != nil {
 		return IntegrationMetadata{}, err
 	}
+	meta.LocateDependencyOrigins(source)
 
 	return IntegrationMetadata{
 		Metadata: meta,
 	}, nil
 }
+
+func checkSource(source v1.SourceSpec) {
+	if source.ContentRef != "" {
+		panic("source must be dereferenced before calling this method")
+	}
+	if source.Compression {
+		panic("source must be uncompressed before calling this method")
+	}
+}
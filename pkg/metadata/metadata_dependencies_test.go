@@ -18,6 +18,7 @@ limitations under the License.
 package metadata
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -62,6 +63,52 @@ func TestDependenciesJavaSource(t *testing.T) {
 		meta.Dependencies.List())
 }
 
+func TestDependencyOrigins(t *testing.T) {
+	code := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name: "Request.java",
+			Content: `import org.apache.camel.builder.RouteBuilder;
+
+public class Request extends RouteBuilder {
+    public void configure() {
+        from("timer:tick")
+            .bean("myBean")
+            .to("kafka:orders?brokers=my-cluster:9092");
+    }
+}
+`,
+		},
+		Language: v1.LanguageJavaSource,
+	}
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	meta, err := extract(catalog, code)
+	require.NoError(t, err)
+
+	assert.Contains(t, meta.DependencyOrigins, v1.DependencyOrigin{
+		Dependency: "camel:timer",
+		Type:       v1.DependencyOriginURI,
+		Source:     "Request.java",
+		Line:       5,
+		Detail:     "timer:tick",
+	})
+	assert.Contains(t, meta.DependencyOrigins, v1.DependencyOrigin{
+		Dependency: "camel:kafka",
+		Type:       v1.DependencyOriginURI,
+		Source:     "Request.java",
+		Line:       7,
+		Detail:     "kafka:orders?brokers=my-cluster:9092",
+	})
+	idx := slices.IndexFunc(meta.DependencyOrigins, func(origin v1.DependencyOrigin) bool {
+		return origin.Dependency == "camel:bean"
+	})
+	require.GreaterOrEqual(t, idx, 0)
+	assert.Equal(t, v1.DependencyOriginRule, meta.DependencyOrigins[idx].Type)
+	assert.Equal(t, 6, meta.DependencyOrigins[idx].Line)
+}
+
 func TestDependencies(t *testing.T) {
 	code := v1.SourceSpec{
 		DataSpec: v1.DataSpec{
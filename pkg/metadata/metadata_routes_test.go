@@ -0,0 +1,96 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+)
+
+func TestExtractRoutes(t *testing.T) {
+	yaml := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name: "routes.yaml",
+			Content: `
+- route:
+    id: yaml-route
+    from:
+      uri: "timer:tick"
+      steps:
+        - to: "log:info"
+`,
+		},
+		Language: v1.LanguageYaml,
+	}
+	xml := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name: "routes.xml",
+			Content: `
+<routes>
+  <route id="xml-route">
+    <from uri="timer:tock"/>
+    <to uri="log:info"/>
+  </route>
+</routes>
+`,
+		},
+		Language: v1.LanguageXML,
+	}
+	java := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name:    "Route.java",
+			Content: `from("timer:java").to("log:info");`,
+		},
+		Language: v1.LanguageJavaSource,
+	}
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	routes, err := ExtractRoutes(catalog, []v1.SourceSpec{yaml, xml, java})
+	require.NoError(t, err)
+	require.Len(t, routes.Routes, 2)
+	assert.Equal(t, "yaml-route", routes.Routes[0].ID)
+	assert.Equal(t, "xml-route", routes.Routes[1].ID)
+
+	meta, err := ExtractAll(catalog, []v1.SourceSpec{yaml, xml})
+	require.NoError(t, err)
+	assert.Nil(t, meta.Routes)
+}
+
+func TestExtractAllSkipsOpenAPI(t *testing.T) {
+	code := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name:    "petstore.yaml",
+			Content: "openapi: 3.0.3\npaths: {}\n",
+		},
+		Type: v1.SourceTypeOpenAPI,
+	}
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	meta, err := ExtractAll(catalog, []v1.SourceSpec{code})
+	require.NoError(t, err)
+	assert.Empty(t, meta.FromURIs)
+}
@@ -95,7 +95,7 @@ func TestJava2(t *testing.T) {
 	assert.Len(t, metadata.FromURIs, 1)
 	assert.Contains(t, metadata.ToURIs, "log:info?skipBodyLineSeparator=false")
 	assert.Contains(t, metadata.ToURIs, "direct:2")
-	assert.Contains(t, metadata.ToURIs, "direct:%s") // resolution not supported yet
+	assert.Contains(t, metadata.ToURIs, "direct:3")
 	assert.Len(t, metadata.ToURIs, 3)
 }
 
@@ -19,6 +19,7 @@ package platform
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"runtime"
 	"strconv"
@@ -237,6 +238,38 @@ func BuilderNodeSelectorAllowList() []string {
 	return result
 }
 
+// SecurityPolicy returns the operator wide policy applied to the Integrations using security sensitive components.
+// The risk categories denied to every Integration are listed in SECURITY_DENIED_RISK_CATEGORIES and the ones requiring
+// the approval of the Integration in SECURITY_APPROVAL_RISK_CATEGORIES, both as comma-separated lists.
+func SecurityPolicy() v1.SecurityPolicySpec {
+	categories := func(key string) []string {
+		var result []string
+		for p := range strings.SplitSeq(GetEnvOrDefault(key, ""), ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				result = append(result, p)
+			}
+		}
+
+		return result
+	}
+
+	return v1.SecurityPolicySpec{
+		Deny:            categories("SECURITY_DENIED_RISK_CATEGORIES"),
+		RequireApproval: categories("SECURITY_APPROVAL_RISK_CATEGORIES"),
+	}
+}
+
+// SecurityRules returns the reference to the rules table replacing the security rules shipped with the operator, set in
+// SECURITY_RULES as a configmap|secret:name@key value source of the operator namespace. It's empty when not set.
+func SecurityRules() (v1.ValueSource, error) {
+	vs, err := valueSource("SECURITY_RULES")
+	if err != nil {
+		return vs, fmt.Errorf("could not parse SECURITY_RULES env variable: %w", err)
+	}
+
+	return vs, nil
+}
+
 func imagePlatforms() []string {
 	buildImagePlatforms := GetEnvOrDefault("BUILD_IMAGE_PLATFORMS", "")
 	if buildImagePlatforms != "" {
@@ -24,6 +24,7 @@ import (
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetEnvPlatform_Defaults(t *testing.T) {
@@ -245,3 +246,21 @@ func TestBuilderTasksEnabled_Empty(t *testing.T) {
 	// empty string is not "false" – treat as enabled
 	assert.False(t, BuilderTasksEnabled())
 }
+
+func TestSecurityRules(t *testing.T) {
+	vs, err := SecurityRules()
+	require.NoError(t, err)
+	assert.Nil(t, vs.ConfigMapKeyRef)
+	assert.Nil(t, vs.SecretKeyRef)
+
+	t.Setenv("SECURITY_RULES", "configmap:security-rules@rules.yaml")
+	vs, err = SecurityRules()
+	require.NoError(t, err)
+	require.NotNil(t, vs.ConfigMapKeyRef)
+	assert.Equal(t, "security-rules", vs.ConfigMapKeyRef.Name)
+	assert.Equal(t, "rules.yaml", vs.ConfigMapKeyRef.Key)
+
+	t.Setenv("SECURITY_RULES", "security-rules")
+	_, err = SecurityRules()
+	require.ErrorContains(t, err, "could not parse SECURITY_RULES env variable")
+}
@@ -147,6 +147,14 @@ func (t *camelTrait) Apply(e *Environment) error {
 
 	if e.IntegrationInRunningPhases() {
 		e.Resources.AddAll(t.computeUserProperties(e))
+
+		openAPIConfigMap, err := computeOpenAPIConfigMap(e)
+		if err != nil {
+			return err
+		}
+		if openAPIConfigMap != nil {
+			e.Resources.Add(openAPIConfigMap)
+		}
 	}
 
 	return nil
@@ -198,8 +198,8 @@ func (t *cronTrait) Apply(e *Environment) error {
 			if fallbackArtifact == nil {
 				return fmt.Errorf("no fallback artifact for scheme %q has been found in camel catalog", genericCronComponentFallbackScheme)
 			}
-			util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, fallbackArtifact.GetDependencyID())
-			util.StringSliceUniqueConcat(&e.Integration.Status.Dependencies, fallbackArtifact.GetConsumerDependencyIDs(genericCronComponentFallbackScheme))
+			e.addDependencies(v1.DependencyOriginTrait, cronTraitID, fallbackArtifact.GetDependencyID())
+			e.addDependencies(v1.DependencyOriginTrait, cronTraitID, fallbackArtifact.GetConsumerDependencyIDs(genericCronComponentFallbackScheme)...)
 
 			return nil
 		}
@@ -212,7 +212,7 @@ func (t *cronTrait) Apply(e *Environment) error {
 		if cronComponentArtifact == nil {
 			return errors.New("no timer artifact has been found in camel catalog")
 		}
-		util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, cronComponentArtifact.GetDependencyID())
+		e.addDependencies(v1.DependencyOriginTrait, cronTraitID, cronComponentArtifact.GetDependencyID())
 	}
 
 	if e.IntegrationInRunningPhases() && !ptr.Deref(t.Fallback, false) {
@@ -221,6 +221,12 @@ func (t *cronTrait) Apply(e *Environment) error {
 		}
 		// Will instruct the context to stop as soon as the first message is done
 		e.ApplicationProperties["camel.main.durationMaxMessages"] = "1"
+		// The Kamelets route templates are generated at each reconciliation, hence are changed at each reconciliation
+		kameletsReplaced, err := t.changeKameletSourcesCronURI(e)
+		if err != nil {
+			return err
+		}
+		replaced = replaced || kameletsReplaced
 
 		cronJob := t.getCronJobFor(e)
 		e.Resources.Add(cronJob)
@@ -275,6 +281,7 @@ func (t *cronTrait) getCronJobFor(e *Environment) *batchv1.CronJob {
 			TimeZone:                t.TimeZone,
 			ConcurrencyPolicy:       t.getConcurrentPolicy(),
 			StartingDeadlineSeconds: t.StartingDeadlineSeconds,
+			Suspend:                 t.Suspend,
 			JobTemplate: batchv1.JobTemplateSpec{
 				Spec: batchv1.JobSpec{
 					ActiveDeadlineSeconds: &activeDeadline,
@@ -553,3 +560,34 @@ func (t *cronTrait) changeSourcesCronURI(e *Environment) (bool, error) {
 
 	return anyRouteReplaced, nil
 }
+
+// changeKameletSourcesCronURI changes the value of the from route of the Kamelets route templates authored in Java or XML
+// (ie, the ones used by a Pipe). Unlike the templates of the YAML Kamelets, which are loaded by the runtime from the Kamelets
+// bundle, they are loaded as any other route from the configmaps generated by the kamelets trait.
+func (t *cronTrait) changeKameletSourcesCronURI(e *Environment) (bool, error) {
+	anyRouteReplaced := false
+	for _, src := range e.Integration.Status.GeneratedSources {
+		if !isKameletRouteTemplateSource(src) || src.ContentRef == "" {
+			continue
+		}
+		cm := e.Resources.GetConfigMap(func(m *corev1.ConfigMap) bool {
+			return m.Name == src.ContentRef
+		})
+		if cm == nil {
+			continue
+		}
+		src.Content = cm.Data[src.ContentKey]
+		src.ContentRef = ""
+		dslInspector := source.InspectorForLanguage(e.CamelCatalog, src.InferLanguage())
+		replaced, err := dslInspector.ReplaceFromURI(&src, overriddenFromURI)
+		if err != nil {
+			return false, fmt.Errorf("wasn't able to replace cron uri trigger in Kamelet source %s", src.Name)
+		}
+		if replaced {
+			anyRouteReplaced = true
+			cm.Data[src.ContentKey] = src.Content
+		}
+	}
+
+	return anyRouteReplaced, nil
+}
@@ -659,6 +659,76 @@ func TestCronWithTimeZone(t *testing.T) {
 	assert.EqualValues(t, *cronJob.Spec.TimeZone, "America/Sao_Paulo")
 }
 
+func TestCronWithSuspend(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	client, _ := internal.NewFakeClient()
+	traitCatalog := NewCatalog(nil)
+
+	environment := Environment{
+		CamelCatalog: catalog,
+		Catalog:      traitCatalog,
+		Client:       client,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+			Spec: v1.IntegrationSpec{
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name:    "routes.java",
+							Content: `from("cron:tab?schedule=0 0/2 * * ?").to("log:test")`,
+						},
+						Language: v1.LanguageJavaSource,
+					},
+				},
+				Traits: v1.Traits{
+					Cron: &traitv1.CronTrait{
+						Suspend: ptr.To(true),
+					},
+				},
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform:       pl,
+		EnvVars:        make([]corev1.EnvVar, 0),
+		ExecutedTraits: make([]Trait, 0),
+		Resources:      kubernetes.NewCollection(),
+	}
+	c, err := newFakeClient("ns")
+	require.NoError(t, err)
+
+	tc := NewCatalog(c)
+
+	expectedCondition := NewIntegrationCondition(
+		"Deployment",
+		v1.IntegrationConditionDeploymentAvailable,
+		corev1.ConditionFalse,
+		"DeploymentAvailable",
+		"controller strategy: cron-job",
+	)
+	conditions, traits, err := tc.apply(&environment)
+	require.NoError(t, err)
+	assert.NotEmpty(t, traits)
+	assert.Contains(t, conditions, expectedCondition)
+	assert.NotEmpty(t, environment.ExecutedTraits)
+
+	cronJob := environment.Resources.GetCronJob(func(job *batchv1.CronJob) bool { return true })
+	assert.NotNil(t, cronJob)
+
+	assert.True(t, ptr.Deref(cronJob.Spec.Suspend, false))
+}
+
 func TestCronAuto(t *testing.T) {
 	catalog, err := camel.DefaultCatalog()
 	require.NoError(t, err)
@@ -789,3 +859,98 @@ func TestCronRuntimeTriggerReplacement(t *testing.T) {
 		environment.Integration.Status.GeneratedSources[0].Content,
 	)
 }
+
+func TestCronPipeJavaKameletTriggerReplacement(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	c, err := internal.NewFakeClient(&v1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-timer-source",
+			Labels: map[string]string{
+				v1.KameletTypeLabel: v1.KameletTypeSource,
+			},
+		},
+		Spec: v1.KameletSpec{
+			KameletSpecBase: v1.KameletSpecBase{
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name:    "MyTimerSource.java",
+							Content: `routeTemplate("my-timer-source").from("timer:tick?period=60000").to("kamelet:sink");`,
+						},
+						Type: v1.SourceTypeTemplate,
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	environment := Environment{
+		CamelCatalog: catalog,
+		Catalog:      NewCatalog(nil),
+		Client:       c,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+			Spec: v1.IntegrationSpec{
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name: "binding.yaml",
+							Content: `
+- route:
+    from:
+      steps:
+      - to: log:info
+      uri: kamelet:my-timer-source/source
+    id: binding
+`,
+						},
+						Language: v1.LanguageYaml,
+					},
+				},
+				Traits: v1.Traits{
+					Cron: &traitv1.CronTrait{
+						Schedule: "0/5 * * * ?",
+					},
+				},
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform:       pl,
+		EnvVars:        make([]corev1.EnvVar, 0),
+		ExecutedTraits: make([]Trait, 0),
+		Resources:      kubernetes.NewCollection(),
+	}
+
+	tc := NewCatalog(c)
+	_, _, err = tc.apply(&environment)
+	require.NoError(t, err)
+
+	cronJob := environment.Resources.GetCronJob(func(job *batchv1.CronJob) bool { return true })
+	require.NotNil(t, cronJob)
+	// The route template is loaded from the configmap generated for the Kamelet source
+	cm := environment.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool {
+		return cm.Name == "test-kamelet-my-timer-source-000"
+	})
+	require.NotNil(t, cm)
+	assert.Equal(t,
+		fmt.Sprintf("routeTemplate(\"my-timer-source\").from(\"%s\").to(\"kamelet:sink\");", overriddenFromURI),
+		cm.Data["content"],
+	)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionCronJobAvailable)
+	require.NotNil(t, condition)
+	assert.Contains(t, condition.Message, overriddenFromURI)
+}
@@ -18,12 +18,17 @@ limitations under the License.
 package trait
 
 import (
+	"fmt"
+	"slices"
+
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/v2/pkg/metadata"
 	"github.com/apache/camel-k/v2/pkg/util"
 	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/modeline"
 	"github.com/apache/camel-k/v2/pkg/util/sets"
+	"k8s.io/utils/ptr"
 )
 
 const (
@@ -56,17 +61,39 @@ func (t *dependenciesTrait) Apply(e *Environment) error {
 	}
 
 	dependencies := sets.NewSet()
+	origins := make([]v1.DependencyOrigin, 0)
+	addDependencies := func(originType v1.DependencyOriginType, detail string, deps ...string) {
+		for _, d := range deps {
+			dependencies.Add(d)
+			origins = append(origins, v1.DependencyOrigin{Dependency: d, Type: originType, Detail: detail})
+		}
+	}
 
 	if e.Integration.Spec.Dependencies != nil {
 		if err := camel.ValidateDependenciesE(e.CamelCatalog, e.Integration.Spec.Dependencies); err != nil {
 			return err
 		}
-		dependencies.Add(e.Integration.Spec.Dependencies...)
+		addDependencies(v1.DependencyOriginSpec, "", e.Integration.Spec.Dependencies...)
+	}
+
+	// Add the dependencies declared by the modeline options of the sources
+	if ModelineEnabled(e.Integration) {
+		for _, o := range e.Integration.Status.Modeline {
+			if o.Name != modeline.OptionDependency {
+				continue
+			}
+			dep := camel.NormalizeDependency(o.Value)
+			if err := camel.ValidateDependencyE(e.CamelCatalog, dep); err != nil {
+				return fmt.Errorf("%s:%d: %w", o.Source, o.Line, err)
+			}
+			dependencies.Add(dep)
+			origins = append(origins, v1.DependencyOrigin{Dependency: dep, Type: v1.DependencyOriginModeline, Source: o.Source, Line: o.Line})
+		}
 	}
 
 	// Add runtime specific dependencies
 	for _, d := range e.CamelCatalog.Runtime.Dependencies {
-		dependencies.Add(d.GetDependencyID())
+		addDependencies(v1.DependencyOriginRuntime, string(e.CamelCatalog.Runtime.Provider), d.GetDependencyID())
 	}
 
 	_, err := e.consumeSourcesMeta(
@@ -75,13 +102,24 @@ func (t *dependenciesTrait) Apply(e *Environment) error {
 			for _, s := range sources {
 				// Add source-related language dependencies
 				srcDeps := ExtractSourceLoaderDependencies(s, e.CamelCatalog)
-				dependencies.Merge(srcDeps)
+				srcDeps.Each(func(d string) bool {
+					dependencies.Add(d)
+					origins = append(origins, v1.DependencyOrigin{
+						Dependency: d,
+						Type:       v1.DependencyOriginLoader,
+						Source:     s.Name,
+						Detail:     string(s.InferLanguage()),
+					})
+
+					return true
+				})
 			}
 
 			return true
 		},
 		func(meta metadata.IntegrationMetadata) bool {
 			dependencies.Merge(meta.Dependencies)
+			origins = append(origins, meta.DependencyOrigins...)
 			meta.RequiredCapabilities.Each(func(item string) bool {
 				util.StringSliceUniqueAdd(&e.Integration.Status.Capabilities, item)
 
@@ -95,9 +133,7 @@ func (t *dependenciesTrait) Apply(e *Environment) error {
 	}
 
 	if e.IntegrationProfile != nil {
-		for _, d := range e.IntegrationProfile.Spec.Dependencies {
-			dependencies.Add(d)
-		}
+		addDependencies(v1.DependencyOriginProfile, e.IntegrationProfile.Name, e.IntegrationProfile.Spec.Dependencies...)
 	}
 
 	// Add dependencies back to integration
@@ -106,6 +142,43 @@ func (t *dependenciesTrait) Apply(e *Environment) error {
 
 		return true
 	})
+	if ptr.Deref(t.Provenance, false) {
+		addDependencyOrigins(e.Integration, origins...)
+	}
 
 	return nil
 }
+
+// addDependencies adds the given dependencies to the Integration status, recording their origin when the
+// dependencies provenance is enabled.
+func (e *Environment) addDependencies(originType v1.DependencyOriginType, detail string, dependencies ...string) {
+	for _, d := range dependencies {
+		util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, d)
+	}
+	if !e.dependenciesProvenanceEnabled() {
+		return
+	}
+	for _, d := range dependencies {
+		addDependencyOrigins(e.Integration, v1.DependencyOrigin{Dependency: d, Type: originType, Detail: detail})
+	}
+}
+
+// dependenciesProvenanceEnabled returns true if the origin of the dependencies has to be recorded in the Integration status.
+func (e *Environment) dependenciesProvenanceEnabled() bool {
+	if e.Catalog == nil {
+		return false
+	}
+	if t, ok := e.Catalog.GetTrait(dependenciesTraitID).(*dependenciesTrait); ok {
+		return ptr.Deref(t.Provenance, false)
+	}
+
+	return false
+}
+
+func addDependencyOrigins(it *v1.Integration, origins ...v1.DependencyOrigin) {
+	for _, origin := range origins {
+		if !slices.Contains(it.Status.DependencyOrigins, origin) {
+			it.Status.DependencyOrigins = append(it.Status.DependencyOrigins, origin)
+		}
+	}
+}
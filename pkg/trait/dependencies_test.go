@@ -23,6 +23,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"k8s.io/utils/ptr"
+
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/util/camel"
 )
@@ -151,6 +153,75 @@ func TestIntegrationCustomDeps(t *testing.T) {
 	)
 }
 
+func TestIntegrationDepsProvenance(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	e := &Environment{
+		Catalog:      NewEnvironmentTestCatalog(),
+		CamelCatalog: catalog,
+		Integration: &v1.Integration{
+			Spec: v1.IntegrationSpec{
+				Dependencies: []string{
+					"org.foo:bar",
+				},
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name: "route.yaml",
+							Content: `
+- from:
+    uri: "timer:tick"
+    steps:
+      - to: "log:bar"
+`,
+						},
+						Language: v1.LanguageYaml,
+					},
+				},
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseInitialization,
+			},
+		},
+	}
+
+	trait, ok := newDependenciesTrait().(*dependenciesTrait)
+	require.True(t, ok)
+	trait.Provenance = ptr.To(true)
+	err = trait.Apply(e)
+	require.NoError(t, err)
+	assert.Contains(t, e.Integration.Status.DependencyOrigins, v1.DependencyOrigin{
+		Dependency: "camel:timer",
+		Type:       v1.DependencyOriginURI,
+		Source:     "route.yaml",
+		Line:       3,
+		Detail:     "timer:tick",
+	})
+	assert.Contains(t, e.Integration.Status.DependencyOrigins, v1.DependencyOrigin{
+		Dependency: "camel:log",
+		Type:       v1.DependencyOriginURI,
+		Source:     "route.yaml",
+		Line:       5,
+		Detail:     "log:bar",
+	})
+	assert.Contains(t, e.Integration.Status.DependencyOrigins, v1.DependencyOrigin{
+		Dependency: "org.foo:bar",
+		Type:       v1.DependencyOriginSpec,
+	})
+	assert.Contains(t, e.Integration.Status.DependencyOrigins, v1.DependencyOrigin{
+		Dependency: "mvn:org.apache.camel.k:camel-k-runtime",
+		Type:       v1.DependencyOriginRuntime,
+		Detail:     string(v1.RuntimeProviderQuarkus),
+	})
+
+	// the origins are not recorded by default
+	e.Integration.Status.DependencyOrigins = nil
+	err = newDependenciesTrait().Apply(e)
+	require.NoError(t, err)
+	assert.Empty(t, e.Integration.Status.DependencyOrigins)
+}
+
 func TestIntegrationAutoGeneratedDeps(t *testing.T) {
 	catalog, err := camel.DefaultCatalog()
 	require.NoError(t, err)
@@ -18,6 +18,17 @@ limitations under the License.
 package trait
 
 import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 )
 
@@ -50,6 +61,9 @@ func (t *deployerTrait) Apply(e *Environment) error {
 	e.PostActions = append(e.PostActions, func(env *Environment) error {
 		applier := e.Client.ServerOrClientSideApplier()
 		for _, resource := range env.Resources.Items() {
+			if err := t.deleteOnImmutableChange(env, resource); err != nil {
+				return err
+			}
 			if err := applier.Apply(e.Ctx, resource); err != nil {
 				return err
 			}
@@ -61,6 +75,109 @@ func (t *deployerTrait) Apply(e *Environment) error {
 	return nil
 }
 
+// deleteOnImmutableChange deletes the existing controller whose immutable fields differ from the ones of the given
+// resource, so that it's created again. The pods of a Deployment don't match its new selector, hence they are deleted as
+// well, whereas the pods of a StatefulSet are orphaned and adopted by the new one, which keeps their Persistent Volume Claims.
+func (t *deployerTrait) deleteOnImmutableChange(e *Environment, resource ctrl.Object) error {
+	switch desired := resource.(type) {
+	case *appsv1.Deployment:
+		if desired.Spec.Selector == nil {
+			return nil
+		}
+		existing := &appsv1.Deployment{}
+		if found, err := t.getExisting(e, desired, existing); err != nil || !found {
+			return err
+		}
+		if existing.Spec.Selector == nil || equality.Semantic.DeepEqual(existing.Spec.Selector, desired.Spec.Selector) {
+			return nil
+		}
+
+		return t.deleteExisting(e, existing, "Deployment", "its selector", metav1.DeletePropagationBackground)
+	case *appsv1.StatefulSet:
+		existing := &appsv1.StatefulSet{}
+		if found, err := t.getExisting(e, desired, existing); err != nil || !found {
+			return err
+		}
+		changed := immutableStatefulSetChanges(existing, desired)
+		if changed == "" {
+			return nil
+		}
+
+		return t.deleteExisting(e, existing, "StatefulSet", changed, metav1.DeletePropagationOrphan)
+	}
+
+	return nil
+}
+
+func (t *deployerTrait) getExisting(e *Environment, desired ctrl.Object, existing ctrl.Object) (bool, error) {
+	if err := e.Client.Get(e.Ctx, ctrl.ObjectKeyFromObject(desired), existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (t *deployerTrait) deleteExisting(e *Environment, existing ctrl.Object, kind string, changed string, propagation metav1.DeletionPropagation) error {
+	err := e.Client.Delete(e.Ctx, existing, ctrl.PropagationPolicy(propagation))
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("could not delete %s %s to change %s: %w", kind, existing.GetName(), changed, err)
+	}
+
+	return nil
+}
+
+// immutableStatefulSetChanges describes the changes of the fields of a StatefulSet which cannot be updated, ignoring
+// the values defaulted by the cluster. It returns an empty string when there is none.
+func immutableStatefulSetChanges(existing *appsv1.StatefulSet, desired *appsv1.StatefulSet) string {
+	changes := make([]string, 0, 4)
+	if desired.Spec.Selector != nil && !equality.Semantic.DeepEqual(existing.Spec.Selector, desired.Spec.Selector) {
+		changes = append(changes, "selector")
+	}
+	if existing.Spec.ServiceName != desired.Spec.ServiceName {
+		changes = append(changes, "serviceName")
+	}
+	if podManagementPolicy(existing.Spec.PodManagementPolicy) != podManagementPolicy(desired.Spec.PodManagementPolicy) {
+		changes = append(changes, "podManagementPolicy")
+	}
+	if !sameVolumeClaimTemplates(existing.Spec.VolumeClaimTemplates, desired.Spec.VolumeClaimTemplates) {
+		changes = append(changes, "volumeClaimTemplates")
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	return "its " + strings.Join(changes, ", ")
+}
+
+func podManagementPolicy(policy appsv1.PodManagementPolicyType) appsv1.PodManagementPolicyType {
+	if policy == "" {
+		return appsv1.OrderedReadyPodManagement
+	}
+
+	return policy
+}
+
+func sameVolumeClaimTemplates(existing []corev1.PersistentVolumeClaim, desired []corev1.PersistentVolumeClaim) bool {
+	if len(existing) != len(desired) {
+		return false
+	}
+	for i := range desired {
+		e, d := existing[i], desired[i]
+		if e.Name != d.Name ||
+			!equality.Semantic.DeepEqual(e.Spec.AccessModes, d.Spec.AccessModes) ||
+			!equality.Semantic.DeepEqual(e.Spec.Resources.Requests, d.Spec.Resources.Requests) ||
+			ptr.Deref(e.Spec.StorageClassName, "") != ptr.Deref(d.Spec.StorageClassName, "") {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (t *deployerTrait) SelectControllerStrategy(e *Environment) (*ControllerStrategy, error) {
 	//nolint:staticcheck
 	if t.Kind != "" {
@@ -18,13 +18,21 @@ limitations under the License.
 package trait
 
 import (
+	"context"
 	"testing"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestConfigureDeployerTraitDoesSucceed(t *testing.T) {
@@ -65,6 +73,65 @@ func TestApplyDeployerTraitInInitializationPhaseDoesSucceed(t *testing.T) {
 	assert.Len(t, environment.PostActions, 1)
 }
 
+func TestDeployerTraitDeletesDeploymentOnSelectorChange(t *testing.T) {
+	existing := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "integration-name", Namespace: "ns"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{v1.IntegrationLabel: "integration-name"}},
+		},
+	}
+	client, err := internal.NewFakeClient(existing)
+	require.NoError(t, err)
+	deployerTrait, environment := createNominalDeployerTest()
+	environment.Ctx = context.Background()
+	environment.Client = client
+
+	deployment := existing.DeepCopy()
+	require.NoError(t, deployerTrait.deleteOnImmutableChange(environment, deployment))
+	require.NoError(t, client.Get(environment.Ctx, ctrl.ObjectKeyFromObject(existing), &appsv1.Deployment{}))
+
+	deployment.Spec.Selector.MatchLabels["camel.apache.org/rollout"] = "stable"
+	require.NoError(t, deployerTrait.deleteOnImmutableChange(environment, deployment))
+	err = client.Get(environment.Ctx, ctrl.ObjectKeyFromObject(existing), &appsv1.Deployment{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestDeployerTraitOrphansStatefulSetOnImmutableChange(t *testing.T) {
+	claim, _, err := ParseVolumeClaimTemplate("data:/var/data:1Gi")
+	require.NoError(t, err)
+	claim.Spec.VolumeMode = ptr.To(corev1.PersistentVolumeFilesystem)
+	existing := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "integration-name", Namespace: "ns"},
+		Spec: appsv1.StatefulSetSpec{
+			Selector:             &metav1.LabelSelector{MatchLabels: map[string]string{v1.IntegrationLabel: "integration-name"}},
+			ServiceName:          "integration-name-headless",
+			PodManagementPolicy:  appsv1.OrderedReadyPodManagement,
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{*claim},
+		},
+	}
+	client, err := internal.NewFakeClient(existing)
+	require.NoError(t, err)
+	deployerTrait, environment := createNominalDeployerTest()
+	environment.Ctx = context.Background()
+	environment.Client = client
+
+	// the values defaulted by the cluster are not a change
+	statefulSet := existing.DeepCopy()
+	statefulSet.Spec.PodManagementPolicy = ""
+	statefulSet.Spec.VolumeClaimTemplates[0].Spec.VolumeMode = nil
+	require.NoError(t, deployerTrait.deleteOnImmutableChange(environment, statefulSet))
+	require.NoError(t, client.Get(environment.Ctx, ctrl.ObjectKeyFromObject(existing), &appsv1.StatefulSet{}))
+
+	assert.Empty(t, immutableStatefulSetChanges(existing, statefulSet))
+	statefulSet.Spec.PodManagementPolicy = appsv1.ParallelPodManagement
+	statefulSet.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("2Gi")
+	assert.Equal(t, "its podManagementPolicy, volumeClaimTemplates", immutableStatefulSetChanges(existing, statefulSet))
+
+	require.NoError(t, deployerTrait.deleteOnImmutableChange(environment, statefulSet))
+	err = client.Get(environment.Ctx, ctrl.ObjectKeyFromObject(existing), &appsv1.StatefulSet{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
 func createNominalDeployerTest() (*deployerTrait, *Environment) {
 	trait, _ := newDeployerTrait().(*deployerTrait)
 
@@ -0,0 +1,278 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package external defines the protocol between the operator and the webhooks implementing the external traits
+// registered with ExternalTrait resources.
+//
+// The operator POSTs a Request to the webhook when configuring the trait, to know whether it is enabled,
+// and when applying it, with the resources generated for the Integration so far. The webhook answers
+// with a Response carrying the JSON patches to apply to those resources.
+package external
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/google/uuid"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/json"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// maxResponseSize is the maximum size of a webhook response body.
+const maxResponseSize = 4 * 1024 * 1024
+
+// Operation is the step of the trait lifecycle a webhook is called for.
+type Operation string
+
+const (
+	// OperationConfigure asks the webhook whether the trait is enabled.
+	OperationConfigure Operation = "Configure"
+	// OperationApply asks the webhook for the patches to apply to the Integration resources.
+	OperationApply Operation = "Apply"
+)
+
+// Request is the payload sent to an external trait webhook.
+type Request struct {
+	// The request identifier, to be returned in the response.
+	UID string `json:"uid"`
+	// The ID of the external trait.
+	Trait string `json:"trait"`
+	// The step of the trait lifecycle.
+	Operation Operation `json:"operation"`
+	// The trait configuration, as set in the `addons` section of the Integration traits.
+	Configuration map[string]any `json:"configuration,omitempty"`
+	// The Integration the trait is executed for.
+	Integration *v1.Integration `json:"integration"`
+	// The resources generated for the Integration so far, only set for the Apply operation.
+	Resources []*unstructured.Unstructured `json:"resources,omitempty"`
+}
+
+// Response is the payload returned by an external trait webhook.
+type Response struct {
+	// The identifier of the request.
+	UID string `json:"uid"`
+	// Whether the trait is enabled, only read in response to the Configure operation.
+	Enabled bool `json:"enabled,omitempty"`
+	// The patches to apply to the Integration resources, only read in response to the Apply operation.
+	Patches []Patch `json:"patches,omitempty"`
+	// An error reported by the trait, which always fails the Integration whatever the failure policy.
+	Error string `json:"error,omitempty"`
+}
+
+// Patch is a JSON patch (RFC 6902) targeting one of the Integration resources.
+type Patch struct {
+	// The API version of the patched resource.
+	APIVersion string `json:"apiVersion"`
+	// The kind of the patched resource.
+	Kind string `json:"kind"`
+	// The name of the patched resource.
+	Name string `json:"name"`
+	// The JSON patch operations.
+	Operations []JSONPatchOperation `json:"patch"`
+}
+
+// JSONPatchOperation is an operation of a JSON patch.
+type JSONPatchOperation struct {
+	// The operation, either `add`, `remove`, `replace`, `move`, `copy` or `test`.
+	Op string `json:"op"`
+	// The JSON pointer to the target location.
+	Path string `json:"path"`
+	// The value used by the `add`, `replace` and `test` operations.
+	Value any `json:"value,omitempty"`
+	// The JSON pointer to the source location of the `move` and `copy` operations.
+	From string `json:"from,omitempty"`
+}
+
+// NewRequest creates the Request sent to the webhook of the given trait.
+func NewRequest(scheme *runtime.Scheme, trait string, operation Operation, configuration map[string]any,
+	integration *v1.Integration, resources []ctrl.Object) (*Request, error) {
+	request := Request{
+		UID:           uuid.NewString(),
+		Trait:         trait,
+		Operation:     operation,
+		Configuration: configuration,
+		Integration:   integration,
+	}
+	for _, resource := range resources {
+		gvk, err := apiutil.GVKForObject(resource, scheme)
+		if err != nil {
+			return nil, err
+		}
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(resource)
+		if err != nil {
+			return nil, err
+		}
+		u := &unstructured.Unstructured{Object: content}
+		u.SetGroupVersionKind(gvk)
+		request.Resources = append(request.Resources, u)
+	}
+
+	return &request, nil
+}
+
+// Client calls the webhook implementing an external trait.
+type Client struct {
+	// The URL of the webhook.
+	URL string
+	// The HTTP client, carrying the timeout and the TLS configuration.
+	HTTPClient *http.Client
+}
+
+// Call sends the request to the webhook and returns its response. An error is returned when the webhook
+// cannot be reached or does not return a valid response, while the errors reported by the trait are
+// left in the response.
+func (c *Client) Call(ctx context.Context, request *Request) (*Response, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("webhook returned HTTP status %d: %s", res.StatusCode, truncate(string(data), 256))
+	}
+
+	var response Response
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("invalid webhook response: %w", err)
+	}
+	if response.UID != request.UID {
+		return nil, fmt.Errorf("invalid webhook response: expected uid %q, got %q", request.UID, response.UID)
+	}
+
+	return &response, nil
+}
+
+// ApplyPatches applies the patches to the matching resources, which are updated in place.
+// The resources are left untouched when any of the patches cannot be applied.
+func ApplyPatches(scheme *runtime.Scheme, resources []ctrl.Object, patches []Patch) error {
+	patched := make(map[int]ctrl.Object)
+	for _, patch := range patches {
+		gv, err := schema.ParseGroupVersion(patch.APIVersion)
+		if err != nil {
+			return fmt.Errorf("invalid patch apiVersion %q: %w", patch.APIVersion, err)
+		}
+		target := gv.WithKind(patch.Kind)
+
+		index := -1
+		for i, r := range resources {
+			gvk, err := apiutil.GVKForObject(r, scheme)
+			if err != nil {
+				return err
+			}
+			if gvk == target && r.GetName() == patch.Name {
+				index = i
+
+				break
+			}
+		}
+		if index < 0 {
+			return fmt.Errorf("no %s %s resource to patch", patch.Kind, patch.Name)
+		}
+		resource, ok := patched[index]
+		if !ok {
+			copied, ok := resources[index].DeepCopyObject().(ctrl.Object)
+			if !ok {
+				return fmt.Errorf("cannot copy %s %s", patch.Kind, patch.Name)
+			}
+			resource = copied
+			patched[index] = resource
+		}
+		if err := applyPatch(resource, patch); err != nil {
+			return fmt.Errorf("cannot patch %s %s: %w", patch.Kind, patch.Name, err)
+		}
+	}
+
+	// Update the original resources, keeping their address, as they may be referenced by other traits
+	for i, resource := range patched {
+		reflect.ValueOf(resources[i]).Elem().Set(reflect.ValueOf(resource).Elem())
+	}
+
+	return nil
+}
+
+func applyPatch(resource ctrl.Object, patch Patch) error {
+	ops, err := json.Marshal(patch.Operations)
+	if err != nil {
+		return err
+	}
+	p, err := jsonpatch.DecodePatch(ops)
+	if err != nil {
+		return err
+	}
+	original, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	patched, err := p.Apply(original)
+	if err != nil {
+		return err
+	}
+
+	name, namespace := resource.GetName(), resource.GetNamespace()
+	// Reset the resource before decoding the patched document, so that removed fields are not retained
+	value := reflect.ValueOf(resource).Elem()
+	value.Set(reflect.Zero(value.Type()))
+	if err := json.Unmarshal(patched, resource); err != nil {
+		return err
+	}
+	if resource.GetName() != name || resource.GetNamespace() != namespace {
+		return errors.New("the resource name and namespace cannot be patched")
+	}
+
+	return nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	return s[:n] + "..."
+}
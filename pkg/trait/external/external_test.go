@@ -0,0 +1,190 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func testResources() (*appsv1.Deployment, *corev1.ConfigMap) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "hello",
+			Labels:    map[string]string{"app": "hello"},
+		},
+	}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "hello",
+		},
+		Data: map[string]string{"key": "value"},
+	}
+
+	return deployment, configMap
+}
+
+func TestNewRequest(t *testing.T) {
+	deployment, configMap := testResources()
+	request, err := NewRequest(scheme.Scheme, "chargeback", OperationApply, map[string]any{"costCenter": "42"},
+		&v1.Integration{}, []ctrl.Object{deployment, configMap})
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, request.UID)
+	require.Len(t, request.Resources, 2)
+	assert.Equal(t, "apps/v1", request.Resources[0].GetAPIVersion())
+	assert.Equal(t, "Deployment", request.Resources[0].GetKind())
+	assert.Equal(t, "v1", request.Resources[1].GetAPIVersion())
+	assert.Equal(t, "ConfigMap", request.Resources[1].GetKind())
+}
+
+func TestApplyPatches(t *testing.T) {
+	deployment, configMap := testResources()
+	resources := []ctrl.Object{deployment, configMap}
+
+	err := ApplyPatches(scheme.Scheme, resources, []Patch{
+		{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       "hello",
+			Operations: []JSONPatchOperation{
+				{Op: "add", Path: "/metadata/labels/team", Value: "orders"},
+				{Op: "add", Path: "/spec/replicas", Value: 2},
+			},
+		},
+		{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Name:       "hello",
+			Operations: []JSONPatchOperation{
+				{Op: "remove", Path: "/data"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// the original resources are updated in place
+	assert.Equal(t, map[string]string{"app": "hello", "team": "orders"}, deployment.Labels)
+	require.NotNil(t, deployment.Spec.Replicas)
+	assert.Equal(t, int32(2), *deployment.Spec.Replicas)
+	assert.Nil(t, configMap.Data)
+}
+
+func TestApplyPatchesIsAtomic(t *testing.T) {
+	deployment, configMap := testResources()
+	resources := []ctrl.Object{deployment, configMap}
+
+	err := ApplyPatches(scheme.Scheme, resources, []Patch{
+		{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       "hello",
+			Operations: []JSONPatchOperation{{Op: "add", Path: "/metadata/labels/team", Value: "orders"}},
+		},
+		{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Name:       "hello",
+			Operations: []JSONPatchOperation{{Op: "remove", Path: "/binaryData"}},
+		},
+	})
+	require.Error(t, err)
+	assert.Equal(t, map[string]string{"app": "hello"}, deployment.Labels)
+}
+
+func TestApplyPatchesErrors(t *testing.T) {
+	deployment, _ := testResources()
+	resources := []ctrl.Object{deployment}
+
+	err := ApplyPatches(scheme.Scheme, resources, []Patch{{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Name:       "hello",
+	}})
+	require.Error(t, err)
+	assert.Equal(t, "no Secret hello resource to patch", err.Error())
+
+	err = ApplyPatches(scheme.Scheme, resources, []Patch{{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "hello",
+		Operations: []JSONPatchOperation{{Op: "replace", Path: "/metadata/namespace", Value: "other"}},
+	}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the resource name and namespace cannot be patched")
+	assert.Equal(t, "default", deployment.Namespace)
+}
+
+type labelTrait struct{}
+
+func (labelTrait) Configure(request *Request) (bool, error) {
+	return true, nil
+}
+
+func (labelTrait) Apply(request *Request) ([]Patch, error) {
+	return []Patch{{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       request.Integration.Name,
+		Operations: []JSONPatchOperation{{Op: "add", Path: "/metadata/labels/team", Value: "orders"}},
+	}}, nil
+}
+
+func TestClientCall(t *testing.T) {
+	server := httptest.NewServer(NewHandler(labelTrait{}))
+	defer server.Close()
+
+	client := Client{URL: server.URL, HTTPClient: server.Client()}
+	request := &Request{UID: "1234", Trait: "label", Operation: OperationConfigure, Integration: &v1.Integration{}}
+	response, err := client.Call(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, "1234", response.UID)
+	assert.True(t, response.Enabled)
+
+	request.Operation = "Delete"
+	response, err = client.Call(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, `unsupported operation "Delete"`, response.Error)
+}
+
+func TestClientCallInvalidResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"uid":"other"}`))
+	}))
+	defer server.Close()
+
+	client := Client{URL: server.URL, HTTPClient: server.Client()}
+	_, err := client.Call(context.Background(), &Request{UID: "1234"})
+	require.Error(t, err)
+	assert.Equal(t, `invalid webhook response: expected uid "1234", got "other"`, err.Error())
+}
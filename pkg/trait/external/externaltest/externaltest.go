@@ -0,0 +1,106 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package externaltest provides a harness to test the webhooks implementing external traits locally,
+// calling them the way the operator does, without a cluster.
+package externaltest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/camel-k/v2/pkg/apis"
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/trait/external"
+)
+
+// Harness calls an external trait webhook for an Integration and its resources.
+type Harness struct {
+	// The ID of the external trait.
+	Trait string
+	// The trait configuration, as set in the `addons` section of the Integration traits.
+	Configuration map[string]any
+	// The Integration the trait is executed for.
+	Integration *v1.Integration
+	// The resources generated for the Integration, patched in place by Apply.
+	Resources []ctrl.Object
+	// The scheme used to resolve the kind of the resources (default the Kubernetes and Camel K types).
+	Scheme *runtime.Scheme
+}
+
+// Configure calls the webhook with the Configure operation and returns whether the trait is enabled.
+func (h *Harness) Configure(handler http.Handler) (bool, error) {
+	response, err := h.call(handler, external.OperationConfigure)
+	if err != nil {
+		return false, err
+	}
+
+	return response.Enabled, nil
+}
+
+// Apply calls the webhook with the Apply operation and applies the returned patches to the resources.
+func (h *Harness) Apply(handler http.Handler) error {
+	response, err := h.call(handler, external.OperationApply)
+	if err != nil {
+		return err
+	}
+
+	return external.ApplyPatches(h.scheme(), h.Resources, response.Patches)
+}
+
+func (h *Harness) call(handler http.Handler, operation external.Operation) (*external.Response, error) {
+	if h.Integration == nil {
+		return nil, errors.New("the harness requires an Integration")
+	}
+	var resources []ctrl.Object
+	if operation == external.OperationApply {
+		resources = h.Resources
+	}
+	request, err := external.NewRequest(h.scheme(), h.Trait, operation, h.Configuration, h.Integration, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	client := external.Client{URL: server.URL, HTTPClient: server.Client()}
+	response, err := client.Call(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	return response, nil
+}
+
+func (h *Harness) scheme() *runtime.Scheme {
+	if h.Scheme == nil {
+		h.Scheme = runtime.NewScheme()
+		_ = clientscheme.AddToScheme(h.Scheme)
+		_ = apis.AddToScheme(h.Scheme)
+	}
+
+	return h.Scheme
+}
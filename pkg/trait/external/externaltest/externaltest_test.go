@@ -0,0 +1,101 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externaltest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/trait/external"
+)
+
+// sidecarTrait adds a logging sidecar container to the Integration Deployment.
+type sidecarTrait struct{}
+
+func (sidecarTrait) Configure(request *external.Request) (bool, error) {
+	return request.Integration.Labels["logging"] != "disabled", nil
+}
+
+func (sidecarTrait) Apply(request *external.Request) ([]external.Patch, error) {
+	image, ok := request.Configuration["image"].(string)
+	if !ok {
+		return nil, errors.New("the sidecar image is required")
+	}
+
+	return []external.Patch{{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       request.Integration.Name,
+		Operations: []external.JSONPatchOperation{{
+			Op:    "add",
+			Path:  "/spec/template/spec/containers/-",
+			Value: map[string]any{"name": "logging", "image": image},
+		}},
+	}}, nil
+}
+
+func TestHarness(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "hello",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "integration", Image: "hello:1.0"}},
+				},
+			},
+		},
+	}
+	h := Harness{
+		Trait:         "logging-sidecar",
+		Configuration: map[string]any{"image": "fluent-bit:3"},
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "hello",
+			},
+		},
+		Resources: []ctrl.Object{deployment},
+	}
+	handler := external.NewHandler(sidecarTrait{})
+
+	enabled, err := h.Configure(handler)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	require.NoError(t, h.Apply(handler))
+	require.Len(t, deployment.Spec.Template.Spec.Containers, 2)
+	assert.Equal(t, "logging", deployment.Spec.Template.Spec.Containers[1].Name)
+	assert.Equal(t, "fluent-bit:3", deployment.Spec.Template.Spec.Containers[1].Image)
+
+	h.Configuration = nil
+	err = h.Apply(handler)
+	require.Error(t, err)
+	assert.Equal(t, "the sidecar image is required", err.Error())
+}
@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/util/json"
+)
+
+// maxRequestSize is the maximum size of a request body accepted by the Handler.
+const maxRequestSize = 16 * 1024 * 1024
+
+// Trait is implemented by the external traits served with NewHandler.
+type Trait interface {
+	// Configure tells whether the trait is enabled for the Integration of the request.
+	Configure(request *Request) (bool, error)
+	// Apply returns the patches to apply to the resources of the request.
+	Apply(request *Request) ([]Patch, error)
+}
+
+// NewHandler returns an HTTP handler serving the given trait with the external trait protocol.
+// The errors returned by the trait are reported in the response.
+func NewHandler(trait Trait) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+			return
+		}
+		data, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+		var request Request
+		if err := json.Unmarshal(data, &request); err != nil {
+			http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		response := Response{UID: request.UID}
+		switch request.Operation {
+		case OperationConfigure:
+			response.Enabled, err = trait.Configure(&request)
+		case OperationApply:
+			response.Patches, err = trait.Apply(&request)
+		default:
+			err = fmt.Errorf("unsupported operation %q", request.Operation)
+		}
+		if err != nil {
+			response = Response{UID: request.UID, Error: err.Error()}
+		}
+
+		body, err := json.Marshal(&response)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	})
+}
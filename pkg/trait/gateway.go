@@ -77,7 +77,12 @@ func (t *gatewayTrait) Apply(e *Environment) error {
 	}
 	e.Resources.Add(gw)
 	servicePorts := extractPorts(service.Spec.Ports)
-	route := buildHTTPRoute(gwName, gw.GetName(), service.GetName(), gw.GetNamespace(), servicePorts)
+	// expose exactly the paths declared by the OpenAPI document, if any
+	exposedPaths, err := e.getOpenAPIExposedPaths()
+	if err != nil {
+		return err
+	}
+	route := buildHTTPRoute(gwName, gw.GetName(), service.GetName(), gw.GetNamespace(), servicePorts, exposedPaths)
 	e.Resources.Add(route)
 
 	e.Integration.Status.SetCondition(
@@ -151,10 +156,24 @@ func isSupported(protocol string) bool {
 	return protocol == "HTTP" || protocol == "HTTPS"
 }
 
-// buildHTTPRoute provides the most basic gateway builder method.
-func buildHTTPRoute(routeName, gatewayName, serviceName, namespace string, servicePorts []int32) *gwv1.HTTPRoute {
+// buildHTTPRoute provides the most basic gateway builder method, matching all the paths unless some are given.
+func buildHTTPRoute(routeName, gatewayName, serviceName, namespace string, servicePorts []int32, paths []exposedPath) *gwv1.HTTPRoute {
 	rules := make([]gwv1.HTTPRouteRule, 0, len(servicePorts))
 
+	matches := make([]gwv1.HTTPRouteMatch, 0, len(paths))
+	for _, p := range paths {
+		matchType := gwv1.PathMatchPathPrefix
+		if p.Exact {
+			matchType = gwv1.PathMatchExact
+		}
+		matches = append(matches, gwv1.HTTPRouteMatch{
+			Path: &gwv1.HTTPPathMatch{
+				Type:  new(matchType),
+				Value: new(p.Path),
+			},
+		})
+	}
+
 	for _, p := range servicePorts {
 		rule := gwv1.HTTPRouteRule{
 			BackendRefs: []gwv1.HTTPBackendRef{
@@ -168,6 +187,9 @@ func buildHTTPRoute(routeName, gatewayName, serviceName, namespace string, servi
 				},
 			},
 		}
+		if len(matches) > 0 {
+			rule.Matches = matches
+		}
 
 		rules = append(rules, rule)
 	}
@@ -66,6 +66,11 @@ var (
 			Group:   appsv1.SchemeGroupVersion.Group,
 			Version: appsv1.SchemeGroupVersion.Version,
 		}: {},
+		{
+			Kind:    "StatefulSet",
+			Group:   appsv1.SchemeGroupVersion.Group,
+			Version: appsv1.SchemeGroupVersion.Version,
+		}: {},
 		{
 			Kind:    "Secret",
 			Group:   corev1.SchemeGroupVersion.Group,
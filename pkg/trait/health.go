@@ -28,7 +28,6 @@ import (
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
-	"github.com/apache/camel-k/v2/pkg/util"
 )
 
 const (
@@ -178,7 +177,7 @@ func (t *healthTrait) Apply(e *Environment) error {
 func addCamelHealthCapabilityDependency(e *Environment) {
 	if capability, ok := e.CamelCatalog.Runtime.Capabilities[v1.CapabilityHealth]; ok {
 		for _, dependency := range capability.Dependencies {
-			util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, dependency.GetDependencyID())
+			e.addDependencies(v1.DependencyOriginCapability, v1.CapabilityHealth, dependency.GetDependencyID())
 		}
 		// sort the dependencies to get always the same list if they don't change
 		sort.Strings(e.Integration.Status.Dependencies)
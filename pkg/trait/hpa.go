@@ -0,0 +1,250 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+const (
+	hpaTraitID    = "hpa"
+	hpaTraitOrder = 900
+
+	defaultHPAMinReplicas    = int32(1)
+	defaultHPACPUUtilization = int32(80)
+
+	camelExchangesInflightMetric  = "camel_exchanges_inflight"
+	camelExchangesPerSecondMetric = "camel_exchanges_per_second"
+	camelRouteIDMetricLabel       = "routeId"
+)
+
+type hpaTrait struct {
+	BaseTrait
+	traitv1.HPATrait `property:",squash"`
+}
+
+func newHPATrait() Trait {
+	return &hpaTrait{
+		BaseTrait: NewBaseTrait(hpaTraitID, hpaTraitOrder),
+	}
+}
+
+func (t *hpaTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !ptr.Deref(t.Enabled, false) || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+
+	if kt, ok := e.Catalog.GetTrait(kedaTraitID).(*kedaTrait); ok && ptr.Deref(kt.Enabled, false) {
+		return false, nil, errors.New("hpa trait can't be enabled together with keda trait")
+	}
+
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return false, nil, errors.New("unable to determine the controller strategy")
+	}
+	switch strategy {
+	case ControllerStrategyKnativeService, ControllerStrategyCronJob, ControllerStrategyJob:
+		return false, nil, fmt.Errorf("horizontalpodautoscaler isn't supported with %s controller strategy", strategy)
+	}
+
+	if t.MaxReplicas == nil {
+		return false, nil, errors.New("hpa trait requires max-replicas to be set")
+	}
+	minReplicas := ptr.Deref(t.MinReplicas, defaultHPAMinReplicas)
+	if minReplicas < 1 || minReplicas > *t.MaxReplicas {
+		return false, nil, fmt.Errorf("hpa trait min-replicas (%d) must be between 1 and max-replicas (%d)", minReplicas, *t.MaxReplicas)
+	}
+
+	if t.InflightExchanges != "" || t.ExchangeRate != "" {
+		if pt, ok := e.Catalog.GetTrait(prometheusTraitID).(*prometheusTrait); !ok || !ptr.Deref(pt.Enabled, false) {
+			return false, nil, errors.New("hpa trait Camel metrics targets require the prometheus trait to be enabled")
+		}
+		for _, target := range []string{t.InflightExchanges, t.ExchangeRate} {
+			if _, err := parseHPATarget(target); err != nil {
+				return false, nil, err
+			}
+		}
+	}
+
+	var condition *TraitCondition
+	if replicas := e.Integration.Spec.Replicas; replicas != nil && (*replicas < minReplicas || *replicas > *t.MaxReplicas) {
+		condition = NewIntegrationCondition(
+			"HPA",
+			v1.IntegrationConditionTraitInfo,
+			corev1.ConditionTrue,
+			TraitConfigurationReason,
+			fmt.Sprintf("replicas (%d) is out of the [%d, %d] range, and will be set by the horizontalpodautoscaler",
+				*replicas, minReplicas, *t.MaxReplicas),
+		)
+	}
+
+	return true, condition, nil
+}
+
+func (t *hpaTrait) Apply(e *Environment) error {
+	hpa, err := t.horizontalPodAutoscalerFor(e.Integration)
+	if err != nil {
+		return err
+	}
+	e.Resources.Add(hpa)
+
+	return nil
+}
+
+func (t *hpaTrait) horizontalPodAutoscalerFor(it *v1.Integration) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	metrics, err := t.getMetrics()
+	if err != nil {
+		return nil, err
+	}
+
+	target := getScaleTarget(it)
+	// The Integration type meta may not be populated
+	if target.Kind == "" {
+		target.APIVersion = v1.SchemeGroupVersion.String()
+		target.Kind = v1.IntegrationKind
+	}
+
+	hpa := autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: autoscalingv2.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      it.Name,
+			Namespace: it.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: it.Name,
+			},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: target.APIVersion,
+				Kind:       target.Kind,
+				Name:       target.Name,
+			},
+			MinReplicas: ptr.To(ptr.Deref(t.MinReplicas, defaultHPAMinReplicas)),
+			MaxReplicas: *t.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+	if t.ScaleDownStabilizationWindowSeconds != nil {
+		hpa.Spec.Behavior = &autoscalingv2.HorizontalPodAutoscalerBehavior{
+			ScaleDown: &autoscalingv2.HPAScalingRules{
+				StabilizationWindowSeconds: t.ScaleDownStabilizationWindowSeconds,
+			},
+		}
+	}
+
+	return &hpa, nil
+}
+
+func (t *hpaTrait) getMetrics() ([]autoscalingv2.MetricSpec, error) {
+	metrics := make([]autoscalingv2.MetricSpec, 0)
+	cpu := t.CPUUtilization
+	if cpu == nil && t.MemoryUtilization == nil && t.InflightExchanges == "" && t.ExchangeRate == "" {
+		cpu = ptr.To(defaultHPACPUUtilization)
+	}
+	if cpu != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceCPU, *cpu))
+	}
+	if t.MemoryUtilization != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceMemory, *t.MemoryUtilization))
+	}
+
+	camelMetrics := []struct {
+		name   string
+		target string
+	}{
+		{name: camelExchangesInflightMetric, target: t.InflightExchanges},
+		{name: camelExchangesPerSecondMetric, target: t.ExchangeRate},
+	}
+	for _, m := range camelMetrics {
+		if m.target == "" {
+			continue
+		}
+		value, err := parseHPATarget(m.target)
+		if err != nil {
+			return nil, err
+		}
+		if len(t.Routes) == 0 {
+			metrics = append(metrics, podsMetric(m.name, nil, value))
+		}
+		// The busiest route drives the scaling
+		for _, route := range t.Routes {
+			selector := &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					camelRouteIDMetricLabel: route,
+				},
+			}
+			metrics = append(metrics, podsMetric(m.name, selector, value))
+		}
+	}
+
+	return metrics, nil
+}
+
+func parseHPATarget(target string) (resource.Quantity, error) {
+	if target == "" {
+		return resource.Quantity{}, nil
+	}
+	value, err := resource.ParseQuantity(target)
+	if err != nil {
+		return resource.Quantity{}, fmt.Errorf("invalid hpa trait metric target %q: %w", target, err)
+	}
+
+	return value, nil
+}
+
+func resourceMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: ptr.To(utilization),
+			},
+		},
+	}
+}
+
+func podsMetric(name string, selector *metav1.LabelSelector, value resource.Quantity) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.PodsMetricSourceType,
+		Pods: &autoscalingv2.PodsMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{
+				Name:     name,
+				Selector: selector,
+			},
+			Target: autoscalingv2.MetricTarget{
+				Type:         autoscalingv2.AverageValueMetricType,
+				AverageValue: &value,
+			},
+		},
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+func TestHPAWithDefaultTarget(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.HPA = &traitv1.HPATrait{
+		Trait: traitv1.Trait{
+			Enabled: ptr.To(true),
+		},
+		MaxReplicas: ptr.To(int32(5)),
+	}
+
+	_, _, err := traitCatalog.apply(environment)
+	require.NoError(t, err)
+	assert.NotNil(t, environment.GetTrait(hpaTraitID))
+
+	hpa := findHPA(environment)
+	require.NotNil(t, hpa)
+	assert.Equal(t, autoscalingv2.CrossVersionObjectReference{
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Kind:       v1.IntegrationKind,
+		Name:       "hello",
+	}, hpa.Spec.ScaleTargetRef)
+	assert.Equal(t, int32(1), *hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+	assert.Nil(t, hpa.Spec.Behavior)
+	require.Len(t, hpa.Spec.Metrics, 1)
+	assert.Equal(t, corev1.ResourceCPU, hpa.Spec.Metrics[0].Resource.Name)
+	assert.Equal(t, int32(80), *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+}
+
+func TestHPAWithCamelMetrics(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.PipeKind,
+			Name:       "my-pipe",
+		},
+	}
+	environment.Integration.Spec.Traits.Prometheus = &traitv1.PrometheusTrait{
+		Trait: traitv1.Trait{
+			Enabled: ptr.To(true),
+		},
+	}
+	environment.Integration.Spec.Traits.HPA = &traitv1.HPATrait{
+		Trait: traitv1.Trait{
+			Enabled: ptr.To(true),
+		},
+		MinReplicas:                         ptr.To(int32(2)),
+		MaxReplicas:                         ptr.To(int32(10)),
+		MemoryUtilization:                   ptr.To(int32(70)),
+		InflightExchanges:                   "20",
+		ExchangeRate:                        "500m",
+		Routes:                              []string{"orders", "payments"},
+		ScaleDownStabilizationWindowSeconds: ptr.To(int32(60)),
+	}
+
+	_, _, err := traitCatalog.apply(environment)
+	require.NoError(t, err)
+
+	hpa := findHPA(environment)
+	require.NotNil(t, hpa)
+	assert.Equal(t, v1.PipeKind, hpa.Spec.ScaleTargetRef.Kind)
+	assert.Equal(t, "my-pipe", hpa.Spec.ScaleTargetRef.Name)
+	assert.Equal(t, int32(2), *hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(60), *hpa.Spec.Behavior.ScaleDown.StabilizationWindowSeconds)
+
+	require.Len(t, hpa.Spec.Metrics, 5)
+	assert.Equal(t, corev1.ResourceMemory, hpa.Spec.Metrics[0].Resource.Name)
+	inflight := hpa.Spec.Metrics[1].Pods
+	assert.Equal(t, camelExchangesInflightMetric, inflight.Metric.Name)
+	assert.Equal(t, "orders", inflight.Metric.Selector.MatchLabels[camelRouteIDMetricLabel])
+	assert.Equal(t, resource.MustParse("20"), *inflight.Target.AverageValue)
+	rate := hpa.Spec.Metrics[4].Pods
+	assert.Equal(t, camelExchangesPerSecondMetric, rate.Metric.Name)
+	assert.Equal(t, "payments", rate.Metric.Selector.MatchLabels[camelRouteIDMetricLabel])
+	assert.Equal(t, resource.MustParse("500m"), *rate.Target.AverageValue)
+}
+
+func TestHPAInvalidConfiguration(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(e *Environment)
+		err   string
+	}{
+		{
+			name:  "missing max replicas",
+			setup: func(e *Environment) { e.Integration.Spec.Traits.HPA.MaxReplicas = nil },
+			err:   "hpa trait requires max-replicas to be set",
+		},
+		{
+			name:  "min greater than max",
+			setup: func(e *Environment) { e.Integration.Spec.Traits.HPA.MinReplicas = ptr.To(int32(4)) },
+			err:   "hpa trait min-replicas (4) must be between 1 and max-replicas (3)",
+		},
+		{
+			name:  "camel metrics without prometheus",
+			setup: func(e *Environment) { e.Integration.Spec.Traits.HPA.InflightExchanges = "10" },
+			err:   "hpa trait Camel metrics targets require the prometheus trait to be enabled",
+		},
+		{
+			name: "keda",
+			setup: func(e *Environment) {
+				e.Integration.Spec.Traits.Keda = &traitv1.KedaTrait{Trait: traitv1.Trait{Enabled: ptr.To(true)}}
+			},
+			err: "hpa trait can't be enabled together with keda trait",
+		},
+		{
+			name: "job",
+			setup: func(e *Environment) {
+				e.Integration.Spec.Traits.Job = &traitv1.JobTrait{Trait: traitv1.Trait{Enabled: ptr.To(true)}}
+			},
+			err: "horizontalpodautoscaler isn't supported with job controller strategy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			traitCatalog := NewCatalog(nil)
+			environment := getNominalEnv(t, traitCatalog)
+			environment.Integration.Spec.Traits.HPA = &traitv1.HPATrait{
+				Trait: traitv1.Trait{
+					Enabled: ptr.To(true),
+				},
+				MaxReplicas: ptr.To(int32(3)),
+			}
+			test.setup(environment)
+
+			_, _, err := traitCatalog.apply(environment)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestHPAReplicasOutOfRange(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Replicas = ptr.To(int32(8))
+	trait, _ := newHPATrait().(*hpaTrait)
+	trait.Enabled = ptr.To(true)
+	trait.MaxReplicas = ptr.To(int32(3))
+
+	configured, condition, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configured)
+	require.NotNil(t, condition)
+	assert.Contains(t, condition.message, "replicas (8) is out of the [1, 3] range")
+}
+
+func findHPA(e *Environment) *autoscalingv2.HorizontalPodAutoscaler {
+	for _, r := range e.Resources.Items() {
+		if hpa, ok := r.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+			return hpa
+		}
+	}
+
+	return nil
+}
@@ -103,6 +103,10 @@ func (t *ingressTrait) Apply(e *Environment) error {
 	if service == nil {
 		return errors.New("cannot apply ingress trait: no target service")
 	}
+	paths, err := t.getPaths(e, service)
+	if err != nil {
+		return err
+	}
 
 	ingress := networkingv1.Ingress{
 		TypeMeta: metav1.TypeMeta{
@@ -120,7 +124,7 @@ func (t *ingressTrait) Apply(e *Environment) error {
 					Host: t.Host,
 					IngressRuleValue: networkingv1.IngressRuleValue{
 						HTTP: &networkingv1.HTTPIngressRuleValue{
-							Paths: t.getPaths(service),
+							Paths: paths,
 						},
 					},
 				},
@@ -154,11 +158,11 @@ func (t *ingressTrait) Apply(e *Environment) error {
 	return nil
 }
 
-func (t *ingressTrait) getPaths(service *corev1.Service) []networkingv1.HTTPIngressPath {
-	createIngressPath := func(path string) networkingv1.HTTPIngressPath {
+func (t *ingressTrait) getPaths(e *Environment, service *corev1.Service) ([]networkingv1.HTTPIngressPath, error) {
+	createIngressPath := func(path string, pathType *networkingv1.PathType) networkingv1.HTTPIngressPath {
 		return networkingv1.HTTPIngressPath{
 			Path:     path,
-			PathType: t.getPathType(),
+			PathType: pathType,
 			Backend: networkingv1.IngressBackend{
 				Service: &networkingv1.IngressServiceBackend{
 					Name: service.Name,
@@ -173,17 +177,31 @@ func (t *ingressTrait) getPaths(service *corev1.Service) []networkingv1.HTTPIngr
 	paths := []networkingv1.HTTPIngressPath{}
 	//nolint:staticcheck
 	if t.Path == "" && len(t.Paths) == 0 {
-		paths = append(paths, createIngressPath(defaultPath))
+		// expose exactly the paths declared by the OpenAPI document, if any
+		exposedPaths, err := e.getOpenAPIExposedPaths()
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range exposedPaths {
+			pathType := networkingv1.PathTypePrefix
+			if p.Exact {
+				pathType = networkingv1.PathTypeExact
+			}
+			paths = append(paths, createIngressPath(p.Path, &pathType))
+		}
+		if len(paths) == 0 {
+			paths = append(paths, createIngressPath(defaultPath, t.getPathType()))
+		}
 	} else {
 		if t.Path != "" {
-			paths = append(paths, createIngressPath(t.Path))
+			paths = append(paths, createIngressPath(t.Path, t.getPathType()))
 		}
 		for _, p := range t.Paths {
-			paths = append(paths, createIngressPath(p))
+			paths = append(paths, createIngressPath(p, t.getPathType()))
 		}
 	}
 
-	return paths
+	return paths, nil
 }
 
 func (t *ingressTrait) getPathType() *networkingv1.PathType {
@@ -23,7 +23,6 @@ import (
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
-	"github.com/apache/camel-k/v2/pkg/util"
 	"k8s.io/utils/ptr"
 )
 
@@ -66,6 +65,11 @@ func (t *initTrait) Apply(e *Environment) error {
 		})
 	}
 
+	// OpenAPI documents need to be turned into a generated source
+	if err := generateOpenAPISource(e); err != nil {
+		return err
+	}
+
 	// Dependencies need to be recomputed in case of a trait declares a capability but as
 	// the dependencies trait runs earlier than some task such as the cron one, we need to
 	// register a post step processor that recompute the dependencies based on the declared
@@ -77,7 +81,7 @@ func (t *initTrait) Apply(e *Environment) error {
 			// add runtime specific dependencies
 			for _, capability := range e.Integration.Status.Capabilities {
 				for _, dependency := range e.CamelCatalog.Runtime.CapabilityDependencies(capability) {
-					util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, dependency.GetDependencyID())
+					e.addDependencies(v1.DependencyOriginCapability, capability, dependency.GetDependencyID())
 				}
 			}
 		}
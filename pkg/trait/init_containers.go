@@ -150,6 +150,13 @@ func (t *initContainersTrait) Apply(e *Environment) error {
 		// Deployment
 		initContainers = &deployment.Spec.Template.Spec.InitContainers
 
+		return nil
+	}); err != nil {
+		return err
+	} else if err := e.Resources.VisitStatefulSetE(func(statefulSet *appsv1.StatefulSet) error {
+		// StatefulSet
+		initContainers = &statefulSet.Spec.Template.Spec.InitContainers
+
 		return nil
 	}); err != nil {
 		return err
@@ -164,6 +171,13 @@ func (t *initContainersTrait) Apply(e *Environment) error {
 		// CronJob
 		initContainers = &cron.Spec.JobTemplate.Spec.Template.Spec.InitContainers
 
+		return nil
+	}); err != nil {
+		return err
+	} else if err := e.Resources.VisitJobE(func(job *batchv1.Job) error {
+		// Job
+		initContainers = &job.Spec.Template.Spec.InitContainers
+
 		return nil
 	}); err != nil {
 		return err
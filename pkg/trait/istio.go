@@ -23,6 +23,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/boolean"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/utils/ptr"
 
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
@@ -66,6 +67,12 @@ func (t *istioTrait) Apply(e *Environment) error {
 		e.Resources.VisitDeployment(func(d *appsv1.Deployment) {
 			d.Spec.Template.Annotations = t.injectIstioAnnotation(d.Spec.Template.Annotations, true)
 		})
+		e.Resources.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+			s.Spec.Template.Annotations = t.injectIstioAnnotation(s.Spec.Template.Annotations, true)
+		})
+		e.Resources.VisitJob(func(j *batchv1.Job) {
+			j.Spec.Template.Annotations = t.injectIstioAnnotation(j.Spec.Template.Annotations, true)
+		})
 		e.Resources.VisitKnativeConfigurationSpec(func(cs *servingv1.ConfigurationSpec) {
 			cs.Template.Annotations = t.injectIstioAnnotation(cs.Template.Annotations, false)
 		})
@@ -0,0 +1,208 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"maps"
+	"strconv"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/digest"
+	"github.com/apache/camel-k/v2/pkg/util/envvar"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	jobTraitID               = "job"
+	jobTraitOrder            = 1050
+	jobStrategySelectorOrder = 60
+
+	defaultJobMaxIdleSeconds = int32(60)
+	defaultJobBackoffLimit   = int32(0)
+)
+
+type jobTrait struct {
+	BaseTrait
+	traitv1.JobTrait `property:",squash"`
+}
+
+var _ ControllerStrategySelector = &jobTrait{}
+
+func newJobTrait() Trait {
+	return &jobTrait{
+		BaseTrait: NewBaseTrait(jobTraitID, jobTraitOrder),
+	}
+}
+
+func (t *jobTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+	if !ptr.Deref(t.Enabled, true) {
+		return false, NewIntegrationConditionUserDisabled("Job"), nil
+	}
+
+	if e.IntegrationInPhase(v1.IntegrationPhaseRunning, v1.IntegrationPhaseError) {
+		condition := e.Integration.Status.GetCondition(v1.IntegrationConditionJobAvailable)
+
+		return condition != nil && condition.Status == corev1.ConditionTrue, nil, nil
+	}
+
+	// The strategy may also be selected by the deployer trait
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return false, NewIntegrationCondition(
+			"Job",
+			v1.IntegrationConditionJobAvailable,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionJobNotAvailableReason,
+			err.Error(),
+		), err
+	}
+	if strategy != ControllerStrategyJob {
+		return false, nil, nil
+	}
+
+	return e.IntegrationInPhase(v1.IntegrationPhaseDeploying), nil, nil
+}
+
+func (t *jobTrait) SelectControllerStrategy(e *Environment) (*ControllerStrategy, error) {
+	if !ptr.Deref(t.Enabled, false) {
+		return nil, nil
+	}
+	jobStrategy := ControllerStrategyJob
+
+	return &jobStrategy, nil
+}
+
+func (t *jobTrait) ControllerStrategySelectorOrder() int {
+	return jobStrategySelectorOrder
+}
+
+func (t *jobTrait) Apply(e *Environment) error {
+	if e.IntegrationInPhase(v1.IntegrationPhaseDeploying) {
+		if err := t.deleteOutdatedJob(e); err != nil {
+			return err
+		}
+	}
+
+	if e.ApplicationProperties == nil {
+		e.ApplicationProperties = make(map[string]string)
+	}
+	// Will instruct the context to stop once the work is done
+	if t.MaxMessages != nil {
+		e.ApplicationProperties["camel.main.durationMaxMessages"] = strconv.FormatInt(int64(*t.MaxMessages), 10)
+	}
+	if t.MaxIdleSeconds != nil || t.MaxMessages == nil {
+		maxIdle := ptr.Deref(t.MaxIdleSeconds, defaultJobMaxIdleSeconds)
+		e.ApplicationProperties["camel.main.durationMaxIdleSeconds"] = strconv.FormatInt(int64(maxIdle), 10)
+	}
+
+	job := t.getJobFor(e)
+	e.Resources.Add(job)
+
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionJobAvailable,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionJobAvailableReason,
+		"job name is "+job.Name,
+	)
+
+	return nil
+}
+
+// deleteOutdatedJob deletes the Job run for a previous version of the Integration,
+// as the template of a Job cannot be changed.
+func (t *jobTrait) deleteOutdatedJob(e *Environment) error {
+	job := batchv1.Job{}
+	err := e.Client.Get(e.Ctx, ctrl.ObjectKey{Namespace: e.Integration.Namespace, Name: e.Integration.Name}, &job)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, container := range job.Spec.Template.Spec.Containers {
+		if d := envvar.Get(container.Env, digest.IntegrationDigestEnvVar); d != nil && d.Value == e.Integration.Status.Digest {
+			return nil
+		}
+	}
+	t.L.ForIntegration(e.Integration).Infof("Deleting Job %s of a previous version of the Integration", job.Name)
+
+	err = e.Client.Delete(e.Ctx, &job, ctrl.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (t *jobTrait) getJobFor(e *Environment) *batchv1.Job {
+	// create a copy to avoid sharing the underlying annotation map
+	annotations := make(map[string]string)
+	if e.Integration.Annotations != nil {
+		maps.Copy(annotations, filterTransferableAnnotations(e.Integration.Annotations))
+	}
+
+	// Set the default container annotation for kubectl commands
+	annotations[defaultContainerAnnotation] = defaultContainerName
+
+	backoffLimit := ptr.Deref(t.BackoffLimit, defaultJobBackoffLimit)
+
+	job := batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: batchv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        e.Integration.Name,
+			Namespace:   e.Integration.Namespace,
+			Labels:      kubernetes.DeploymentLabels(e.Integration.Name),
+			Annotations: annotations,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			Completions:             t.Completions,
+			Parallelism:             t.Parallelism,
+			ActiveDeadlineSeconds:   t.ActiveDeadlineSeconds,
+			TTLSecondsAfterFinished: t.TTLSecondsAfterFinished,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						v1.IntegrationLabel: e.Integration.Name,
+					},
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: e.Integration.Spec.ServiceAccountName,
+					RestartPolicy:      corev1.RestartPolicyNever,
+				},
+			},
+		},
+	}
+
+	return &job
+}
@@ -0,0 +1,159 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/digest"
+)
+
+func TestJobIntegrationPhaseDeploying(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.Job = &traitv1.JobTrait{
+		Trait: traitv1.Trait{
+			Enabled: ptr.To(true),
+		},
+		MaxMessages:             ptr.To(int32(100)),
+		BackoffLimit:            ptr.To(int32(3)),
+		TTLSecondsAfterFinished: ptr.To(int32(600)),
+	}
+
+	_, _, err := traitCatalog.apply(environment)
+	require.NoError(t, err)
+	assert.NotNil(t, environment.GetTrait(jobTraitID))
+	assert.Nil(t, environment.GetTrait(deploymentTraitID))
+	assert.True(t, environment.Integration.IsConditionTrue(v1.IntegrationConditionJobAvailable))
+
+	assert.Nil(t, environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return true }))
+	job := environment.Resources.GetJob(func(j *batchv1.Job) bool { return j.Name == "hello" })
+	require.NotNil(t, job)
+	assert.Equal(t, corev1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+	assert.Equal(t, int32(3), *job.Spec.BackoffLimit)
+	assert.Equal(t, int32(600), *job.Spec.TTLSecondsAfterFinished)
+	assert.Nil(t, job.Spec.Completions)
+	assert.Equal(t, "hello", job.Spec.Template.Labels[v1.IntegrationLabel])
+	assert.Equal(t, defaultContainerName, job.Spec.Template.Spec.Containers[0].Name)
+
+	assert.Equal(t, "100", environment.ApplicationProperties["camel.main.durationMaxMessages"])
+	assert.NotContains(t, environment.ApplicationProperties, "camel.main.durationMaxIdleSeconds")
+}
+
+func TestJobSelectedByDeployer(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.Deployer = &traitv1.DeployerTrait{
+		Kind: "job",
+	}
+
+	_, _, err := traitCatalog.apply(environment)
+	require.NoError(t, err)
+
+	job := environment.Resources.GetJob(func(j *batchv1.Job) bool { return j.Name == "hello" })
+	require.NotNil(t, job)
+	assert.Equal(t, int32(defaultJobBackoffLimit), *job.Spec.BackoffLimit)
+	assert.Nil(t, job.Spec.TTLSecondsAfterFinished)
+	assert.Nil(t, environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return true }))
+	assert.Equal(t, "60", environment.ApplicationProperties["camel.main.durationMaxIdleSeconds"])
+	assert.NotContains(t, environment.ApplicationProperties, "camel.main.durationMaxMessages")
+}
+
+func TestJobRunningWithoutAvailableCondition(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Status.Phase = v1.IntegrationPhaseRunning
+	trait, _ := newJobTrait().(*jobTrait)
+	trait.Enabled = ptr.To(true)
+
+	configured, condition, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.Nil(t, condition)
+	assert.False(t, configured)
+
+	environment.Integration.Status.SetCondition(
+		v1.IntegrationConditionJobAvailable,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionJobAvailableReason,
+		"job name is hello",
+	)
+	configured, _, err = trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configured)
+}
+
+func TestJobDeleteOutdated(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Status.Digest = "v2"
+	trait, _ := newJobTrait().(*jobTrait)
+
+	current := jobWithDigest("v2")
+	fakeClient, err := internal.NewFakeClient(current)
+	require.NoError(t, err)
+	environment.Client = fakeClient
+	require.NoError(t, trait.deleteOutdatedJob(environment))
+	require.NoError(t, fakeClient.Get(environment.Ctx, ctrl.ObjectKeyFromObject(current), &batchv1.Job{}))
+
+	outdated := jobWithDigest("v1")
+	fakeClient, err = internal.NewFakeClient(outdated)
+	require.NoError(t, err)
+	environment.Client = fakeClient
+	require.NoError(t, trait.deleteOutdatedJob(environment))
+	err = fakeClient.Get(environment.Ctx, ctrl.ObjectKeyFromObject(outdated), &batchv1.Job{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func jobWithDigest(d string) *batchv1.Job {
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: batchv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "hello",
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: defaultContainerName,
+							Env:  []corev1.EnvVar{{Name: digest.IntegrationDigestEnvVar, Value: d}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
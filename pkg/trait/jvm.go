@@ -210,6 +210,12 @@ func (t *jvmTrait) feedContainer(container *corev1.Container, args []string, e *
 				kitDepsDirs = getLegacyCamelQuarkusDependenciesPaths()
 			}
 			classpathItems = getClasspath(kitDepsDirs, classpathItems)
+			if builder.HasAppCDSArchive(kit.Status.Artifacts) && !util.StringSliceContainsAnyOf(args, "-XX:SharedArchiveFile", "-Xshare") {
+				// The archive is only used when the classpath starts with the one it has been trained with
+				appCDSClasspath := builder.AppCDSClasspath(kit.Status.Artifacts)
+				classpathItems = strings.Join(append(appCDSClasspath, classpathItems), ":")
+				args = append(args, "-XX:SharedArchiveFile="+builder.AppCDSArchiveTarget, "-Xshare:auto")
+			}
 		}
 		args = append(args, "-cp", classpathItems)
 		args = append(args, e.CamelCatalog.Runtime.ApplicationClass)
@@ -24,6 +24,7 @@ import (
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/builder"
 	"github.com/apache/camel-k/v2/pkg/internal"
 	"github.com/apache/camel-k/v2/pkg/util/camel"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
@@ -320,6 +321,53 @@ func TestApplyJvmTraitWithDeploymentResource(t *testing.T) {
 	}, d.Spec.Template.Spec.Containers[0].Args)
 }
 
+func TestApplyJvmTraitWithAppCDSArchive(t *testing.T) {
+	trait, environment := createNominalJvmTest(v1.IntegrationKitTypePlatform)
+	environment.IntegrationKit.Status.Artifacts = append(environment.IntegrationKit.Status.Artifacts,
+		v1.Artifact{Target: "dependencies/quarkus-run.jar"},
+		v1.Artifact{Target: builder.AppCDSArchiveTarget},
+	)
+
+	d := appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: defaultContainerName,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	environment.Resources.Add(&d)
+	configure, _, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configure)
+	require.NoError(t, trait.Apply(environment))
+
+	assert.Equal(t, []string{
+		"-XX:SharedArchiveFile=dependencies/app-cds.jsa",
+		"-Xshare:auto",
+		"-cp",
+		fmt.Sprintf(
+			"dependencies/my-dep.jar:dependencies/quarkus-run.jar:./resources:%s:%s:%s:dependencies/*",
+			rdMountPath,
+			cmrMountPath,
+			scrMountPath,
+		),
+		"io.quarkus.bootstrap.runner.QuarkusEntryPoint",
+	}, d.Spec.Template.Spec.Containers[0].Args)
+
+	// User provided options take precedence
+	d.Spec.Template.Spec.Containers[0].Args = nil
+	trait.Options = []string{"-Xshare:off"}
+	require.NoError(t, trait.Apply(environment))
+	assert.NotContains(t, d.Spec.Template.Spec.Containers[0].Args, "-XX:SharedArchiveFile=dependencies/app-cds.jsa")
+}
+
 func TestApplyJvmTraitWithKnativeResource(t *testing.T) {
 	trait, environment := createNominalJvmTest(v1.IntegrationKitTypePlatform)
 
@@ -20,8 +20,10 @@ package trait
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"net/url"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -41,6 +43,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/digest"
 	"github.com/apache/camel-k/v2/pkg/util/dsl"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/source"
 )
 
 const (
@@ -56,6 +59,11 @@ const (
 type kameletsTrait struct {
 	BaseTrait
 	traitv1.KameletsTrait `property:",squash"`
+
+	// the Kamelets bundles shared with other Integrations, to be mounted by the mount trait
+	sharedBundles []*corev1.ConfigMap
+	// the Kamelets used by the Integration, by name
+	kamelets map[string]*v1.Kamelet
 }
 
 func newKameletsTrait() Trait {
@@ -68,6 +76,11 @@ func (t *kameletsTrait) Configure(e *Environment) (bool, *TraitCondition, error)
 	if e.Integration == nil {
 		return false, nil, nil
 	}
+	if e.IntegrationInPhase(v1.IntegrationPhaseInitialization) || e.IntegrationInRunningPhases() {
+		// Registered regardless of the trait configuration, so that the Integration releases the shared bundles
+		// it no longer requires, also when the trait or the sharing of the bundles is disabled
+		e.PostActions = append(e.PostActions, t.syncSharedBundles)
+	}
 	if !ptr.Deref(t.Enabled, true) {
 		return false, NewIntegrationConditionUserDisabled("Kamelets"), nil
 	}
@@ -105,18 +118,7 @@ func (t *kameletsTrait) Apply(e *Environment) error {
 
 // collectKamelets load a Kamelet specification setting the specific version specification.
 func (t *kameletsTrait) collectKamelets(e *Environment) (map[string]*v1.Kamelet, error) {
-	namespaces, err := t.calculateNamespaces(e, e.Integration.Namespace, platform.GetOperatorNamespace())
-	if err != nil {
-		return nil, err
-	}
-
-	var externalRepos []v1.KameletRepositorySpec
-	ip, _ := platform.GetForResource(e.Ctx, e.Client, e.Integration)
-	if ip != nil {
-		externalRepos = ip.Status.Kamelet.Repositories
-	}
-	//nolint:staticcheck
-	repo, err := repository.NewWithURIs(e.Ctx, e.Client, externalRepos, namespaces...)
+	repo, err := t.kameletRepository(e)
 	if err != nil {
 		return nil, err
 	}
@@ -125,6 +127,7 @@ func (t *kameletsTrait) collectKamelets(e *Environment) (map[string]*v1.Kamelet,
 	var missingKamelets []string
 	var availableKamelets []string
 	var bundledKamelets []string
+	var deprecatedKamelets []string
 
 	for kml := range strings.SplitSeq(t.List, ",") {
 		name := getKameletKey(kml)
@@ -155,6 +158,9 @@ func (t *kameletsTrait) collectKamelets(e *Environment) (map[string]*v1.Kamelet,
 		if err != nil {
 			return nil, err
 		}
+		if clonedKamelet.IsDeprecated() {
+			deprecatedKamelets = append(deprecatedKamelets, clonedKamelet.DeprecationMessage())
+		}
 		kamelets[clonedKamelet.Name] = clonedKamelet
 	}
 
@@ -176,6 +182,19 @@ func (t *kameletsTrait) collectKamelets(e *Environment) (map[string]*v1.Kamelet,
 		)
 	}
 
+	// We warn the user about any deprecated Kamelet, suggesting the replacement when available.
+	if len(deprecatedKamelets) > 0 {
+		sort.Strings(deprecatedKamelets)
+		e.Integration.Status.SetCondition(
+			v1.IntegrationConditionKameletsDeprecated,
+			corev1.ConditionTrue,
+			v1.IntegrationConditionKameletsDeprecatedReason,
+			strings.Join(deprecatedKamelets, "; "),
+		)
+	} else {
+		e.Integration.Status.RemoveCondition(v1.IntegrationConditionKameletsDeprecated)
+	}
+
 	kameletsAvailabilityMessage := ""
 	cond := corev1.ConditionTrue
 	if len(missingKamelets) > 0 {
@@ -200,6 +219,22 @@ func (t *kameletsTrait) collectKamelets(e *Environment) (map[string]*v1.Kamelet,
 	return kamelets, nil
 }
 
+// kameletRepository returns the repository the Kamelets of the Integration are loaded from.
+func (t *kameletsTrait) kameletRepository(e *Environment) (repository.KameletRepository, error) {
+	namespaces, err := t.calculateNamespaces(e, e.Integration.Namespace, platform.GetOperatorNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	var externalRepos []v1.KameletRepositorySpec
+	ip, _ := platform.GetForResource(e.Ctx, e.Client, e.Integration)
+	if ip != nil {
+		externalRepos = ip.Status.Kamelet.Repositories
+	}
+	//nolint:staticcheck
+	return repository.NewWithURIs(e.Ctx, e.Client, externalRepos, namespaces...)
+}
+
 // calculateNamespaces is in charge to scan the kamelets specification and provide a list of
 // namespaces where to look for Kamelets.
 func (t *kameletsTrait) calculateNamespaces(e *Environment, defaultNamespaces ...string) ([]string, error) {
@@ -273,35 +308,82 @@ func (t *kameletsTrait) addKamelets(e *Environment) error {
 	if err != nil {
 		return err
 	}
+	t.kamelets = kamelets
 	kb := newKameletBundle()
-	for _, kamelet := range kamelets {
+	versions := t.getKameletVersions()
+	// iterate in a deterministic order, so that the bundle content does not change among reconciliations
+	names := make([]string, 0, len(kamelets))
+	for name := range kamelets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var nested []string
+	for _, name := range names {
+		kamelet := kamelets[name]
+		meta, err := inspectKameletSources(e, kamelet)
+		if err != nil {
+			return err
+		}
+		if err := validateKameletSources(kamelet, meta); err != nil {
+			return err
+		}
+		templateMeta, err := inspectKameletTemplate(e, kamelet)
+		if err != nil {
+			return err
+		}
+		addKameletRiskCapabilities(e, meta, templateMeta)
+		nested = appendNestedKamelets(nested, kamelets, meta, templateMeta)
 		if err := t.addKameletAsSource(e, kamelet); err != nil {
 			return err
 		}
 		// Adding dependencies from Kamelets
-		util.StringSliceUniqueConcat(&e.Integration.Status.Dependencies, kamelet.Spec.Dependencies)
+		e.addDependencies(v1.DependencyOriginKamelet, kamelet.Name, kamelet.Spec.Dependencies...)
+		if meta != nil {
+			// and the ones required by the components and languages used in their Java or XML sources
+			dependencies := meta.Dependencies.List()
+			sort.Strings(dependencies)
+			e.addDependencies(v1.DependencyOriginKamelet, kamelet.Name, dependencies...)
+		}
 		// Add to Kamelet bundle configmap
-		kb.add(kamelet)
+		if err := kb.add(kamelet, versions[name]); err != nil {
+			return err
+		}
 	}
-	bundleConfigmaps, err := kb.toConfigmaps(e.Integration.Name, e.Integration.Namespace)
-	if err != nil {
+	if err := t.addNestedKameletRiskCapabilities(e, kamelets, nested); err != nil {
 		return err
 	}
+	var bundleConfigmaps []*corev1.ConfigMap
+	if ptr.Deref(t.SharedBundle, false) {
+		bundleConfigmaps, err = kb.toSharedConfigmaps(e.Integration.Namespace)
+		if err != nil {
+			return err
+		}
+		// The shared bundles are not owned (hence not deployed) by a single Integration
+		t.sharedBundles = bundleConfigmaps
+	} else {
+		bundleConfigmaps, err = kb.toConfigmaps(e.Integration.Name, e.Integration.Namespace)
+		if err != nil {
+			return err
+		}
+	}
 	// set kamelets runtime location
 	if e.ApplicationProperties == nil {
 		e.ApplicationProperties = map[string]string{}
 	}
+	locations := make([]string, 0, len(bundleConfigmaps)+2)
+	if loc := e.ApplicationProperties[KameletLocationProperty]; loc != "" {
+		locations = append(locations, loc)
+	}
 	for _, cm := range bundleConfigmaps {
 		kameletMountPoint := fmt.Sprintf("%s/%s", t.getMountPoint(), cm.Name)
-		cm.Annotations[kameletMountPointAnnotation] = kameletMountPoint
-		e.Resources.Add(cm)
-		if e.ApplicationProperties[KameletLocationProperty] == "" {
-			e.ApplicationProperties[KameletLocationProperty] = "file:" + kameletMountPoint
-		} else {
-			e.ApplicationProperties[KameletLocationProperty] += ",file:" + kameletMountPoint
+		if t.sharedBundles == nil {
+			cm.Annotations[kameletMountPointAnnotation] = kameletMountPoint
+			e.Resources.Add(cm)
 		}
+		locations = append(locations, "file:"+kameletMountPoint)
 	}
-	e.ApplicationProperties[KameletLocationProperty] += ",classpath:/kamelets"
+	locations = append(locations, "classpath:/kamelets")
+	e.ApplicationProperties[KameletLocationProperty] = strings.Join(locations, ",")
 	// required because of https://issues.apache.org/jira/browse/CAMEL-21599
 	e.ApplicationProperties[KameletErrorHandler] = "false"
 	// resort dependencies
@@ -359,6 +441,177 @@ func (t *kameletsTrait) addKameletAsSource(e *Environment, kamelet *v1.Kamelet)
 	return nil
 }
 
+// inspectKameletSources inspects the Java or XML sources of a Kamelet, which the runtime loads as any other route. It
+// returns nil when the Kamelet has no such source.
+func inspectKameletSources(e *Environment, kamelet *v1.Kamelet) (*source.Metadata, error) {
+	if e.CamelCatalog == nil {
+		return nil, nil
+	}
+	meta := source.NewMetadata()
+	inspected := false
+	for _, s := range kamelet.Spec.Sources {
+		// Sources stored elsewhere are not inspected
+		if !isRouteTemplateSource(s) || s.Content == "" || s.Compression {
+			continue
+		}
+		if err := source.InspectorForLanguage(e.CamelCatalog, s.InferLanguage()).Extract(s, &meta); err != nil {
+			return nil, fmt.Errorf("could not inspect Kamelet %s source %s: %w", kamelet.Name, s.Name, err)
+		}
+		inspected = true
+	}
+	if !inspected {
+		return nil, nil
+	}
+
+	return &meta, nil
+}
+
+// inspectKameletTemplate inspects the template of a Kamelet as the YAML route template the runtime loads. It returns nil
+// when the Kamelet has no template.
+func inspectKameletTemplate(e *Environment, kamelet *v1.Kamelet) (*source.Metadata, error) {
+	if e.CamelCatalog == nil || kamelet.Spec.Template == nil {
+		return nil, nil
+	}
+	flowData, err := dsl.TemplateToYamlDSL(*kamelet.Spec.Template, kamelet.Name)
+	if err != nil {
+		return nil, err
+	}
+	meta := source.NewMetadata()
+	flowSource := v1.NewSourceSpec(kamelet.Name+".yaml", string(flowData), v1.LanguageYaml)
+	if err := source.InspectorForLanguage(e.CamelCatalog, v1.LanguageYaml).Extract(flowSource, &meta); err != nil {
+		return nil, fmt.Errorf("could not inspect Kamelet %s template: %w", kamelet.Name, err)
+	}
+
+	return &meta, nil
+}
+
+// addKameletRiskCapabilities reports the risk categories of the Kamelet template and sources in the Integration
+// capabilities, so that the security policy covers the endpoints the Integration uses through its Kamelets.
+func addKameletRiskCapabilities(e *Environment, metas ...*source.Metadata) {
+	for _, meta := range metas {
+		if meta == nil {
+			continue
+		}
+		meta.RequiredCapabilities.Each(func(capability string) bool {
+			if _, ok := source.RiskCategory(capability); ok {
+				util.StringSliceUniqueAdd(&e.Integration.Status.Capabilities, capability)
+			}
+
+			return true
+		})
+	}
+}
+
+// appendNestedKamelets appends the Kamelets referenced by the Kamelet template and sources which the Integration does
+// not reference directly.
+func appendNestedKamelets(nested []string, kamelets map[string]*v1.Kamelet, metas ...*source.Metadata) []string {
+	for _, meta := range metas {
+		if meta == nil {
+			continue
+		}
+		for _, kml := range meta.Kamelets {
+			name := getKameletKey(kml)
+			if _, ok := kamelets[name]; !ok && v1.ValidKameletName(name) && !slices.Contains(nested, name) {
+				nested = append(nested, name)
+			}
+		}
+	}
+
+	return nested
+}
+
+// addNestedKameletRiskCapabilities reports the risk categories of the Kamelets referenced by other Kamelets, which the
+// runtime loads as well. Their templates and sources are inspected recursively, while missing Kamelets are ignored as
+// they are reported by the runtime.
+func (t *kameletsTrait) addNestedKameletRiskCapabilities(e *Environment, kamelets map[string]*v1.Kamelet, nested []string) error {
+	if len(nested) == 0 {
+		return nil
+	}
+	repo, err := t.kameletRepository(e)
+	if err != nil {
+		return err
+	}
+	visited := make(map[string]*v1.Kamelet, len(kamelets))
+	maps.Copy(visited, kamelets)
+	for len(nested) > 0 {
+		name := nested[0]
+		nested = nested[1:]
+		kamelet, err := repo.Get(e.Ctx, name)
+		if err != nil {
+			return err
+		}
+		visited[name] = kamelet
+		if kamelet == nil {
+			continue
+		}
+		meta, err := inspectKameletSources(e, kamelet)
+		if err != nil {
+			return err
+		}
+		templateMeta, err := inspectKameletTemplate(e, kamelet)
+		if err != nil {
+			return err
+		}
+		addKameletRiskCapabilities(e, meta, templateMeta)
+		for _, n := range appendNestedKamelets(nil, visited, meta, templateMeta) {
+			if !slices.Contains(nested, n) {
+				nested = append(nested, n)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateKameletSources makes sure that a Kamelet whose logic is authored in Java or XML route templates (rather than
+// in the Kamelet template) defines a route template named after the Kamelet, wired to the `kamelet:source` and
+// `kamelet:sink` endpoints according to the Kamelet type. Sources which do not define any route template are loaded
+// as they are.
+func validateKameletSources(kamelet *v1.Kamelet, meta *source.Metadata) error {
+	if kamelet.Spec.Template != nil || meta == nil || len(meta.RouteTemplates) == 0 {
+		return nil
+	}
+	if !slices.Contains(meta.RouteTemplates, kamelet.Name) {
+		return fmt.Errorf("kamelet %s sources must define a route template with id %s", kamelet.Name, kamelet.Name)
+	}
+	kameletType := kamelet.Labels[v1.KameletTypeLabel]
+	if (kameletType == v1.KameletTypeSink || kameletType == v1.KameletTypeAction) && !containsKameletEndpoint(meta.FromURIs, "source") {
+		return fmt.Errorf("kamelet %s of type %s must consume from kamelet:source", kamelet.Name, kameletType)
+	}
+	if (kameletType == v1.KameletTypeSource || kameletType == v1.KameletTypeAction) && !containsKameletEndpoint(meta.ToURIs, "sink") {
+		return fmt.Errorf("kamelet %s of type %s must produce to kamelet:sink", kamelet.Name, kameletType)
+	}
+
+	return nil
+}
+
+func containsKameletEndpoint(uris []string, endpoint string) bool {
+	for _, uri := range uris {
+		if uri == "kamelet:"+endpoint || strings.HasPrefix(uri, "kamelet:"+endpoint+"?") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isRouteTemplateSource returns true if the source is a route template authored in a language which the runtime
+// can load as any other route (ie, Java or XML), rather than from the Kamelet specification.
+func isRouteTemplateSource(s v1.SourceSpec) bool {
+	if s.Type != v1.SourceTypeTemplate {
+		return false
+	}
+	language := s.InferLanguage()
+
+	return language == v1.LanguageJavaSource || language == v1.LanguageXML
+}
+
+// isKameletRouteTemplateSource returns true if the Integration source is a Kamelet Java or XML route template, which must be
+// loaded by the runtime.
+func isKameletRouteTemplateSource(s v1.SourceSpec) bool {
+	return s.IsGeneratedFromKamelet() && isRouteTemplateSource(s)
+}
+
 func (t *kameletsTrait) getKameletKeys() []string {
 	answer := make([]string, 0)
 	for item := range strings.SplitSeq(t.List, ",") {
@@ -394,6 +647,18 @@ func getKameletKey(item string) string {
 	return ""
 }
 
+// getKameletVersions returns the Kamelet version required by the Integration, if any, for each Kamelet.
+func (t *kameletsTrait) getKameletVersions() map[string]string {
+	versions := make(map[string]string)
+	for item := range strings.SplitSeq(t.List, ",") {
+		if version, err := getKameletVersion(item); err == nil && version != "" {
+			versions[getKameletKey(item)] = version
+		}
+	}
+
+	return versions
+}
+
 func getKameletVersion(item string) (string, error) {
 	return getKameletParam(item, v1.KameletVersionProperty)
 }
@@ -18,56 +18,193 @@ limitations under the License.
 package trait
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/cmd/source"
 	"github.com/apache/camel-k/v2/pkg/util/boolean"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const KameletBundleType = "kamelets-bundle"
+const (
+	KameletBundleType = "kamelets-bundle"
+
+	// KameletBundleDigestLabel contains the digest of the content of a shared Kamelets bundle.
+	KameletBundleDigestLabel = "camel.apache.org/kamelets-bundle.digest"
+	// KameletBundleContentAnnotation lists the Kamelets (name, version and digest) stored in a shared Kamelets bundle.
+	// When the list does not fit into kameletBundleContentLimit, it contains the sha256 digest of the list instead.
+	KameletBundleContentAnnotation = "camel.apache.org/kamelets-bundle.content"
+
+	// the maximum size of the content annotation, well below the 256 KiB limit of all the annotations of a resource.
+	kameletBundleContentLimit = 16 * 1024
+)
 
 type kameletBundle struct {
-	kamelets []*v1.Kamelet
+	kamelets []kameletBundleEntry
+	// the maximum size of each configmap, defaults to 1 MiB
+	limit int
+}
+
+// kameletBundleEntry is a serialized Kamelet, keyed by its name, version and digest.
+type kameletBundleEntry struct {
+	name       string
+	version    string
+	digest     string
+	serialized []byte
+}
+
+func (e kameletBundleEntry) key() string {
+	if e.version == "" {
+		return fmt.Sprintf("%s:%s", e.name, e.digest)
+	}
+
+	return fmt.Sprintf("%s@%s:%s", e.name, e.version, e.digest)
 }
 
 func newKameletBundle() *kameletBundle {
 	return &kameletBundle{
-		kamelets: make([]*v1.Kamelet, 0),
+		kamelets: make([]kameletBundleEntry, 0),
+		limit:    source.Megabyte,
 	}
 }
 
-func (kb *kameletBundle) add(k *v1.Kamelet) {
-	kb.kamelets = append(kb.kamelets, k)
+func (kb *kameletBundle) add(k *v1.Kamelet, version string) error {
+	serialized, err := serializeKamelet(k)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(serialized)
+	kb.kamelets = append(kb.kamelets, kameletBundleEntry{
+		name:       k.Name,
+		version:    version,
+		digest:     hex.EncodeToString(sum[:]),
+		serialized: serialized,
+	})
+
+	return nil
+}
+
+// serializeKamelet only keeps the Kamelet metadata and specification, so that any change in the status
+// (or in any metadata managed by the cluster) does not change the bundle content.
+func serializeKamelet(k *v1.Kamelet) ([]byte, error) {
+	kamelet := v1.Kamelet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1.KameletKind,
+			APIVersion: v1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        k.Name,
+			Namespace:   k.Namespace,
+			Labels:      k.Labels,
+			Annotations: k.Annotations,
+		},
+		Spec: k.Spec,
+	}
+
+	return kubernetes.ToYAMLNoManagedFields(&kamelet)
+}
+
+// shards splits the Kamelets into groups, making sure not to overpass the configmap size limit. The Kamelets order is preserved.
+func (kb *kameletBundle) shards(entries []kameletBundleEntry) ([][]kameletBundleEntry, error) {
+	shards := make([][]kameletBundleEntry, 0)
+	var shard []kameletBundleEntry
+	size := 0
+	for _, e := range entries {
+		if len(e.serialized) > kb.limit {
+			return nil, fmt.Errorf("kamelet %s is too big (%d bytes) to fit into a configmap", e.name, len(e.serialized))
+		}
+		// Add if it fits into a configmap, otherwise, create a new one
+		if size+len(e.serialized) > kb.limit {
+			shards = append(shards, shard)
+			shard = nil
+			size = 0
+		}
+		shard = append(shard, e)
+		size += len(e.serialized)
+	}
+	if len(shard) > 0 {
+		shards = append(shards, shard)
+	}
+
+	return shards, nil
 }
 
 // Split the contents of the Kamelets into one ore more configmap, making sure not to overpass the 1 MB limit.
 func (kb *kameletBundle) toConfigmaps(itName, itNamespace string) ([]*corev1.ConfigMap, error) {
-	configmaps := make([]*corev1.ConfigMap, 0)
-	cmSize := 0
-	cmID := 1
-	cm := newBundleConfigmap(itName, itNamespace, cmID)
-	for _, k := range kb.kamelets {
-		serialized, err := kubernetes.ToYAMLNoManagedFields(k)
-		if err != nil {
-			return nil, err
-		}
-		// Add if it fits into a configmap, otherwise, create a new configmap
-		if cmSize+len(serialized) > source.Megabyte {
-			configmaps = append(configmaps, cm)
-			// create a new configmap
-			cmSize = 0
-			cmID++
-			cm = newBundleConfigmap(itName, itNamespace, cmID)
-		}
-		cm.Data[k.Name+".kamelet.yaml"] = string(serialized)
-		cmSize += len(serialized)
-	}
-	// Add the last configmap
-	configmaps = append(configmaps, cm)
+	shards, err := kb.shards(kb.kamelets)
+	if err != nil {
+		return nil, err
+	}
+	configmaps := make([]*corev1.ConfigMap, 0, len(shards))
+	for i, shard := range shards {
+		cm := newBundleConfigmap(itName, itNamespace, i+1)
+		for _, e := range shard {
+			cm.Data[e.name+".kamelet.yaml"] = string(e.serialized)
+		}
+		configmaps = append(configmaps, cm)
+	}
+
+	return configmaps, nil
+}
+
+// toSharedConfigmaps splits the Kamelets into content-addressed configmaps which can be shared among the Integrations
+// of a namespace. The Kamelets are sorted by name, version and digest, so that the same set of Kamelets always produces
+// the same configmaps, whose name is derived by the digest of the Kamelets they contain.
+func (kb *kameletBundle) toSharedConfigmaps(namespace string) ([]*corev1.ConfigMap, error) {
+	entries := make([]kameletBundleEntry, len(kb.kamelets))
+	copy(entries, kb.kamelets)
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key() < entries[j].key()
+	})
+	shards, err := kb.shards(entries)
+	if err != nil {
+		return nil, err
+	}
+	configmaps := make([]*corev1.ConfigMap, 0, len(shards))
+	for _, shard := range shards {
+		keys := make([]string, 0, len(shard))
+		for _, e := range shard {
+			keys = append(keys, e.key())
+		}
+		content := strings.Join(keys, ",")
+		sum := sha256.Sum256([]byte(content))
+		shardDigest := hex.EncodeToString(sum[:])[:16]
+		if len(content) > kameletBundleContentLimit {
+			content = "sha256:" + hex.EncodeToString(sum[:])
+		}
+		cm := &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ConfigMap",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", KameletBundleType, shardDigest),
+				Namespace: namespace,
+				Labels: map[string]string{
+					kubernetes.ConfigMapTypeLabel: KameletBundleType,
+					KameletBundleDigestLabel:      shardDigest,
+				},
+				Annotations: map[string]string{
+					kubernetes.ConfigMapAutogenLabel: boolean.TrueString,
+					KameletBundleContentAnnotation:   content,
+				},
+			},
+			Data: map[string]string{},
+		}
+		for _, e := range shard {
+			cm.Data[e.name+".kamelet.yaml"] = string(e.serialized)
+		}
+		configmaps = append(configmaps, cm)
+	}
 
 	return configmaps, nil
 }
@@ -92,3 +229,109 @@ func newBundleConfigmap(name, namespace string, id int) *corev1.ConfigMap {
 		Data: map[string]string{},
 	}
 }
+
+// syncSharedBundles makes sure that the shared Kamelets bundles required by the Integration exist and are referenced by
+// the Integration. Any shared bundle no longer required by the Integration is released, and deleted when no longer
+// referenced by any other Integration.
+func (t *kameletsTrait) syncSharedBundles(e *Environment) error {
+	required := make(map[string]bool, len(t.sharedBundles))
+	for _, cm := range t.sharedBundles {
+		required[cm.Name] = true
+		if err := acquireSharedBundle(e, cm); err != nil {
+			return fmt.Errorf("could not acquire Kamelets bundle %s: %w", cm.Name, err)
+		}
+	}
+
+	bundles := &corev1.ConfigMapList{}
+	if err := e.Client.List(e.Ctx, bundles,
+		ctrl.InNamespace(e.Integration.Namespace),
+		ctrl.MatchingLabels{kubernetes.ConfigMapTypeLabel: KameletBundleType},
+		ctrl.HasLabels{KameletBundleDigestLabel},
+	); err != nil {
+		return err
+	}
+	for _, cm := range bundles.Items {
+		if required[cm.Name] || !isOwnedBy(&cm, e.Integration) {
+			continue
+		}
+		if err := releaseSharedBundle(e, cm.Name); err != nil {
+			return fmt.Errorf("could not release Kamelets bundle %s: %w", cm.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// acquireSharedBundle creates the shared bundle or adds the Integration to the list of its owners.
+func acquireSharedBundle(e *Environment, bundle *corev1.ConfigMap) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{}
+		err := e.Client.Get(e.Ctx, ctrl.ObjectKeyFromObject(bundle), cm)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		if k8serrors.IsNotFound(err) {
+			cm = bundle.DeepCopy()
+			cm.OwnerReferences = []metav1.OwnerReference{integrationReference(e.Integration)}
+			err = e.Client.Create(e.Ctx, cm)
+			if k8serrors.IsAlreadyExists(err) {
+				// Created concurrently by another Integration
+				return k8serrors.NewConflict(corev1.Resource("configmaps"), cm.Name, err)
+			}
+
+			return err
+		}
+		if isOwnedBy(cm, e.Integration) {
+			return nil
+		}
+		cm.OwnerReferences = append(cm.OwnerReferences, integrationReference(e.Integration))
+
+		return e.Client.Update(e.Ctx, cm)
+	})
+}
+
+// releaseSharedBundle removes the Integration from the list of owners of the shared bundle,
+// deleting the bundle if no other owner is left.
+func releaseSharedBundle(e *Environment, name string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{}
+		if err := e.Client.Get(e.Ctx, ctrl.ObjectKey{Namespace: e.Integration.Namespace, Name: name}, cm); err != nil {
+			return ctrl.IgnoreNotFound(err)
+		}
+		owners := make([]metav1.OwnerReference, 0, len(cm.OwnerReferences))
+		for _, o := range cm.OwnerReferences {
+			if !isIntegrationReference(o, e.Integration) {
+				owners = append(owners, o)
+			}
+		}
+		if len(owners) == 0 {
+			return ctrl.IgnoreNotFound(e.Client.Delete(e.Ctx, cm, ctrl.Preconditions{ResourceVersion: &cm.ResourceVersion}))
+		}
+		cm.OwnerReferences = owners
+
+		return e.Client.Update(e.Ctx, cm)
+	})
+}
+
+func integrationReference(it *v1.Integration) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Kind:       v1.IntegrationKind,
+		Name:       it.Name,
+		UID:        it.UID,
+	}
+}
+
+func isIntegrationReference(o metav1.OwnerReference, it *v1.Integration) bool {
+	return o.Kind == v1.IntegrationKind && o.Name == it.Name && o.UID == it.UID
+}
+
+func isOwnedBy(cm *corev1.ConfigMap, it *v1.Integration) bool {
+	for _, o := range cm.OwnerReferences {
+		if isIntegrationReference(o, it) {
+			return true
+		}
+	}
+
+	return false
+}
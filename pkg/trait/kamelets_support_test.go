@@ -18,17 +18,22 @@ limitations under the License.
 package trait
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestKameletBundleSingle(t *testing.T) {
 	kb := newKameletBundle()
-	kb.add(kamelet("my-ns", "test"))
+	require.NoError(t, kb.add(kamelet("my-ns", "test"), ""))
 	cmBundle, err := kb.toConfigmaps("my-it", "my-ns")
 	require.NoError(t, err)
 	assert.NotNil(t, cmBundle)
@@ -41,12 +46,12 @@ func TestKameletBundleSingle(t *testing.T) {
 
 func TestKameletBundleMultiKameletsSingleConfigmap(t *testing.T) {
 	kb := newKameletBundle()
-	kb.add(kamelet("default", "test1"))
-	kb.add(kamelet("default", "test2"))
-	kb.add(kamelet("default", "test3"))
-	kb.add(kamelet("default", "test4"))
-	kb.add(kamelet("default", "test5"))
-	kb.add(kamelet("default", "test6"))
+	require.NoError(t, kb.add(kamelet("default", "test1"), ""))
+	require.NoError(t, kb.add(kamelet("default", "test2"), ""))
+	require.NoError(t, kb.add(kamelet("default", "test3"), ""))
+	require.NoError(t, kb.add(kamelet("default", "test4"), ""))
+	require.NoError(t, kb.add(kamelet("default", "test5"), ""))
+	require.NoError(t, kb.add(kamelet("default", "test6"), ""))
 	cmBundle, err := kb.toConfigmaps("my-it", "default")
 	require.NoError(t, err)
 	assert.NotNil(t, cmBundle)
@@ -66,7 +71,7 @@ func TestKameletBundleMultiKameletsSingleConfigmap(t *testing.T) {
 func TestKameletBundleMultiKameletsMultiConfigmap(t *testing.T) {
 	kb := newKameletBundle()
 	for i := 0; i < 2000; i++ {
-		kb.add(kamelet("default", fmt.Sprintf("test%d", i)))
+		require.NoError(t, kb.add(kamelet("default", fmt.Sprintf("test%d", i)), ""))
 	}
 	cmBundle, err := kb.toConfigmaps("my-it", "default")
 	require.NoError(t, err)
@@ -83,6 +88,146 @@ func TestKameletBundleMultiKameletsMultiConfigmap(t *testing.T) {
 	assert.NotNil(t, cmBundle[1].Data["test1999.kamelet.yaml"])
 }
 
+func TestKameletBundleSharedDeterministic(t *testing.T) {
+	kb1 := newKameletBundle()
+	require.NoError(t, kb1.add(kamelet("default", "test1"), ""))
+	require.NoError(t, kb1.add(kamelet("default", "test2"), "v2"))
+	kb2 := newKameletBundle()
+	require.NoError(t, kb2.add(kamelet("default", "test2"), "v2"))
+	require.NoError(t, kb2.add(kamelet("default", "test1"), ""))
+
+	cmBundle1, err := kb1.toSharedConfigmaps("default")
+	require.NoError(t, err)
+	cmBundle2, err := kb2.toSharedConfigmaps("default")
+	require.NoError(t, err)
+	assert.Len(t, cmBundle1, 1)
+	assert.Equal(t, cmBundle1, cmBundle2)
+	assert.Equal(t, "default", cmBundle1[0].Namespace)
+	assert.Equal(t, "kamelets-bundle-"+cmBundle1[0].Labels[KameletBundleDigestLabel], cmBundle1[0].Name)
+	assert.Empty(t, cmBundle1[0].Labels[v1.IntegrationLabel])
+	assert.Regexp(t, "^test1:[a-f0-9]{64},test2@v2:[a-f0-9]{64}$", cmBundle1[0].Annotations[KameletBundleContentAnnotation])
+	assert.Len(t, cmBundle1[0].Data, 2)
+
+	// A different Kamelet content produces a different bundle
+	k := kamelet("default", "test1")
+	k.Spec.Dependencies = []string{"camel:log"}
+	kb3 := newKameletBundle()
+	require.NoError(t, kb3.add(k, ""))
+	require.NoError(t, kb3.add(kamelet("default", "test2"), "v2"))
+	cmBundle3, err := kb3.toSharedConfigmaps("default")
+	require.NoError(t, err)
+	assert.NotEqual(t, cmBundle1[0].Name, cmBundle3[0].Name)
+
+	// The status does not change the bundle content
+	k = kamelet("default", "test1")
+	k.Status.UsageCount = 10
+	kb4 := newKameletBundle()
+	require.NoError(t, kb4.add(k, ""))
+	require.NoError(t, kb4.add(kamelet("default", "test2"), "v2"))
+	cmBundle4, err := kb4.toSharedConfigmaps("default")
+	require.NoError(t, err)
+	assert.Equal(t, cmBundle1, cmBundle4)
+}
+
+func TestKameletBundleSharedLargeCatalog(t *testing.T) {
+	kb := newKameletBundle()
+	reversed := newKameletBundle()
+	for i := 0; i < 5000; i++ {
+		require.NoError(t, kb.add(kamelet("default", fmt.Sprintf("test%d", i)), ""))
+		require.NoError(t, reversed.add(kamelet("default", fmt.Sprintf("test%d", 4999-i)), ""))
+	}
+	cmBundle, err := kb.toSharedConfigmaps("default")
+	require.NoError(t, err)
+	assert.Greater(t, len(cmBundle), 1)
+	cmBundleReversed, err := reversed.toSharedConfigmaps("default")
+	require.NoError(t, err)
+	assert.Equal(t, cmBundle, cmBundleReversed)
+
+	names := make(map[string]bool)
+	kamelets := 0
+	for _, cm := range cmBundle {
+		// the content is too long to be listed in an annotation
+		assert.Regexp(t, "^sha256:[a-f0-9]{64}$", cm.Annotations[KameletBundleContentAnnotation])
+		size := 0
+		for _, v := range cm.Data {
+			size += len(v)
+		}
+		assert.LessOrEqual(t, size, kb.limit)
+		assert.False(t, names[cm.Name])
+		names[cm.Name] = true
+		kamelets += len(cm.Data)
+	}
+	assert.Equal(t, 5000, kamelets)
+}
+
+func TestKameletBundleTooBig(t *testing.T) {
+	kb := newKameletBundle()
+	kb.limit = 100
+	require.NoError(t, kb.add(kamelet("default", "test"), ""))
+	_, err := kb.toSharedConfigmaps("default")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "kamelet test is too big")
+	_, err = kb.toConfigmaps("my-it", "default")
+	require.Error(t, err)
+}
+
+func TestKameletBundleSharedSync(t *testing.T) {
+	kb := newKameletBundle()
+	require.NoError(t, kb.add(kamelet("default", "test1"), ""))
+	bundles, err := kb.toSharedConfigmaps("default")
+	require.NoError(t, err)
+	kb = newKameletBundle()
+	require.NoError(t, kb.add(kamelet("default", "test2"), ""))
+	otherBundles, err := kb.toSharedConfigmaps("default")
+	require.NoError(t, err)
+
+	c, err := internal.NewFakeClient()
+	require.NoError(t, err)
+	it1 := v1.NewIntegration("default", "it1")
+	it1.UID = "uid-1"
+	it2 := v1.NewIntegration("default", "it2")
+	it2.UID = "uid-2"
+	sync := func(it *v1.Integration, shared []*corev1.ConfigMap) {
+		kt, _ := newKameletsTrait().(*kameletsTrait)
+		kt.sharedBundles = shared
+		require.NoError(t, kt.syncSharedBundles(&Environment{Ctx: context.TODO(), Client: c, Integration: it}))
+	}
+	getBundle := func(name string) *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{}
+		err := c.Get(context.TODO(), ctrl.ObjectKey{Namespace: "default", Name: name}, cm)
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		require.NoError(t, err)
+
+		return cm
+	}
+
+	// Both Integrations use the same bundle
+	sync(&it1, bundles)
+	sync(&it2, bundles)
+	sync(&it2, bundles)
+	cm := getBundle(bundles[0].Name)
+	require.NotNil(t, cm)
+	assert.Len(t, cm.OwnerReferences, 2)
+	assert.Equal(t, "it1", cm.OwnerReferences[0].Name)
+	assert.Equal(t, "it2", cm.OwnerReferences[1].Name)
+	assert.Nil(t, cm.OwnerReferences[0].Controller)
+
+	// The first Integration changes its Kamelets
+	sync(&it1, otherBundles)
+	cm = getBundle(bundles[0].Name)
+	require.NotNil(t, cm)
+	assert.Len(t, cm.OwnerReferences, 1)
+	assert.Equal(t, "it2", cm.OwnerReferences[0].Name)
+	require.NotNil(t, getBundle(otherBundles[0].Name))
+
+	// The last user is gone
+	sync(&it2, nil)
+	assert.Nil(t, getBundle(bundles[0].Name))
+	require.NotNil(t, getBundle(otherBundles[0].Name))
+}
+
 func kamelet(ns, name string) *v1.Kamelet {
 	kamelet := v1.NewKamelet(ns, name)
 	kamelet.Spec = v1.KameletSpec{
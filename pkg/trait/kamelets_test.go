@@ -18,6 +18,7 @@ limitations under the License.
 package trait
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -30,9 +31,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestConfigurationNoKameletsUsed(t *testing.T) {
@@ -752,6 +755,382 @@ func TestKameletAuto(t *testing.T) {
 	assert.Equal(t, "false", environment.ApplicationProperties[KameletErrorHandler])
 }
 
+func TestJavaKameletRouteTemplate(t *testing.T) {
+	trait, environment := createKameletsTestEnvironment(`
+- from:
+    uri: kamelet:my-timer-source
+    steps:
+    - to: log:info
+`, &v1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "my-timer-source",
+			Labels: map[string]string{
+				v1.KameletTypeLabel: v1.KameletTypeSource,
+			},
+		},
+		Spec: v1.KameletSpec{
+			KameletSpecBase: v1.KameletSpecBase{
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name: "MyTimerSource.java",
+							Content: `routeTemplate("my-timer-source")
+								.from("timer:tick")
+								.to("kamelet:sink");`,
+						},
+						Type: v1.SourceTypeTemplate,
+					},
+				},
+			},
+		},
+	})
+	enabled, condition, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Nil(t, condition)
+	require.NoError(t, trait.Apply(environment))
+
+	require.Len(t, environment.Integration.Status.GeneratedSources, 1)
+	source := environment.Integration.Status.GeneratedSources[0]
+	assert.Equal(t, "my-timer-source.java", source.Name)
+	assert.True(t, isKameletRouteTemplateSource(source))
+
+	// The route template is loaded by the runtime as any other route
+	environment.ExecutedTraits = append(environment.ExecutedTraits, trait)
+	var vols []corev1.Volume
+	var mnts []corev1.VolumeMount
+	mt, _ := newMountTrait().(*mountTrait)
+	mt.configureCamelVolumesAndMounts(environment, &vols, &mnts)
+	assert.Contains(t, mnts, corev1.VolumeMount{
+		Name:      "i-source-000",
+		MountPath: "/etc/camel/sources/my-timer-source.java",
+		SubPath:   "my-timer-source.java",
+		ReadOnly:  true,
+	})
+	mt.addSourcesProperties(environment)
+	assert.Equal(t, "file:/etc/camel/sources/my-timer-source.java", environment.ApplicationProperties["camel.k.sources[0].location"])
+	assert.Equal(t, "java", environment.ApplicationProperties["camel.k.sources[0].language"])
+	assert.Equal(t, "file:/etc/camel/sources/flow.yaml", environment.ApplicationProperties["camel.k.sources[1].location"])
+}
+
+func TestXMLKameletRouteTemplate(t *testing.T) {
+	trait, environment := createKameletsTestEnvironment(`
+- from:
+    uri: timer:tick
+    steps:
+    - to: kamelet:my-log-sink
+`, &v1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "my-log-sink",
+			Labels: map[string]string{
+				v1.KameletTypeLabel: v1.KameletTypeSink,
+			},
+		},
+		Spec: v1.KameletSpec{
+			KameletSpecBase: v1.KameletSpecBase{
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name: "my-log-sink.xml",
+							Content: `<routeTemplates>
+								<routeTemplate id="my-log-sink">
+									<route>
+										<from uri="kamelet:source"/>
+										<to uri="log:info"/>
+									</route>
+								</routeTemplate>
+							</routeTemplates>`,
+						},
+						Type: v1.SourceTypeTemplate,
+					},
+				},
+			},
+		},
+	})
+	enabled, condition, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Nil(t, condition)
+	assert.Equal(t, []string{"my-log-sink"}, trait.getKameletKeys())
+	require.NoError(t, trait.Apply(environment))
+
+	require.Len(t, environment.Integration.Status.GeneratedSources, 1)
+	source := environment.Integration.Status.GeneratedSources[0]
+	assert.Equal(t, "my-log-sink.xml", source.Name)
+	assert.True(t, isKameletRouteTemplateSource(source))
+}
+
+func TestPipeJavaAndXMLKamelets(t *testing.T) {
+	// The flow generated for a Pipe binding a source Kamelet to a sink Kamelet
+	trait, environment := createKameletsTestEnvironment(`
+- route:
+    from:
+      steps:
+      - to: kamelet:my-kafka-sink/sink
+      uri: kamelet:my-timer-source/source
+    id: binding
+`, &v1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "my-timer-source",
+			Labels: map[string]string{
+				v1.KameletTypeLabel: v1.KameletTypeSource,
+			},
+		},
+		Spec: v1.KameletSpec{
+			KameletSpecBase: v1.KameletSpecBase{
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name: "MyTimerSource.java",
+							Content: `routeTemplate("my-timer-source")
+								.templateParameter("period", "1000")
+								.from("timer:tick?period={{period}}")
+								.to("kamelet:sink");`,
+						},
+						Type: v1.SourceTypeTemplate,
+					},
+				},
+			},
+		},
+	}, &v1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "my-kafka-sink",
+			Labels: map[string]string{
+				v1.KameletTypeLabel: v1.KameletTypeSink,
+			},
+		},
+		Spec: v1.KameletSpec{
+			KameletSpecBase: v1.KameletSpecBase{
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name: "my-kafka-sink.xml",
+							Content: `<routeTemplate id="my-kafka-sink">
+								<templateParameter name="topic"/>
+								<route>
+									<from uri="kamelet:source"/>
+									<to uri="kafka:{{topic}}"/>
+								</route>
+							</routeTemplate>`,
+						},
+						Type: v1.SourceTypeTemplate,
+					},
+				},
+			},
+		},
+	})
+	enabled, condition, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Nil(t, condition)
+	assert.Equal(t, []string{"my-kafka-sink", "my-timer-source"}, trait.getKameletKeys())
+	require.NoError(t, trait.Apply(environment))
+
+	require.Len(t, environment.Integration.Status.GeneratedSources, 2)
+	assert.Equal(t, "my-kafka-sink.xml", environment.Integration.Status.GeneratedSources[0].Name)
+	assert.True(t, isKameletRouteTemplateSource(environment.Integration.Status.GeneratedSources[0]))
+	assert.Equal(t, "my-timer-source.java", environment.Integration.Status.GeneratedSources[1].Name)
+	assert.True(t, isKameletRouteTemplateSource(environment.Integration.Status.GeneratedSources[1]))
+	// The dependencies are discovered by inspecting the Kamelets sources
+	assert.Contains(t, environment.Integration.Status.Dependencies, "camel:timer")
+	assert.Contains(t, environment.Integration.Status.Dependencies, "camel:kafka")
+	assert.Contains(t, environment.Integration.Status.Dependencies, "camel:kamelet")
+}
+
+func TestKameletRouteTemplateValidation(t *testing.T) {
+	testcases := []struct {
+		name     string
+		typ      string
+		fileName string
+		content  string
+		err      string
+	}{
+		{
+			name:     "missing-template",
+			typ:      v1.KameletTypeSource,
+			fileName: "Source.java",
+			content:  `routeTemplate("another").from("timer:tick").to("kamelet:sink");`,
+			err:      "kamelet missing-template sources must define a route template with id missing-template",
+		},
+		{
+			name:     "not-wired-source",
+			typ:      v1.KameletTypeSource,
+			fileName: "Source.java",
+			content:  `routeTemplate("not-wired-source").from("timer:tick").to("log:info");`,
+			err:      "kamelet not-wired-source of type source must produce to kamelet:sink",
+		},
+		{
+			name:     "not-wired-sink",
+			typ:      v1.KameletTypeSink,
+			fileName: "sink.xml",
+			content:  `<routeTemplate id="not-wired-sink"><route><from uri="timer:tick"/><to uri="log:info"/></route></routeTemplate>`,
+			err:      "kamelet not-wired-sink of type sink must consume from kamelet:source",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			trait, environment := createKameletsTestEnvironment("", &v1.Kamelet{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      tc.name,
+					Labels: map[string]string{
+						v1.KameletTypeLabel: tc.typ,
+					},
+				},
+				Spec: v1.KameletSpec{
+					KameletSpecBase: v1.KameletSpecBase{
+						Sources: []v1.SourceSpec{
+							{
+								DataSpec: v1.DataSpec{
+									Name:    tc.fileName,
+									Content: tc.content,
+								},
+								Type: v1.SourceTypeTemplate,
+							},
+						},
+					},
+				},
+			})
+			trait.Auto = ptr.To(false)
+			trait.List = tc.name
+			enabled, _, err := trait.Configure(environment)
+			require.NoError(t, err)
+			assert.True(t, enabled)
+			err = trait.Apply(environment)
+			require.Error(t, err)
+			assert.Equal(t, tc.err, err.Error())
+		})
+	}
+}
+
+func TestKameletSharedBundle(t *testing.T) {
+	trait, environment := createKameletsTestEnvironment(
+		"",
+		&v1.Kamelet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "timer",
+			},
+			Spec: v1.KameletSpec{
+				KameletSpecBase: v1.KameletSpecBase{
+					Template: templateOrFail(map[string]interface{}{
+						"from": map[string]interface{}{
+							"uri": "timer:tick",
+						},
+					}),
+				},
+			},
+		})
+	environment.Ctx = context.TODO()
+	environment.Integration.UID = "uid"
+	trait.Auto = ptr.To(false)
+	trait.List = "timer"
+	trait.SharedBundle = ptr.To(true)
+
+	enabled, condition, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Nil(t, condition)
+	err = trait.Apply(environment)
+	require.NoError(t, err)
+
+	// The shared bundle is not owned by the Integration
+	kameletsBundle := environment.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool {
+		return cm.Labels[kubernetes.ConfigMapTypeLabel] == KameletBundleType
+	})
+	assert.Nil(t, kameletsBundle)
+	require.Len(t, trait.sharedBundles, 1)
+	bundleName := trait.sharedBundles[0].Name
+	assert.Equal(t,
+		"file:/etc/camel/kamelets/"+bundleName+",classpath:/kamelets",
+		environment.ApplicationProperties[KameletLocationProperty],
+	)
+
+	require.Len(t, environment.PostActions, 1)
+	require.NoError(t, environment.PostActions[0](environment))
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, environment.Client.Get(environment.Ctx, ctrl.ObjectKey{Namespace: "test", Name: bundleName}, cm))
+	assert.Contains(t, cm.Data["timer.kamelet.yaml"], "uri: timer:tick")
+	assert.Equal(t, "it", cm.OwnerReferences[0].Name)
+
+	// The mount trait mounts the shared bundle
+	environment.ExecutedTraits = append(environment.ExecutedTraits, trait)
+	var vols []corev1.Volume
+	var mnts []corev1.VolumeMount
+	mt, _ := newMountTrait().(*mountTrait)
+	mt.configureCamelVolumesAndMounts(environment, &vols, &mnts)
+	require.Len(t, vols, 2)
+	assert.Equal(t, bundleName, vols[1].ConfigMap.Name)
+	require.Len(t, mnts, 2)
+	assert.Equal(t, "/etc/camel/kamelets/"+bundleName, mnts[1].MountPath)
+}
+
+func TestKameletSharedBundleReleasedWhenSharingDisabled(t *testing.T) {
+	trait, environment := createKameletsTestEnvironment(
+		"",
+		&v1.Kamelet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "timer",
+			},
+			Spec: v1.KameletSpec{
+				KameletSpecBase: v1.KameletSpecBase{
+					Template: templateOrFail(map[string]interface{}{
+						"from": map[string]interface{}{
+							"uri": "timer:tick",
+						},
+					}),
+				},
+			},
+		})
+	environment.Ctx = context.TODO()
+	environment.Integration.UID = "uid"
+	trait.Auto = ptr.To(false)
+	trait.List = "timer"
+	trait.SharedBundle = ptr.To(true)
+
+	enabled, _, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	require.NoError(t, trait.Apply(environment))
+	require.Len(t, environment.PostActions, 1)
+	require.NoError(t, environment.PostActions[0](environment))
+	require.Len(t, trait.sharedBundles, 1)
+	bundleName := trait.sharedBundles[0].Name
+
+	// Switching the sharing off releases the shared bundle
+	unshared, _ := newKameletsTrait().(*kameletsTrait)
+	unshared.Client = trait.Client
+	unshared.Auto = ptr.To(false)
+	unshared.List = "timer"
+	environment.PostActions = nil
+	environment.Resources = kubernetes.NewCollection()
+	enabled, _, err = unshared.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	require.NoError(t, unshared.Apply(environment))
+	require.Len(t, environment.PostActions, 1)
+	require.NoError(t, environment.PostActions[0](environment))
+	cm := &corev1.ConfigMap{}
+	err = environment.Client.Get(environment.Ctx, ctrl.ObjectKey{Namespace: "test", Name: bundleName}, cm)
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	// Nothing left to release when the trait is disabled
+	disabled, _ := newKameletsTrait().(*kameletsTrait)
+	disabled.Enabled = ptr.To(false)
+	environment.PostActions = nil
+	enabled, _, err = disabled.Configure(environment)
+	require.NoError(t, err)
+	assert.False(t, enabled)
+	require.Len(t, environment.PostActions, 1)
+	require.NoError(t, environment.PostActions[0](environment))
+}
+
 func TestKameletVersionParameter(t *testing.T) {
 	v1, err := getKameletVersion("my-kamelet?kameletVersion=v2")
 	require.NoError(t, err)
@@ -908,3 +1287,167 @@ func TestKameletMultiNamespaceMissing(t *testing.T) {
 		environment.Integration.Status.GetCondition(v1.IntegrationConditionKameletsAvailable).Message,
 		"Kamelets [missing] not found in cluster")
 }
+
+func TestKameletDeprecated(t *testing.T) {
+	flow := `
+- from:
+    uri: kamelet:timer
+    steps:
+    - to: kamelet:old-sink?kameletVersion=v1
+`
+	trait, environment := createKameletsTestEnvironment(
+		flow,
+		&v1.Kamelet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "timer",
+			},
+			Spec: v1.KameletSpec{
+				KameletSpecBase: v1.KameletSpecBase{
+					Template: templateOrFail(map[string]interface{}{
+						"from": map[string]interface{}{
+							"uri": "timer:tick",
+						},
+					}),
+				},
+			},
+		},
+		&v1.Kamelet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "old-sink",
+			},
+			Spec: v1.KameletSpec{
+				KameletSpecBase: v1.KameletSpecBase{
+					Template: templateOrFail(map[string]interface{}{
+						"from": map[string]interface{}{
+							"uri": "kamelet:source",
+						},
+					}),
+					Deprecation: &v1.KameletDeprecation{
+						Message:            "no longer maintained",
+						Replacement:        "new-sink",
+						ReplacementVersion: "v2",
+						RemovalDate:        "2027-01-31",
+					},
+				},
+				Versions: map[string]v1.KameletSpecBase{
+					"v1": {
+						Template: templateOrFail(map[string]interface{}{
+							"from": map[string]interface{}{
+								"uri": "kamelet:source",
+							},
+						}),
+					},
+				},
+			},
+		})
+
+	enabled, condition, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Nil(t, condition)
+
+	err = trait.Apply(environment)
+	require.NoError(t, err)
+	cond := environment.Integration.Status.GetCondition(v1.IntegrationConditionKameletsDeprecated)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionKameletsDeprecatedReason, cond.Reason)
+	assert.Equal(t, "Kamelet old-sink is deprecated: no longer maintained "+
+		"(use new-sink (version v2) instead, it will be removed on 2027-01-31)", cond.Message)
+}
+
+func TestKameletNotDeprecated(t *testing.T) {
+	trait, environment := createKameletsTestEnvironment(`
+- from:
+    uri: kamelet:timer
+    steps:
+    - to: log:info
+`, &v1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "timer",
+		},
+		Spec: v1.KameletSpec{
+			KameletSpecBase: v1.KameletSpecBase{
+				Template: templateOrFail(map[string]interface{}{
+					"from": map[string]interface{}{
+						"uri": "timer:tick",
+					},
+				}),
+			},
+		},
+	})
+	environment.Integration.Status.SetCondition(
+		v1.IntegrationConditionKameletsDeprecated,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionKameletsDeprecatedReason,
+		"Kamelet timer is deprecated",
+	)
+
+	enabled, _, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	err = trait.Apply(environment)
+	require.NoError(t, err)
+	assert.Nil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionKameletsDeprecated))
+}
+
+func TestKameletsRiskCapabilities(t *testing.T) {
+	trait, environment := createKameletsTestEnvironment(`
+- from:
+    uri: kamelet:command-source
+    steps:
+    - to: log:info
+`,
+		&v1.Kamelet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "command-source",
+			},
+			Spec: v1.KameletSpec{
+				KameletSpecBase: v1.KameletSpecBase{
+					Template: templateOrFail(map[string]interface{}{
+						"from": map[string]interface{}{
+							"uri": "timer:tick",
+							"steps": []interface{}{
+								map[string]interface{}{"to": "kamelet:exec-action"},
+								map[string]interface{}{"to": "kamelet:sink"},
+							},
+						},
+					}),
+				},
+			},
+		},
+		&v1.Kamelet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "exec-action",
+			},
+			Spec: v1.KameletSpec{
+				KameletSpecBase: v1.KameletSpecBase{
+					Template: templateOrFail(map[string]interface{}{
+						"from": map[string]interface{}{
+							"uri": "kamelet:source",
+							"steps": []interface{}{
+								map[string]interface{}{"to": "exec:{{executable}}"},
+								map[string]interface{}{"to": "file:/var/data/outbox"},
+								map[string]interface{}{"to": "kamelet:sink"},
+							},
+						},
+					}),
+				},
+			},
+		})
+
+	enabled, condition, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Nil(t, condition)
+	assert.Equal(t, "command-source", trait.List)
+
+	err = trait.Apply(environment)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"risk:command-execution", "risk:filesystem-access"}, environment.Integration.Status.Capabilities)
+}
@@ -53,18 +53,30 @@ func (t *kedaTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
 		return false, nil, nil
 	}
 
+	var condition *TraitCondition
 	if ptr.Deref(t.Auto, true) {
-		if err := t.autoDiscoverTriggers(e); err != nil {
+		profile, err := t.autoDiscoverTriggers(e)
+		if err != nil {
 			return false, nil, err
 		}
+		if unsupported := profile.Unsupported(); len(unsupported) > 0 {
+			condition = NewIntegrationCondition(
+				"Keda",
+				v1.IntegrationConditionTraitInfo,
+				corev1.ConditionTrue,
+				TraitConfigurationReason,
+				fmt.Sprintf("no scaler can activate the consumers [%s]: the integration should not be scaled to zero",
+					strings.Join(unsupported, ",")),
+			)
+		}
 	}
 
-	return len(t.Triggers) > 0, nil, nil
+	return len(t.Triggers) > 0, condition, nil
 }
 
 func (t *kedaTrait) Apply(e *Environment) error {
 	triggers, auths := t.populateTriggers(e.Integration.Name, e.Integration.Namespace)
-	scaleTarget := t.getScaleTarget(e.Integration)
+	scaleTarget := getScaleTarget(e.Integration)
 	scaledObject := &v1alpha1.ScaledObject{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: v1alpha1.SchemeGroupVersion.String(),
@@ -142,7 +154,7 @@ func populateTriggerAuth(secrets []*traitv1.KedaSecret, itName, itNamespace, ked
 }
 
 // getScaleTarget returns either an Integration or a Pipe, if the Integration was created by a Pipe.
-func (t *kedaTrait) getScaleTarget(it *v1.Integration) *corev1.ObjectReference {
+func getScaleTarget(it *v1.Integration) *corev1.ObjectReference {
 	for _, o := range it.OwnerReferences {
 		if o.Kind == v1.PipeKind && strings.HasPrefix(o.APIVersion, v1.SchemeGroupVersion.Group) {
 			return &corev1.ObjectReference{
@@ -161,34 +173,55 @@ func (t *kedaTrait) getScaleTarget(it *v1.Integration) *corev1.ObjectReference {
 }
 
 // autoDiscoverTriggers discovers KEDA triggers from Camel source URIs.
-func (t *kedaTrait) autoDiscoverTriggers(e *Environment) error {
+func (t *kedaTrait) autoDiscoverTriggers(e *Environment) (*kedamapper.ActivationProfile, error) {
 	// Build set of manually configured trigger types
 	manualTypes := make(map[string]bool)
 	for _, trigger := range t.Triggers {
 		manualTypes[trigger.Type] = true
 	}
 
-	meta, err := metadata.ExtractAll(e.CamelCatalog, e.Integration.AllSources())
+	profile, err := ComputeActivationProfile(e)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	for _, fromURI := range meta.FromURIs {
-		trigger, err := kedamapper.MapToKedaTrigger(fromURI)
-		if err != nil {
-			return err
-		}
+	for _, trigger := range profile.Triggers() {
 		// Only add if trigger type not already manually configured
-		if trigger != nil && !manualTypes[trigger.Type] {
+		if !manualTypes[trigger.Type] {
 			// Merge additional metadata if configured
 			if t.AutoMetadata != nil {
 				if extra, ok := t.AutoMetadata[trigger.Type]; ok {
 					maps.Copy(trigger.Metadata, extra)
 				}
 			}
-			t.Triggers = append(t.Triggers, *trigger)
+			t.Triggers = append(t.Triggers, trigger)
 		}
 	}
 
-	return nil
+	return profile, nil
+}
+
+// ComputeActivationProfile returns the activation profile of the Integration consumers, describing which KEDA scaler
+// is able to activate each of them.
+func ComputeActivationProfile(e *Environment) (*kedamapper.ActivationProfile, error) {
+	meta, err := metadata.ExtractAll(e.CamelCatalog, e.Integration.AllSources())
+	if err != nil {
+		return nil, err
+	}
+
+	return kedamapper.NewActivationProfile(meta.FromURIs), nil
+}
+
+// ActivationProfileRequired returns true when the keda trait is enabled, or its automatic discovery of the triggers
+// is explicitly requested, so that the activation profile of the Integration consumers has to be reported.
+func ActivationProfileRequired(e *Environment) bool {
+	if e.Catalog == nil {
+		return false
+	}
+	t, ok := e.Catalog.GetTrait(kedaTraitID).(*kedaTrait)
+	if !ok {
+		return false
+	}
+
+	return ptr.Deref(t.Enabled, false) || ptr.Deref(t.Auto, false)
 }
@@ -24,6 +24,8 @@ import (
 // ScaleMapper defines the interface for mapping Camel component URIs to KEDA triggers.
 type ScaleMapper interface {
 	Component() string
+	// Map returns the KEDA trigger type and metadata for the given endpoint, or an empty type
+	// when the endpoint cannot be activated by a scaler.
 	Map(pathValue string, params map[string]string) (kedaType string, metadata map[string]string)
 }
 
@@ -52,6 +54,10 @@ func MapToKedaTrigger(rawURI string) (*traitv1.KedaTrigger, error) {
 		return nil, nil
 	}
 	kedaType, metadata := mapper.Map(pathValue, params)
+	if kedaType == "" {
+		// the mapper can't activate this endpoint
+		return nil, nil
+	}
 
 	return &traitv1.KedaTrigger{
 		Type:     kedaType,
@@ -0,0 +1,113 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keda
+
+import (
+	"fmt"
+	"strings"
+
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/uri"
+)
+
+// kameletSource is the consumer used by Kamelet sources, which is activated by the Kamelet consumer itself.
+const kameletSource = "kamelet:source"
+
+// Activation describes a consumer of the integration and the KEDA trigger able to activate it, if any.
+type Activation struct {
+	// URI is the consumer endpoint URI
+	URI string
+	// Component is the Camel component of the consumer
+	Component string
+	// Trigger is the KEDA trigger matching the consumer, nil if no scaler is available
+	Trigger *traitv1.KedaTrigger
+}
+
+// ActivationProfile describes how the consumers of an integration can be activated by external events.
+type ActivationProfile struct {
+	Activations []Activation
+}
+
+// NewActivationProfile computes the activation profile of the given consumer URIs. The consumers whose URI cannot be
+// parsed are reported as not activated by any scaler.
+func NewActivationProfile(fromURIs []string) *ActivationProfile {
+	profile := ActivationProfile{}
+	for _, fromURI := range fromURIs {
+		if fromURI == kameletSource {
+			continue
+		}
+		trigger, err := MapToKedaTrigger(fromURI)
+		if err != nil {
+			trigger = nil
+		}
+		profile.Activations = append(profile.Activations, Activation{
+			URI:       fromURI,
+			Component: uri.GetComponent(fromURI),
+			Trigger:   trigger,
+		})
+	}
+
+	return &profile
+}
+
+// Eligible returns true if every consumer can be activated by a KEDA scaler, so that the integration can scale to zero.
+func (p *ActivationProfile) Eligible() bool {
+	if len(p.Activations) == 0 {
+		return false
+	}
+
+	return len(p.Unsupported()) == 0
+}
+
+// Triggers returns the KEDA triggers of the consumers that can be activated by a scaler.
+func (p *ActivationProfile) Triggers() []traitv1.KedaTrigger {
+	triggers := make([]traitv1.KedaTrigger, 0, len(p.Activations))
+	for _, a := range p.Activations {
+		if a.Trigger != nil {
+			triggers = append(triggers, *a.Trigger)
+		}
+	}
+
+	return triggers
+}
+
+// Unsupported returns the URIs of the consumers which cannot be activated by any scaler.
+func (p *ActivationProfile) Unsupported() []string {
+	var unsupported []string
+	for _, a := range p.Activations {
+		if a.Trigger == nil {
+			unsupported = append(unsupported, a.URI)
+		}
+	}
+
+	return unsupported
+}
+
+// String returns a human readable description of the profile.
+func (p *ActivationProfile) String() string {
+	items := make([]string, 0, len(p.Activations))
+	for _, a := range p.Activations {
+		scaler := "none"
+		if a.Trigger != nil {
+			scaler = a.Trigger.Type
+		}
+		items = append(items, fmt.Sprintf("%s=%s", a.Component, scaler))
+	}
+
+	return strings.Join(items, ",")
+}
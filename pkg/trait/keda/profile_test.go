@@ -0,0 +1,60 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package keda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type queueScaler struct{}
+
+func (q *queueScaler) Component() string {
+	return "test-queue"
+}
+
+func (q *queueScaler) Map(pathValue string, params map[string]string) (string, map[string]string) {
+	return "test-queue", map[string]string{"queue": pathValue}
+}
+
+func TestActivationProfile(t *testing.T) {
+	Register(&queueScaler{})
+
+	profile := NewActivationProfile([]string{"test-queue:orders", "kamelet:source"})
+	assert.True(t, profile.Eligible())
+	assert.Empty(t, profile.Unsupported())
+	require.Len(t, profile.Triggers(), 1)
+	assert.Equal(t, "test-queue", profile.Triggers()[0].Type)
+	assert.Equal(t, "test-queue=test-queue", profile.String())
+
+	profile = NewActivationProfile([]string{"test-queue:orders", "timer:tick"})
+	assert.False(t, profile.Eligible())
+	assert.Equal(t, []string{"timer:tick"}, profile.Unsupported())
+	assert.Equal(t, "test-queue=test-queue,timer=none", profile.String())
+
+	profile = NewActivationProfile([]string{"kamelet:source"})
+	assert.False(t, profile.Eligible())
+	assert.Empty(t, profile.Activations)
+
+	// The consumers whose URI cannot be parsed are not eligible
+	profile = NewActivationProfile([]string{"test-queue:orders?password=RAW(a;b)"})
+	assert.False(t, profile.Eligible())
+	assert.Equal(t, []string{"test-queue:orders?password=RAW(a;b)"}, profile.Unsupported())
+	assert.Equal(t, "test-queue=none", profile.String())
+}
@@ -0,0 +1,115 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package scalers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+const (
+	// cronTimezone is the timezone used by the Camel schedulers when none is configured.
+	cronTimezone = "Etc/UTC"
+	// cronDesiredReplicas is the number of replicas activated while the schedule is running.
+	cronDesiredReplicas = "1"
+)
+
+// CronScaler activates the integration on the schedule of a cron-like consumer.
+type CronScaler struct {
+	component string
+	param     string
+}
+
+func init() {
+	keda.Register(&CronScaler{component: "cron", param: "schedule"})
+	keda.Register(&CronScaler{component: "quartz", param: "cron"})
+}
+func (c *CronScaler) Component() string {
+	return c.component
+}
+func (c *CronScaler) Map(pathValue string, params map[string]string) (string, map[string]string) {
+	start := toCronSchedule(params[c.param])
+	if start == "" {
+		return "", nil
+	}
+	end := cronWindowEnd(start)
+	if end == "" {
+		return "", nil
+	}
+
+	return "cron", map[string]string{
+		"timezone":        cronTimezone,
+		"start":           start,
+		"end":             end,
+		"desiredReplicas": cronDesiredReplicas,
+	}
+}
+
+// toCronSchedule converts a Camel schedule (which may have seconds and year fields) to a standard 5 fields schedule,
+// or returns an empty string if it can't be converted.
+func toCronSchedule(schedule string) string {
+	parts := strings.Fields(schedule)
+	if len(parts) > 5 {
+		if parts[0] != "0" {
+			// sub minute schedules are not supported
+			return ""
+		}
+		parts = parts[1:]
+	}
+	if len(parts) == 6 && (parts[5] == "*" || parts[5] == "?") {
+		parts = parts[0:5]
+	}
+	if len(parts) != 5 {
+		return ""
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// cronWindowEnd returns a schedule ending one minute after the given one, or an empty string if the minute field
+// can't be shifted without changing the other fields.
+func cronWindowEnd(schedule string) string {
+	parts := strings.Fields(schedule)
+	minute := parts[0]
+	var shifted string
+	if first, step, ok := strings.Cut(minute, "/"); ok {
+		if first == "*" {
+			first = "0"
+		}
+		f, err := strconv.Atoi(first)
+		if err != nil {
+			return ""
+		}
+		s, err := strconv.Atoi(step)
+		if err != nil || f+1 >= s {
+			return ""
+		}
+		shifted = fmt.Sprintf("%d/%d", f+1, s)
+	} else {
+		m, err := strconv.Atoi(minute)
+		if err != nil || m >= 59 {
+			return ""
+		}
+		shifted = strconv.Itoa(m + 1)
+	}
+	parts[0] = shifted
+
+	return strings.Join(parts, " ")
+}
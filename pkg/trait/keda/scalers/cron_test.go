@@ -0,0 +1,100 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package scalers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+func TestCronScalerRegistered(t *testing.T) {
+	mapper, found := keda.GetMapper("cron")
+	require.True(t, found, "cron scaler should be registered")
+	assert.Equal(t, "cron", mapper.Component())
+}
+
+func TestCronScalerMap(t *testing.T) {
+	scaler := &CronScaler{component: "cron", param: "schedule"}
+
+	tests := []struct {
+		name         string
+		pathValue    string
+		params       map[string]string
+		expectedType string
+		expectedMeta map[string]string
+	}{
+		{
+			name:         "fixed minute",
+			pathValue:    "tab",
+			params:       map[string]string{"schedule": "0 15 2 * * ?"},
+			expectedType: "cron",
+			expectedMeta: map[string]string{
+				"timezone":        "Etc/UTC",
+				"start":           "15 2 * * ?",
+				"end":             "16 2 * * ?",
+				"desiredReplicas": "1",
+			},
+		},
+		{
+			name:         "minute step",
+			pathValue:    "tab",
+			params:       map[string]string{"schedule": "*/5 * * * *"},
+			expectedType: "cron",
+			expectedMeta: map[string]string{
+				"timezone":        "Etc/UTC",
+				"start":           "*/5 * * * *",
+				"end":             "1/5 * * * *",
+				"desiredReplicas": "1",
+			},
+		},
+		{
+			name:         "every minute",
+			pathValue:    "tab",
+			params:       map[string]string{"schedule": "* * * * *"},
+			expectedType: "",
+			expectedMeta: nil,
+		},
+		{
+			name:         "sub minute",
+			pathValue:    "tab",
+			params:       map[string]string{"schedule": "0/10 * * * * ?"},
+			expectedType: "",
+			expectedMeta: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kedaType, metadata := scaler.Map(tt.pathValue, tt.params)
+			assert.Equal(t, tt.expectedType, kedaType)
+			assert.Equal(t, tt.expectedMeta, metadata)
+		})
+	}
+}
+
+func TestQuartzScalerRegistered(t *testing.T) {
+	trigger, err := keda.MapToKedaTrigger("quartz:my/timer?cron=0+0+3+*+*+?")
+	require.NoError(t, err)
+	require.NotNil(t, trigger)
+	assert.Equal(t, "cron", trigger.Type)
+	assert.Equal(t, "0 3 * * ?", trigger.Metadata["start"])
+	assert.Equal(t, "1 3 * * ?", trigger.Metadata["end"])
+}
@@ -0,0 +1,45 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package scalers
+
+import (
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+type NatsJetStreamScaler struct{}
+
+func init() {
+	keda.Register(&NatsJetStreamScaler{})
+}
+func (n *NatsJetStreamScaler) Component() string {
+	return "nats"
+}
+func (n *NatsJetStreamScaler) Map(pathValue string, params map[string]string) (string, map[string]string) {
+	if params["jetstreamEnabled"] != "true" {
+		// core NATS subscriptions are not persisted, there is nothing to measure
+		return "", nil
+	}
+	metadata := make(map[string]string)
+	if v, ok := params["jetstreamName"]; ok {
+		metadata["stream"] = v
+	}
+	if v, ok := params["durableName"]; ok {
+		metadata["consumer"] = v
+	}
+
+	return "nats-jetstream", metadata
+}
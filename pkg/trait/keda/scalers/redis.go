@@ -0,0 +1,57 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"strings"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+// redisStreamLength is the number of entries of the stream above which a replica is added.
+const redisStreamLength = "5"
+
+type RedisStreamsScaler struct{}
+
+func init() {
+	keda.Register(&RedisStreamsScaler{})
+}
+func (r *RedisStreamsScaler) Component() string {
+	return "spring-redis"
+}
+func (r *RedisStreamsScaler) Map(pathValue string, params map[string]string) (string, map[string]string) {
+	if command := params["command"]; command != "" && !strings.EqualFold(command, "SUBSCRIBE") {
+		// the patterns of PSUBSCRIBE do not denote a single stream
+		return "", nil
+	}
+	channels := params["channels"]
+	if channels == "" {
+		return "", nil
+	}
+	// the scaler watches a single stream, named after the first channel
+	stream, _, _ := strings.Cut(channels, ",")
+	metadata := map[string]string{
+		"stream":       stream,
+		"streamLength": redisStreamLength,
+	}
+	if address := strings.TrimPrefix(pathValue, "//"); address != "" {
+		metadata["address"] = address
+	}
+
+	return "redis-streams", metadata
+}
@@ -0,0 +1,89 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+func TestRedisStreamsScalerRegistered(t *testing.T) {
+	mapper, found := keda.GetMapper("spring-redis")
+	require.True(t, found, "spring-redis scaler should be registered")
+	assert.Equal(t, "spring-redis", mapper.Component())
+}
+
+func TestRedisStreamsScalerMap(t *testing.T) {
+	scaler := &RedisStreamsScaler{}
+
+	tests := []struct {
+		name         string
+		pathValue    string
+		params       map[string]string
+		expectedType string
+		expectedMeta map[string]string
+	}{
+		{
+			name:         "subscription",
+			pathValue:    "redis:6379",
+			params:       map[string]string{"command": "SUBSCRIBE", "channels": "orders,returns"},
+			expectedType: "redis-streams",
+			expectedMeta: map[string]string{
+				"address":      "redis:6379",
+				"stream":       "orders",
+				"streamLength": "5",
+			},
+		},
+		{
+			name:         "default command",
+			pathValue:    "",
+			params:       map[string]string{"channels": "orders"},
+			expectedType: "redis-streams",
+			expectedMeta: map[string]string{
+				"stream":       "orders",
+				"streamLength": "5",
+			},
+		},
+		{
+			name:         "pattern subscription",
+			pathValue:    "redis:6379",
+			params:       map[string]string{"command": "PSUBSCRIBE", "channels": "orders.*"},
+			expectedType: "",
+			expectedMeta: nil,
+		},
+		{
+			name:         "no channel",
+			pathValue:    "redis:6379",
+			params:       map[string]string{},
+			expectedType: "",
+			expectedMeta: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kedaType, metadata := scaler.Map(tt.pathValue, tt.params)
+			assert.Equal(t, tt.expectedType, kedaType)
+			assert.Equal(t, tt.expectedMeta, metadata)
+		})
+	}
+}
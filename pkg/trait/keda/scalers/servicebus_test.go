@@ -0,0 +1,73 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package scalers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+func TestServiceBusScalerRegistered(t *testing.T) {
+	mapper, found := keda.GetMapper("azure-servicebus")
+	require.True(t, found, "azure-servicebus scaler should be registered")
+	assert.Equal(t, "azure-servicebus", mapper.Component())
+}
+
+func TestServiceBusScalerMap(t *testing.T) {
+	scaler := &ServiceBusScaler{}
+
+	tests := []struct {
+		name         string
+		pathValue    string
+		params       map[string]string
+		expectedType string
+		expectedMeta map[string]string
+	}{
+		{
+			name:         "queue",
+			pathValue:    "orders",
+			params:       map[string]string{"fullyQualifiedNamespace": "my-ns.servicebus.windows.net"},
+			expectedType: "azure-servicebus",
+			expectedMeta: map[string]string{
+				"queueName": "orders",
+				"namespace": "my-ns",
+			},
+		},
+		{
+			name:         "topic subscription",
+			pathValue:    "events",
+			params:       map[string]string{"serviceBusType": "topic", "subscriptionName": "sub"},
+			expectedType: "azure-servicebus",
+			expectedMeta: map[string]string{
+				"topicName":        "events",
+				"subscriptionName": "sub",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kedaType, metadata := scaler.Map(tt.pathValue, tt.params)
+			assert.Equal(t, tt.expectedType, kedaType)
+			assert.Equal(t, tt.expectedMeta, metadata)
+		})
+	}
+}
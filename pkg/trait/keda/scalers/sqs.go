@@ -0,0 +1,55 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package scalers
+
+import (
+	"fmt"
+	"strings"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+type SQSScaler struct{}
+
+func init() {
+	keda.Register(&SQSScaler{})
+}
+func (s *SQSScaler) Component() string {
+	return "aws2-sqs"
+}
+func (s *SQSScaler) Map(pathValue string, params map[string]string) (string, map[string]string) {
+	metadata := make(map[string]string)
+	if v, ok := params["region"]; ok {
+		metadata["awsRegion"] = v
+	}
+	switch {
+	case params["queueUrl"] != "":
+		metadata["queueURL"] = params["queueUrl"]
+	case strings.HasPrefix(pathValue, "arn:"):
+		// arn:aws:sqs:region:account:name
+		parts := strings.Split(pathValue, ":")
+		if len(parts) != 6 {
+			return "", nil
+		}
+		metadata["awsRegion"] = parts[3]
+		metadata["queueURL"] = fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", parts[3], parts[4], parts[5])
+	case pathValue != "":
+		metadata["queueURL"] = pathValue
+	}
+
+	return "aws-sqs-queue", metadata
+}
@@ -0,0 +1,90 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package scalers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keda "github.com/apache/camel-k/v2/pkg/trait/keda"
+)
+
+func TestSQSScalerRegistered(t *testing.T) {
+	mapper, found := keda.GetMapper("aws2-sqs")
+	require.True(t, found, "aws2-sqs scaler should be registered")
+	assert.Equal(t, "aws2-sqs", mapper.Component())
+}
+
+func TestSQSScalerMap(t *testing.T) {
+	scaler := &SQSScaler{}
+
+	tests := []struct {
+		name         string
+		pathValue    string
+		params       map[string]string
+		expectedType string
+		expectedMeta map[string]string
+	}{
+		{
+			name:         "queue name",
+			pathValue:    "orders",
+			params:       map[string]string{"region": "us-east-1"},
+			expectedType: "aws-sqs-queue",
+			expectedMeta: map[string]string{
+				"queueURL":  "orders",
+				"awsRegion": "us-east-1",
+			},
+		},
+		{
+			name:         "queue arn",
+			pathValue:    "arn:aws:sqs:eu-west-1:123456789012:orders",
+			params:       map[string]string{},
+			expectedType: "aws-sqs-queue",
+			expectedMeta: map[string]string{
+				"queueURL":  "https://sqs.eu-west-1.amazonaws.com/123456789012/orders",
+				"awsRegion": "eu-west-1",
+			},
+		},
+		{
+			name:         "queue url",
+			pathValue:    "orders",
+			params:       map[string]string{"queueUrl": "https://sqs.us-east-1.amazonaws.com/1/orders", "region": "us-east-1"},
+			expectedType: "aws-sqs-queue",
+			expectedMeta: map[string]string{
+				"queueURL":  "https://sqs.us-east-1.amazonaws.com/1/orders",
+				"awsRegion": "us-east-1",
+			},
+		},
+		{
+			name:         "malformed arn",
+			pathValue:    "arn:aws:sqs:orders",
+			params:       map[string]string{},
+			expectedType: "",
+			expectedMeta: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kedaType, metadata := scaler.Map(tt.pathValue, tt.params)
+			assert.Equal(t, tt.expectedType, kedaType)
+			assert.Equal(t, tt.expectedMeta, metadata)
+		})
+	}
+}
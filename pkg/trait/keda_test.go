@@ -144,6 +144,30 @@ func TestKedaAutoDiscovery(t *testing.T) {
 			},
 			expectedCount: 1,
 		},
+		{
+			name:         "aws-sqs",
+			source:       `from("aws2-sqs:arn:aws:sqs:eu-west-1:123456789012:orders").log("${body}");`,
+			expectedType: "aws-sqs-queue",
+			expectedParams: map[string]string{
+				"queueURL":  "https://sqs.eu-west-1.amazonaws.com/123456789012/orders",
+				"awsRegion": "eu-west-1",
+			},
+			expectedCount: 1,
+		},
+		{
+			name:         "rabbitmq",
+			source:       `from("spring-rabbitmq:orders?queues=new-orders").log("${body}");`,
+			expectedType: "rabbitmq",
+			expectedParams: map[string]string{
+				"queueName": "new-orders",
+			},
+			autoMetadata: map[string]map[string]string{
+				"rabbitmq": {
+					"hostFromEnv": "RABBITMQ_HOST",
+				},
+			},
+			expectedCount: 1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -176,6 +200,27 @@ func TestKedaAutoDiscovery(t *testing.T) {
 	}
 }
 
+func TestKedaAutoDiscoveryUnsupportedConsumer(t *testing.T) {
+	environment := autoDiscoveryEnvWithSource(t,
+		`from("kafka:my-topic?brokers=my-broker:9092").log("${body}"); from("timer:tick").log("${body}");`, nil, nil)
+	traitCatalog := environment.Catalog
+
+	conditions, _, err := traitCatalog.apply(&environment)
+
+	require.NoError(t, err)
+	scaledObject := getKedaScaledObject(environment.Resources)
+	require.NotNil(t, scaledObject)
+	require.Len(t, scaledObject.Spec.Triggers, 1)
+	assert.Equal(t, "kafka", scaledObject.Spec.Triggers[0].Type)
+	assert.Contains(t, conditions, NewIntegrationCondition(
+		"Keda",
+		v1.IntegrationConditionTraitInfo,
+		corev1.ConditionTrue,
+		TraitConfigurationReason,
+		"no scaler can activate the consumers [timer:tick]: the integration should not be scaled to zero",
+	))
+}
+
 func TestKedaAuthentication(t *testing.T) {
 	environment := nominalEnv(t)
 	environment.Integration.Spec.Traits.Keda.Triggers[0].Secrets = []*traitv1.KedaSecret{
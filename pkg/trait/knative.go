@@ -45,6 +45,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/metadata"
 	"github.com/apache/camel-k/v2/pkg/util"
 	knativeutil "github.com/apache/camel-k/v2/pkg/util/knative"
+	"github.com/apache/camel-k/v2/pkg/util/source"
 )
 
 const (
@@ -84,7 +85,18 @@ func (t *knativeTrait) Configure(e *Environment) (bool, *TraitCondition, error)
 		return false, nil, nil
 	}
 
-	_, err := e.ConsumeMeta(false, func(meta metadata.IntegrationMetadata) bool {
+	var deadLetterURIs []string
+	_, err := e.ConsumeRoutes(false, func(routes *source.RouteModel) bool {
+		deadLetterURIs = routes.DeadLetterURIs()
+
+		return true
+	})
+	if err != nil {
+		return false, nil, err
+	}
+	_, err = e.ConsumeMeta(false, func(meta metadata.IntegrationMetadata) bool {
+		// The dead letter channels of the error handlers are sinks as well
+		meta.ToURIs = append(meta.ToURIs, deadLetterURIs...)
 		if len(t.ChannelSources) == 0 {
 			t.ChannelSources = filterMetaItems(meta, knativeapi.CamelServiceTypeChannel, "from")
 		}
@@ -530,7 +542,7 @@ func (t *knativeTrait) createTrigger(e *Environment, ref *corev1.ObjectReference
 		if err != nil {
 			return err
 		}
-	case ControllerStrategyDeployment:
+	case ControllerStrategyDeployment, ControllerStrategyStatefulSet:
 		trigger, err = knativeutil.CreateServiceTrigger(*ref, e.Integration.Name, eventType, path, attributes)
 		if err != nil {
 			return err
@@ -1661,3 +1661,50 @@ func TestKnativeAutoConfiguration(t *testing.T) {
 	expectedTrait.ChannelSources = []string{"knative:channel/test"}
 	assert.Equal(t, expectedTrait, trait)
 }
+
+func TestKnativeDeadLetterChannelSink(t *testing.T) {
+	client, _ := internal.NewFakeClient()
+	catalog, _ := camel.QuarkusCatalog()
+	environment := &Environment{
+		CamelCatalog: catalog,
+		Catalog:      NewCatalog(nil),
+		Client:       client,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "integration-name",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseInitialization,
+			},
+		},
+		Platform:              pl,
+		Resources:             kubernetes.NewCollection(),
+		ApplicationProperties: make(map[string]string),
+	}
+
+	trait, _ := newKnativeTrait().(*knativeTrait)
+	environment.Integration.Spec.Sources = []v1.SourceSpec{
+		{
+			DataSpec: v1.DataSpec{
+				Name: "test.yaml",
+				Content: `
+- errorHandler:
+    deadLetterChannel:
+      deadLetterUri: knative:channel/errors
+- route:
+    from:
+      uri: knative:channel/test
+      steps:
+        - to: log:info
+`,
+			},
+			Language: v1.LanguageYaml,
+		},
+	}
+
+	configured, _, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configured)
+	assert.Equal(t, []string{"knative:channel/test"}, trait.ChannelSources)
+	assert.Equal(t, []string{"knative:channel/errors"}, trait.ChannelSinks)
+}
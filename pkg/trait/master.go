@@ -122,9 +122,7 @@ func (t *masterTrait) Apply(e *Environment) error {
 	if e.IntegrationInPhase(v1.IntegrationPhaseInitialization) {
 		util.StringSliceUniqueAdd(&e.Integration.Status.Capabilities, v1.CapabilityMaster)
 		// Master sub endpoints need to be added to the list of dependencies
-		for _, dep := range t.delegateDependencies {
-			util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, dep)
-		}
+		e.addDependencies(v1.DependencyOriginTrait, string(t.ID()), t.delegateDependencies...)
 	} else if e.IntegrationInRunningPhases() {
 		// Master trait requires the ServiceAccount certain privileges
 		privileges, err := t.prepareRBAC(e.Client, e.Integration.Spec.ServiceAccountName, e.Integration.Name, e.Integration.Namespace)
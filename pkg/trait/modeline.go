@@ -0,0 +1,159 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/modeline"
+)
+
+// ModelineEnabled returns true when the operator must honour the modeline options declared in the Integration sources.
+func ModelineEnabled(it *v1.Integration) bool {
+	return it != nil && it.Annotations[v1.IntegrationModelineAnnotation] == "true"
+}
+
+// ResolveModeline parses the modeline options declared in the Integration sources and validates them against the
+// trait catalog, including the registered external traits. It returns the options which can be applied by the operator, along with a "source:line: error"
+// diagnostic for each of the invalid ones.
+func ResolveModeline(ctx context.Context, c client.Client, it *v1.Integration) ([]v1.ModelineOption, []string, error) {
+	sources, err := resolveIntegrationSources(ctx, c, it, true, kubernetes.NewCollection())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	catalog := NewCatalog(c)
+	if err := catalog.loadExternalTraits(&Environment{Ctx: ctx, Client: c, Integration: it}); err != nil {
+		return nil, nil, err
+	}
+
+	var options []v1.ModelineOption
+	var diagnostics []string
+	for _, src := range sources {
+		located, err := modeline.ParseLocated(src.Name, src.Content)
+		if err != nil {
+			// not a language supporting modelines
+			continue
+		}
+		for _, o := range located {
+			opt := modeline.Normalize(o.Option)
+			if err := validateModelineOption(catalog, opt); err != nil {
+				diagnostics = append(diagnostics, fmt.Sprintf("%s: %s", o.Location(), err.Error()))
+
+				continue
+			}
+			if opt.Name == modeline.OptionLanguage {
+				continue
+			}
+			options = append(options, v1.ModelineOption{
+				Name:   opt.Name,
+				Value:  opt.Value,
+				Source: o.Source,
+				Line:   o.Line,
+			})
+		}
+	}
+
+	return options, diagnostics, nil
+}
+
+func validateModelineOption(catalog *Catalog, o modeline.Option) error {
+	switch o.Name {
+	case modeline.OptionLanguage:
+		return nil
+	case modeline.OptionDependency:
+		if o.Value == "" {
+			return fmt.Errorf("option %q requires a value", o.Name)
+		}
+
+		return nil
+	case modeline.OptionProfile:
+		if modelineProfile(o.Value) == "" {
+			return fmt.Errorf("unknown profile %q, expected one of %v", o.Value, v1.AllTraitProfiles)
+		}
+
+		return nil
+	}
+
+	option, err := modeline.ToTraitOption(o)
+	if err != nil {
+		return err
+	}
+	if err := ValidateTraitOption(catalog, option); err != nil {
+		return fmt.Errorf("invalid trait option %q: %w", option, err)
+	}
+
+	return nil
+}
+
+func modelineProfile(name string) v1.TraitProfile {
+	for _, p := range v1.AllTraitProfiles {
+		if p.Equal(v1.TraitProfile(name)) {
+			return p
+		}
+	}
+
+	return ""
+}
+
+// modelineOptions returns the values of the given modeline option recorded in the Integration status.
+func modelineOptions(it *v1.Integration, name string) []string {
+	if !ModelineEnabled(it) {
+		return nil
+	}
+	var values []string
+	for _, o := range it.Status.Modeline {
+		if o.Name == name {
+			values = append(values, o.Value)
+		}
+	}
+
+	return values
+}
+
+// ModelineProfile returns the trait profile declared by a modeline option of the Integration, if any.
+func ModelineProfile(it *v1.Integration) v1.TraitProfile {
+	profiles := modelineOptions(it, modeline.OptionProfile)
+	if len(profiles) == 0 {
+		return ""
+	}
+
+	return modelineProfile(profiles[len(profiles)-1])
+}
+
+// modelineTraitOptions returns the trait options standing for the modeline options recorded in the Integration status.
+func modelineTraitOptions(it *v1.Integration) []string {
+	if !ModelineEnabled(it) {
+		return nil
+	}
+	var options []string
+	for _, o := range it.Status.Modeline {
+		if o.Name == modeline.OptionDependency || o.Name == modeline.OptionProfile {
+			continue
+		}
+		if option, err := modeline.ToTraitOption(modeline.Option{Name: o.Name, Value: o.Value}); err == nil {
+			options = append(options, option)
+		}
+	}
+
+	return options
+}
@@ -18,6 +18,7 @@ limitations under the License.
 package trait
 
 import (
+	"errors"
 	"fmt"
 	"path"
 	"path/filepath"
@@ -87,6 +88,7 @@ func (t *mountTrait) Apply(e *Environment) error {
 
 	var volumes *[]corev1.Volume
 	var initContainers *[]corev1.Container
+	var claimTemplates *[]corev1.PersistentVolumeClaim
 	visited := false
 
 	// Deployment
@@ -100,6 +102,18 @@ func (t *mountTrait) Apply(e *Environment) error {
 		return err
 	}
 
+	// StatefulSet
+	if err := e.Resources.VisitStatefulSetE(func(statefulSet *appsv1.StatefulSet) error {
+		volumes = &statefulSet.Spec.Template.Spec.Volumes
+		initContainers = &statefulSet.Spec.Template.Spec.InitContainers
+		claimTemplates = &statefulSet.Spec.VolumeClaimTemplates
+		visited = true
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
 	// Knative Service
 	if err := e.Resources.VisitKnativeServiceE(func(service *serving.Service) error {
 		volumes = &service.Spec.Template.Spec.Volumes
@@ -122,6 +136,26 @@ func (t *mountTrait) Apply(e *Environment) error {
 		return err
 	}
 
+	// Job
+	if err := e.Resources.VisitJobE(func(job *batchv1.Job) error {
+		volumes = &job.Spec.Template.Spec.Volumes
+		initContainers = &job.Spec.Template.Spec.InitContainers
+		visited = true
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if len(t.VolumeClaimTemplates) > 0 {
+		if claimTemplates == nil {
+			return errors.New("volume claim templates are only supported with the statefulset controller strategy")
+		}
+		if err := t.configureVolumeClaimTemplates(claimTemplates, &container.VolumeMounts, initContainers); err != nil {
+			return err
+		}
+	}
+
 	if visited {
 		// Volumes declared in the trait config/resource options
 		// as this func influences the application.properties
@@ -144,6 +178,27 @@ func (t *mountTrait) Apply(e *Environment) error {
 	return nil
 }
 
+// configureVolumeClaimTemplates adds the Persistent Volume Claim templates and their mounts to a StatefulSet.
+func (t *mountTrait) configureVolumeClaimTemplates(
+	templates *[]corev1.PersistentVolumeClaim,
+	mnts *[]corev1.VolumeMount,
+	icnts *[]corev1.Container,
+) error {
+	for _, v := range t.VolumeClaimTemplates {
+		pvc, volumeMount, parseErr := ParseVolumeClaimTemplate(v)
+		if parseErr != nil {
+			return parseErr
+		}
+		*templates = append(*templates, *pvc)
+		*mnts = append(*mnts, *volumeMount)
+		for i := range *icnts {
+			(*icnts)[i].VolumeMounts = append((*icnts)[i].VolumeMounts, *volumeMount)
+		}
+	}
+
+	return nil
+}
+
 // configureVolumesAndMounts is in charge to mount volumes and mounts coming from the trait configuration.
 // icnts holds the InitContainers which also require to be mounted with the shared volumes.
 func (t *mountTrait) configureVolumesAndMounts(
@@ -249,8 +304,8 @@ func (t *mountTrait) configureCamelVolumesAndMounts(e *Environment, vols *[]core
 	// Configmap index (may differ as generated sources can have a different name)
 	cmx := 0
 	for _, s := range e.Integration.AllSources() {
-		// We don't process routes embedded (native) or Kamelets
-		if e.isEmbedded(s) || s.IsGeneratedFromKamelet() {
+		// We don't process routes embedded (native) or Kamelets (unless loaded as routes)
+		if e.isEmbedded(s) || (s.IsGeneratedFromKamelet() && !isKameletRouteTemplateSource(s)) {
 			continue
 		}
 		// Routes are copied under /etc/camel/sources and discovered by the runtime accordingly
@@ -302,6 +357,13 @@ func (t *mountTrait) configureCamelVolumesAndMounts(e *Environment, vols *[]core
 				} else {
 					log.WithValues("Function", "trait.configureVolumesAndMounts").Infof("Warning: could not determine camel properties type %s", propertiesType)
 				}
+			case OpenAPIType:
+				// OpenAPI document served by the Integration
+				vol := getVolume(OpenAPIType, "configmap", configMap.Name, "", "")
+				mnt := getMount(OpenAPIType, openAPIMountPath, "", true)
+
+				*vols = append(*vols, *vol)
+				*mnts = append(*mnts, *mnt)
 			case KameletBundleType:
 				// Kamelets bundle configmap
 				kameletMountPoint := configMap.Annotations[kameletMountPointAnnotation]
@@ -314,6 +376,16 @@ func (t *mountTrait) configureCamelVolumesAndMounts(e *Environment, vols *[]core
 			}
 		})
 	}
+	// Kamelets bundles shared with other Integrations
+	if kt, ok := e.GetTrait(kameletsTraitID).(*kameletsTrait); ok {
+		for _, cm := range kt.sharedBundles {
+			vol := getVolume(cm.Name, "configmap", cm.Name, "", "")
+			mnt := getMount(cm.Name, filepath.Join(kt.getMountPoint(), cm.Name), "", true)
+
+			*vols = append(*vols, *vol)
+			*mnts = append(*mnts, *mnt)
+		}
+	}
 }
 
 // mountResource add the resource to volumes and mounts and return the final path where the resource is mounted.
@@ -386,8 +458,8 @@ func (t *mountTrait) addSourcesProperties(e *Environment) {
 	if e.CamelCatalog.GetRuntimeProvider() == v1.RuntimeProviderPlainQuarkus {
 		sourceLocationEnabled := false
 		for _, s := range e.Integration.AllSources() {
-			// We don't process routes embedded (native) or Kamelets
-			if e.isEmbedded(s) || s.IsGeneratedFromKamelet() {
+			// We don't process routes embedded (native) or Kamelets (unless loaded as routes)
+			if e.isEmbedded(s) || (s.IsGeneratedFromKamelet() && !isKameletRouteTemplateSource(s)) {
 				continue
 			}
 			sourceLocationEnabled = true
@@ -401,8 +473,8 @@ func (t *mountTrait) addSourcesProperties(e *Environment) {
 	} else {
 		idx := 0
 		for _, s := range e.Integration.AllSources() {
-			// We don't process routes embedded (native) or Kamelets
-			if e.isEmbedded(s) || s.IsGeneratedFromKamelet() {
+			// We don't process routes embedded (native) or Kamelets (unless loaded as routes)
+			if e.isEmbedded(s) || (s.IsGeneratedFromKamelet() && !isKameletRouteTemplateSource(s)) {
 				continue
 			}
 			srcName := strings.TrimPrefix(filepath.ToSlash(s.Name), "/")
@@ -26,6 +26,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ParseEmptyDirVolume will parse and return an empty-dir volume.
@@ -98,6 +99,43 @@ func ParseAndCreateVolume(e *Environment, item string) (*corev1.Volume, *corev1.
 	return volume, volumeMount, nil
 }
 
+// ParseVolumeClaimTemplate will parse and return a Persistent Volume Claim template, to be provisioned for each replica of a StatefulSet.
+// item is expected to be as: name:path/to/mount:size<:accessMode<:storageClassName>>.
+func ParseVolumeClaimTemplate(item string) (*corev1.PersistentVolumeClaim, *corev1.VolumeMount, error) {
+	volumeParts := strings.Split(item, ":")
+	if len(volumeParts) < 3 || len(volumeParts) > 5 {
+		return nil, nil, fmt.Errorf(
+			"volume claim template syntax error, must be name:path/to/mount:size<:accessMode<:storageClassName>> was %s",
+			item,
+		)
+	}
+
+	refName := kubernetes.SanitizeLabel(volumeParts[0])
+	sizeQty, err := resource.ParseQuantity(volumeParts[2])
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not parse size %s, %s", volumeParts[2], err.Error())
+	}
+	accessMode := corev1.ReadWriteOnce
+	if len(volumeParts) > 3 && volumeParts[3] != "" {
+		accessMode = corev1.PersistentVolumeAccessMode(volumeParts[3])
+	}
+
+	// The namespace is set by the StatefulSet controller when provisioning the claims
+	pvc := kubernetes.NewPersistentVolumeClaim("", refName, "", sizeQty, accessMode)
+	if len(volumeParts) == 5 {
+		pvc.Spec.StorageClassName = &volumeParts[4]
+	} else {
+		// Let the cluster use its default storage class
+		pvc.Spec.StorageClassName = nil
+	}
+	// Templates are embedded in the StatefulSet
+	pvc.TypeMeta = metav1.TypeMeta{}
+
+	volumeMount := getMount(refName, volumeParts[1], "", false)
+
+	return pvc, volumeMount, nil
+}
+
 // createPVC is in charge to create a PersistentVolumeClaim based on the configuration provided. Or it fail within the intent.
 // volumeParts is expected to be as: name, path/to/mount, size, accessMode, <storageClassName>.
 func createPVC(e *Environment, volumeParts []string) error {
@@ -0,0 +1,661 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/metadata"
+	"github.com/apache/camel-k/v2/pkg/util/source"
+)
+
+const (
+	networkPolicyTraitID = "network-policy"
+
+	dnsPort                = int32(53)
+	defaultKafkaBrokerPort = int32(9092)
+	kafkaBrokersProperty   = "camel.component.kafka.brokers"
+	namespaceNameLabel     = "kubernetes.io/metadata.name"
+	clusterDomainSuffix    = ".cluster.local"
+	serviceDomainComponent = "svc"
+)
+
+// The ports of the Knative queue-proxy container, receiving the traffic and the metrics scrapes of a Knative Service.
+var knativeQueueProxyPorts = []int32{8012, 8013, 8022, 9090, 9091}
+
+type networkPolicyTrait struct {
+	BaseTrait
+	traitv1.NetworkPolicyTrait `property:",squash"`
+}
+
+// endpoint is a host, and optionally a port, reached by the Integration.
+type endpoint struct {
+	host string
+	port int32
+}
+
+func newNetworkPolicyTrait() Trait {
+	return &networkPolicyTrait{
+		BaseTrait: NewBaseTrait(networkPolicyTraitID, TraitOrderPostProcessResources),
+	}
+}
+
+func (t *networkPolicyTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !ptr.Deref(t.Enabled, false) || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+
+	for _, p := range slices.Concat(t.IngressPorts, t.EgressPorts) {
+		if _, err := parseNetworkPolicyPort(p); err != nil {
+			return false, nil, err
+		}
+	}
+	for _, cidr := range slices.Concat(t.IngressCIDRs, t.EgressCIDRs) {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return false, nil, fmt.Errorf("invalid network-policy trait CIDR %q: %w", cidr, err)
+		}
+	}
+
+	return true, nil, nil
+}
+
+func (t *networkPolicyTrait) Apply(e *Environment) error {
+	ingress, ingressReport, err := t.ingressRules(e)
+	if err != nil {
+		return err
+	}
+	egress, egressReport, err := t.egressRules(e)
+	if err != nil {
+		return err
+	}
+	report := fmt.Sprintf("ingress: [%s], egress: [%s]", strings.Join(ingressReport, ", "), strings.Join(egressReport, ", "))
+
+	if ptr.Deref(t.Audit, false) {
+		e.Integration.Status.SetCondition(
+			v1.IntegrationConditionNetworkPolicyAvailable,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionNetworkPolicyAuditReason,
+			"audit mode, any traffic but the following one would be blocked: "+report,
+		)
+
+		return nil
+	}
+
+	policy := networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "NetworkPolicy",
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel: e.Integration.Name,
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			Ingress:     ingress,
+			Egress:      egress,
+		},
+	}
+	e.Resources.Add(&policy)
+
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionNetworkPolicyAvailable,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionNetworkPolicyAvailableReason,
+		fmt.Sprintf("NetworkPolicy (%s) allows %s", policy.Name, report),
+	)
+
+	return nil
+}
+
+// ingressRules allows the traffic from the pods of the cluster, and from the configured CIDRs, to the ports
+// exposed by the Integration container.
+func (t *networkPolicyTrait) ingressRules(e *Environment) ([]networkingv1.NetworkPolicyIngressRule, []string, error) {
+	ports := make([]networkingv1.NetworkPolicyPort, 0)
+	if container := e.GetIntegrationContainer(); container != nil {
+		for _, p := range container.Ports {
+			ports = appendNetworkPolicyPort(ports, intstr.FromInt32(p.ContainerPort), p.Protocol)
+		}
+		for _, probe := range []*corev1.Probe{container.LivenessProbe, container.ReadinessProbe, container.StartupProbe} {
+			if probe != nil && probe.HTTPGet != nil {
+				ports = appendNetworkPolicyPort(ports, probe.HTTPGet.Port, corev1.ProtocolTCP)
+			}
+		}
+	}
+	if !e.Integration.IsSynthetic() {
+		strategy, err := e.DetermineControllerStrategy()
+		if err != nil {
+			return nil, nil, err
+		}
+		if strategy == ControllerStrategyKnativeService {
+			for _, p := range knativeQueueProxyPorts {
+				ports = appendNetworkPolicyPort(ports, intstr.FromInt32(p), corev1.ProtocolTCP)
+			}
+		}
+	}
+	for _, p := range t.IngressPorts {
+		port, err := parseNetworkPolicyPort(p)
+		if err != nil {
+			return nil, nil, err
+		}
+		ports = appendNetworkPolicyPort(ports, *port.Port, *port.Protocol)
+	}
+	if len(ports) == 0 {
+		return []networkingv1.NetworkPolicyIngressRule{}, []string{}, nil
+	}
+
+	rules := []networkingv1.NetworkPolicyIngressRule{
+		{
+			From: []networkingv1.NetworkPolicyPeer{
+				{NamespaceSelector: &metav1.LabelSelector{}},
+			},
+			Ports: ports,
+		},
+	}
+	report := []string{fmt.Sprintf("%s from the cluster", formatNetworkPolicyPorts(ports))}
+	if len(t.IngressCIDRs) > 0 {
+		rules = append(rules, networkingv1.NetworkPolicyIngressRule{
+			From:  ipBlockPeers(t.IngressCIDRs),
+			Ports: ports,
+		})
+		report = append(report, fmt.Sprintf("%s from %s", formatNetworkPolicyPorts(ports), strings.Join(t.IngressCIDRs, ",")))
+	}
+
+	return rules, report, nil
+}
+
+// egressRules allows the traffic to the endpoints of the Integration routes, to the DNS, and to the configured
+// ports and CIDRs.
+func (t *networkPolicyTrait) egressRules(e *Environment) ([]networkingv1.NetworkPolicyEgressRule, []string, error) {
+	rules := make([]networkingv1.NetworkPolicyEgressRule, 0)
+	report := make([]string, 0)
+	if ptr.Deref(t.DNS, true) {
+		rules = append(rules, networkingv1.NetworkPolicyEgressRule{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Port: ptr.To(intstr.FromInt32(dnsPort)), Protocol: ptr.To(corev1.ProtocolUDP)},
+				{Port: ptr.To(intstr.FromInt32(dnsPort)), Protocol: ptr.To(corev1.ProtocolTCP)},
+			},
+		})
+		report = append(report, "DNS")
+	}
+
+	endpoints, unresolved, err := t.endpoints(e)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, ep := range endpoints {
+		rule, description, err := t.endpointRule(e, ep)
+		if err != nil {
+			return nil, nil, err
+		}
+		if rule == nil {
+			unresolved = append(unresolved, ep.host)
+
+			continue
+		}
+		rules = append(rules, *rule)
+		report = append(report, description)
+	}
+	if len(unresolved) > 0 {
+		report = append(report, fmt.Sprintf("unresolved endpoints (not allowed) %s", strings.Join(unresolved, ",")))
+	}
+
+	if len(t.EgressPorts) > 0 {
+		ports := make([]networkingv1.NetworkPolicyPort, 0, len(t.EgressPorts))
+		for _, p := range t.EgressPorts {
+			port, err := parseNetworkPolicyPort(p)
+			if err != nil {
+				return nil, nil, err
+			}
+			ports = appendNetworkPolicyPort(ports, *port.Port, *port.Protocol)
+		}
+		rules = append(rules, networkingv1.NetworkPolicyEgressRule{Ports: ports})
+		report = append(report, fmt.Sprintf("%s to any host", formatNetworkPolicyPorts(ports)))
+	}
+	if len(t.EgressCIDRs) > 0 {
+		rules = append(rules, networkingv1.NetworkPolicyEgressRule{To: ipBlockPeers(t.EgressCIDRs)})
+		report = append(report, strings.Join(t.EgressCIDRs, ","))
+	}
+
+	return rules, report, nil
+}
+
+// endpointRule returns the rule allowing the traffic to the given endpoint, or nil if the endpoint can't be allowed.
+func (t *networkPolicyTrait) endpointRule(e *Environment, ep endpoint) (*networkingv1.NetworkPolicyEgressRule, string, error) {
+	svc, err := t.lookupService(e, ep.host)
+	if err != nil {
+		return nil, "", err
+	}
+	if svc != nil && len(svc.Spec.Selector) > 0 {
+		ports := make([]networkingv1.NetworkPolicyPort, 0, len(svc.Spec.Ports))
+		for _, p := range svc.Spec.Ports {
+			if ep.port != 0 && ep.port != p.Port {
+				continue
+			}
+			target := p.TargetPort
+			if target.Type == intstr.Int && target.IntVal == 0 {
+				target = intstr.FromInt32(p.Port)
+			}
+			ports = appendNetworkPolicyPort(ports, target, p.Protocol)
+		}
+		peer := networkingv1.NetworkPolicyPeer{
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: svc.Spec.Selector,
+			},
+		}
+		if svc.Namespace != e.Integration.Namespace {
+			peer.NamespaceSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					namespaceNameLabel: svc.Namespace,
+				},
+			}
+		}
+
+		return &networkingv1.NetworkPolicyEgressRule{
+			To:    []networkingv1.NetworkPolicyPeer{peer},
+			Ports: ports,
+		}, fmt.Sprintf("%s/%s pods on %s", svc.Namespace, svc.Name, formatNetworkPolicyPorts(ports)), nil
+	}
+
+	var ports []networkingv1.NetworkPolicyPort
+	if ep.port != 0 {
+		ports = appendNetworkPolicyPort(ports, intstr.FromInt32(ep.port), corev1.ProtocolTCP)
+	}
+	if ip := net.ParseIP(ep.host); ip != nil {
+		bits := 32
+		if ip.To4() == nil {
+			bits = 128
+		}
+		cidr := fmt.Sprintf("%s/%d", ip.String(), bits)
+
+		return &networkingv1.NetworkPolicyEgressRule{
+			To:    ipBlockPeers([]string{cidr}),
+			Ports: ports,
+		}, fmt.Sprintf("%s on %s", cidr, formatNetworkPolicyPorts(ports)), nil
+	}
+	if ep.port == 0 {
+		// The endpoint would be allowed on any port of any host
+		return nil, "", nil
+	}
+
+	return &networkingv1.NetworkPolicyEgressRule{
+		Ports: ports,
+	}, fmt.Sprintf("%s on %s (any host)", ep.host, formatNetworkPolicyPorts(ports)), nil
+}
+
+// lookupService returns the Kubernetes Service the given host name resolves to in the cluster, if any
+// (ie, `name`, `name.namespace`, `name.namespace.svc` or `name.namespace.svc.cluster.local`).
+func (t *networkPolicyTrait) lookupService(e *Environment, host string) (*corev1.Service, error) {
+	if e.Client == nil || net.ParseIP(host) != nil {
+		return nil, nil
+	}
+	parts := strings.Split(strings.TrimSuffix(host, clusterDomainSuffix), ".")
+	name, namespace := parts[0], e.Integration.Namespace
+	switch {
+	case len(parts) == 1:
+	case len(parts) == 2, len(parts) == 3 && parts[2] == serviceDomainComponent:
+		namespace = parts[1]
+	default:
+		return nil, nil
+	}
+
+	svc := corev1.Service{}
+	err := e.Client.Get(e.Ctx, ctrl.ObjectKey{Namespace: namespace, Name: name}, &svc)
+	if k8serrors.IsNotFound(err) || k8serrors.IsForbidden(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &svc, nil
+}
+
+// endpoints returns the hosts reached by the Integration routes, including the ones of the Kamelets they use, and the
+// ones which can't be resolved.
+func (t *networkPolicyTrait) endpoints(e *Environment) ([]endpoint, []string, error) {
+	if e.CamelCatalog == nil {
+		return nil, nil, nil
+	}
+	// the Kamelet sources generated by the kamelets trait are expanded from the Kamelet URIs, with their properties
+	sources, err := resolveIntegrationSources(e.Ctx, e.Client, e.Integration, true, e.Resources)
+	if err != nil {
+		return nil, nil, err
+	}
+	meta, err := metadata.ExtractAll(e.CamelCatalog, sources)
+	if err != nil {
+		return nil, nil, err
+	}
+	resolver, err := newPropertyResolver(e)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uris := make([]string, 0, len(meta.FromURIs)+len(meta.ToURIs))
+	for _, uri := range slices.Concat(meta.FromURIs, meta.ToURIs) {
+		uris = append(uris, source.ReplacePropertyPlaceholders(uri, resolver.value))
+	}
+	uris, unresolved, err := t.expandKameletURIs(e, resolver, uris, make(map[string]bool))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoints := make([]endpoint, 0)
+	for _, uri := range uris {
+		for _, ep := range uriEndpoints(uri, resolver) {
+			switch {
+			case strings.Contains(ep.host, "{{"):
+				if !slices.Contains(unresolved, ep.host) {
+					unresolved = append(unresolved, ep.host)
+				}
+			case slices.Contains(endpoints, ep):
+			default:
+				endpoints = append(endpoints, ep)
+			}
+		}
+	}
+
+	return endpoints, unresolved, nil
+}
+
+// expandKameletURIs replaces the Kamelet URIs (ie, `kamelet:name/id?prop=value`) with the URIs of the Kamelet
+// templates, whose property placeholders are resolved from the Kamelet URI parameters, the Integration configuration
+// (ie, `camel.kamelet.name.id.prop`) and the Kamelet defaults. The nested Kamelets are expanded as well. It also returns
+// the Kamelets which can't be found, as `kamelet:name`.
+func (t *networkPolicyTrait) expandKameletURIs(
+	e *Environment, resolver *propertyResolver, uris []string, visited map[string]bool,
+) ([]string, []string, error) {
+	expanded := make([]string, 0, len(uris))
+	unresolved := make([]string, 0)
+	for _, uri := range uris {
+		remainder, ok := strings.CutPrefix(uri, "kamelet:")
+		if !ok {
+			expanded = append(expanded, uri)
+
+			continue
+		}
+		ref, query, _ := strings.Cut(remainder, "?")
+		name, id, _ := strings.Cut(ref, "/")
+		// the source and sink of a Kamelet template are its boundaries
+		if name == "source" || name == "sink" || visited[uri] {
+			continue
+		}
+		visited[uri] = true
+		kamelet, err := t.lookupKamelet(e, resolver, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if kamelet == nil {
+			if id := "kamelet:" + name; !slices.Contains(unresolved, id) {
+				unresolved = append(unresolved, id)
+			}
+
+			continue
+		}
+		meta, err := inspectKameletSources(e, kamelet)
+		if err != nil {
+			return nil, nil, err
+		}
+		templateMeta, err := inspectKameletTemplate(e, kamelet)
+		if err != nil {
+			return nil, nil, err
+		}
+		parameters, _ := url.ParseQuery(query)
+		value := kameletPropertyValue(resolver, kamelet, id, parameters)
+		templateURIs := make([]string, 0)
+		for _, m := range []*source.Metadata{meta, templateMeta} {
+			if m == nil {
+				continue
+			}
+			for _, u := range slices.Concat(m.FromURIs, m.ToURIs) {
+				templateURIs = append(templateURIs, source.ReplacePropertyPlaceholders(u, value))
+			}
+		}
+		nested, nestedUnresolved, err := t.expandKameletURIs(e, resolver, templateURIs, visited)
+		if err != nil {
+			return nil, nil, err
+		}
+		expanded = append(expanded, nested...)
+		for _, u := range nestedUnresolved {
+			if !slices.Contains(unresolved, u) {
+				unresolved = append(unresolved, u)
+			}
+		}
+	}
+
+	return expanded, unresolved, nil
+}
+
+// lookupKamelet returns the Kamelet with the given name, either collected by the kamelets trait or, for a nested
+// Kamelet, from the Kamelet repositories. It returns nil if the Kamelet can't be found.
+func (t *networkPolicyTrait) lookupKamelet(e *Environment, resolver *propertyResolver, name string) (*v1.Kamelet, error) {
+	if kamelet, ok := resolver.kamelets[name]; ok {
+		return kamelet, nil
+	}
+	kt, ok := e.Catalog.GetTrait(kameletsTraitID).(*kameletsTrait)
+	if !ok || e.Client == nil || !v1.ValidKameletName(name) {
+		return nil, nil
+	}
+	repo, err := kt.kameletRepository(e)
+	if err != nil {
+		return nil, err
+	}
+	kamelet, err := repo.Get(e.Ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if resolver.kamelets == nil {
+		resolver.kamelets = make(map[string]*v1.Kamelet)
+	}
+	resolver.kamelets[name] = kamelet
+
+	return kamelet, nil
+}
+
+// kameletPropertyValue returns the function resolving the property placeholders of the template of a Kamelet, as
+// the Camel runtime does.
+func kameletPropertyValue(
+	resolver *propertyResolver, kamelet *v1.Kamelet, id string, parameters url.Values,
+) func(source.PropertyPlaceholder) (string, bool) {
+	return func(p source.PropertyPlaceholder) (string, bool) {
+		if p.Function != "" {
+			return resolver.value(p)
+		}
+		if v := parameters.Get(p.Key); v != "" {
+			return v, true
+		}
+		if id != "" {
+			if v, ok := resolver.property(fmt.Sprintf("camel.kamelet.%s.%s.%s", kamelet.Name, id, p.Key)); ok {
+				return v, true
+			}
+		}
+		if v, ok := resolver.property(fmt.Sprintf("camel.kamelet.%s.%s", kamelet.Name, p.Key)); ok {
+			return v, true
+		}
+		if kamelet.Spec.Definition != nil {
+			if def, ok := kamelet.Spec.Definition.Properties[p.Key]; ok {
+				if v, ok := defaultValue(def.Default); ok {
+					return v, true
+				}
+			}
+		}
+		if p.Default != nil {
+			return *p.Default, true
+		}
+
+		return "", false
+	}
+}
+
+// uriEndpoints returns the hosts reached by a Camel endpoint URI, either from its authority (ie, `http://host:port`)
+// or, for Kafka, from its bootstrap servers.
+func uriEndpoints(uri string, resolver *propertyResolver) []endpoint {
+	scheme, remainder, ok := strings.Cut(uri, ":")
+	if !ok {
+		return nil
+	}
+	endpoints := make([]endpoint, 0)
+	if scheme == "kafka" {
+		brokers, _ := resolver.property(kafkaBrokersProperty)
+		if _, query, ok := strings.Cut(remainder, "?"); ok {
+			if values, err := url.ParseQuery(query); err == nil && values.Get("brokers") != "" {
+				brokers = values.Get("brokers")
+			}
+		}
+		for _, broker := range strings.Split(brokers, ",") {
+			if ep, ok := parseEndpoint(strings.TrimSpace(broker), defaultKafkaBrokerPort); ok {
+				endpoints = append(endpoints, ep)
+			}
+		}
+
+		return endpoints
+	}
+	if !strings.HasPrefix(remainder, "//") {
+		return nil
+	}
+	if strings.Contains(remainder, "{{") {
+		// Report the unresolved authority as is
+		authority, _, _ := strings.Cut(strings.TrimPrefix(remainder, "//"), "/")
+		authority, _, _ = strings.Cut(authority, "?")
+
+		return []endpoint{{host: authority}}
+	}
+	u, err := url.Parse(uri)
+	if err != nil || u.Hostname() == "" {
+		return nil
+	}
+	defaultPort := int32(0)
+	switch {
+	case strings.HasSuffix(scheme, "https"):
+		defaultPort = 443
+	case strings.HasSuffix(scheme, "http"):
+		defaultPort = 80
+	}
+	if ep, ok := parseEndpoint(u.Host, defaultPort); ok {
+		endpoints = append(endpoints, ep)
+	}
+
+	return endpoints
+}
+
+func parseEndpoint(hostPort string, defaultPort int32) (endpoint, bool) {
+	if hostPort == "" {
+		return endpoint{}, false
+	}
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		host, port = strings.Trim(hostPort, "[]"), ""
+	}
+	switch host {
+	case "", "localhost", "0.0.0.0", "127.0.0.1", "::1":
+		return endpoint{}, false
+	}
+	ep := endpoint{host: host, port: defaultPort}
+	if port != "" {
+		p, err := strconv.ParseInt(port, 10, 32)
+		if err != nil && strings.Contains(hostPort, "{{") {
+			return endpoint{host: hostPort}, true
+		} else if err != nil {
+			return endpoint{}, false
+		}
+		ep.port = int32(p)
+	}
+
+	return ep, true
+}
+
+// parseNetworkPolicyPort parses a port in the form `port[/protocol]`.
+func parseNetworkPolicyPort(value string) (networkingv1.NetworkPolicyPort, error) {
+	number, protocol, ok := strings.Cut(value, "/")
+	if !ok {
+		protocol = string(corev1.ProtocolTCP)
+	}
+	port, err := strconv.ParseInt(number, 10, 32)
+	if err != nil || port < 1 || port > 65535 {
+		return networkingv1.NetworkPolicyPort{}, fmt.Errorf("invalid network-policy trait port %q", value)
+	}
+	switch corev1.Protocol(strings.ToUpper(protocol)) {
+	case corev1.ProtocolTCP, corev1.ProtocolUDP, corev1.ProtocolSCTP:
+	default:
+		return networkingv1.NetworkPolicyPort{}, errors.New("invalid network-policy trait port protocol " + protocol)
+	}
+
+	return networkingv1.NetworkPolicyPort{
+		Port:     ptr.To(intstr.FromInt32(int32(port))),
+		Protocol: ptr.To(corev1.Protocol(strings.ToUpper(protocol))),
+	}, nil
+}
+
+func appendNetworkPolicyPort(ports []networkingv1.NetworkPolicyPort, port intstr.IntOrString, protocol corev1.Protocol) []networkingv1.NetworkPolicyPort {
+	if protocol == "" {
+		protocol = corev1.ProtocolTCP
+	}
+	for _, p := range ports {
+		if *p.Port == port && *p.Protocol == protocol {
+			return ports
+		}
+	}
+
+	return append(ports, networkingv1.NetworkPolicyPort{Port: &port, Protocol: &protocol})
+}
+
+func formatNetworkPolicyPorts(ports []networkingv1.NetworkPolicyPort) string {
+	formatted := make([]string, 0, len(ports))
+	for _, p := range ports {
+		formatted = append(formatted, fmt.Sprintf("%s/%s", p.Port.String(), *p.Protocol))
+	}
+	if len(formatted) == 0 {
+		return "any port"
+	}
+
+	return strings.Join(formatted, ",")
+}
+
+func ipBlockPeers(cidrs []string) []networkingv1.NetworkPolicyPeer {
+	peers := make([]networkingv1.NetworkPolicyPeer, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			IPBlock: &networkingv1.IPBlock{CIDR: cidr},
+		})
+	}
+
+	return peers
+}
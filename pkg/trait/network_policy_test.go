@@ -0,0 +1,280 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/source"
+)
+
+const networkPolicyTestRoute = `
+from("platform-http:/orders")
+	.to("http://my-svc:8080/api")
+	.to("kafka:orders?brokers=my-cluster-kafka-bootstrap.kafka:9092")
+	.to("https://api.example.com/v1")
+	.to("http://{{unknown.host}}/path");
+`
+
+func TestNetworkPolicy(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNetworkPolicyEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.NetworkPolicy.IngressCIDRs = []string{"10.0.0.0/16"}
+	environment.Integration.Spec.Traits.NetworkPolicy.EgressPorts = []string{"5432"}
+
+	_, _, err := traitCatalog.apply(environment)
+	require.NoError(t, err)
+	assert.True(t, environment.Integration.IsConditionTrue(v1.IntegrationConditionNetworkPolicyAvailable))
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionNetworkPolicyAvailable)
+	assert.Contains(t, condition.Message, "unresolved endpoints (not allowed) {{unknown.host}}")
+
+	policy := findNetworkPolicy(environment)
+	require.NotNil(t, policy)
+	assert.Equal(t, "hello", policy.Spec.PodSelector.MatchLabels[v1.IntegrationLabel])
+	assert.Equal(t, "hello", policy.Labels[v1.IntegrationLabel])
+
+	require.Len(t, policy.Spec.Ingress, 2)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{}}}, policy.Spec.Ingress[0].From)
+	assert.Contains(t, policy.Spec.Ingress[0].Ports, networkPolicyPort(8080, corev1.ProtocolTCP))
+	assert.Equal(t, "10.0.0.0/16", policy.Spec.Ingress[1].From[0].IPBlock.CIDR)
+
+	require.Len(t, policy.Spec.Egress, 5)
+	// DNS
+	assert.Empty(t, policy.Spec.Egress[0].To)
+	assert.Contains(t, policy.Spec.Egress[0].Ports, networkPolicyPort(53, corev1.ProtocolUDP))
+	// The pods of a local Service, on its target port
+	assert.Equal(t, map[string]string{"app": "my-app"}, policy.Spec.Egress[1].To[0].PodSelector.MatchLabels)
+	assert.Nil(t, policy.Spec.Egress[1].To[0].NamespaceSelector)
+	assert.Equal(t, []networkingv1.NetworkPolicyPort{networkPolicyPort(9000, corev1.ProtocolTCP)}, policy.Spec.Egress[1].Ports)
+	// The Kafka brokers, in another namespace
+	assert.Equal(t, "kafka", policy.Spec.Egress[2].To[0].NamespaceSelector.MatchLabels[namespaceNameLabel])
+	assert.Equal(t, "my-cluster", policy.Spec.Egress[2].To[0].PodSelector.MatchLabels["strimzi.io/cluster"])
+	// An external host, only restricted by port
+	assert.Empty(t, policy.Spec.Egress[3].To)
+	assert.Equal(t, []networkingv1.NetworkPolicyPort{networkPolicyPort(443, corev1.ProtocolTCP)}, policy.Spec.Egress[3].Ports)
+	// The configured port
+	assert.Equal(t, []networkingv1.NetworkPolicyPort{networkPolicyPort(5432, corev1.ProtocolTCP)}, policy.Spec.Egress[4].Ports)
+}
+
+func TestNetworkPolicyMountedProperties(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNetworkPolicyEnv(t, traitCatalog)
+	require.NoError(t, environment.Client.Create(environment.Ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: environment.Integration.Namespace, Name: "my-props"},
+		Data: map[string]string{
+			"app.properties": "unknown.host=orders.example.com:8443",
+		},
+	}))
+	environment.Integration.Spec.Traits.Mount = &traitv1.MountTrait{
+		Configs: []string{"configmap:my-props"},
+	}
+
+	_, _, err := traitCatalog.apply(environment)
+	require.NoError(t, err)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionNetworkPolicyAvailable)
+	require.NotNil(t, condition)
+	assert.NotContains(t, condition.Message, "unresolved endpoints")
+
+	policy := findNetworkPolicy(environment)
+	require.NotNil(t, policy)
+	assert.Contains(t, policy.Spec.Egress[len(policy.Spec.Egress)-1].Ports, networkPolicyPort(8443, corev1.ProtocolTCP))
+}
+
+func TestNetworkPolicyAudit(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNetworkPolicyEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.NetworkPolicy.Audit = ptr.To(true)
+
+	_, _, err := traitCatalog.apply(environment)
+	require.NoError(t, err)
+	assert.Nil(t, findNetworkPolicy(environment))
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionNetworkPolicyAvailable)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionNetworkPolicyAuditReason, condition.Reason)
+	assert.Contains(t, condition.Message, "default/my-svc pods on 9000/TCP")
+	assert.Contains(t, condition.Message, "api.example.com on 443/TCP (any host)")
+}
+
+func TestNetworkPolicyInvalidConfiguration(t *testing.T) {
+	trait, _ := newNetworkPolicyTrait().(*networkPolicyTrait)
+	trait.Enabled = ptr.To(true)
+	environment := getNetworkPolicyEnv(t, NewCatalog(nil))
+
+	trait.EgressPorts = []string{"5432/HTTP"}
+	_, _, err := trait.Configure(environment)
+	require.Error(t, err)
+
+	trait.EgressPorts = []string{"5432/udp"}
+	trait.IngressCIDRs = []string{"10.0.0.0"}
+	_, _, err = trait.Configure(environment)
+	require.Error(t, err)
+}
+
+func TestNetworkPolicyKamelets(t *testing.T) {
+	kamelets := []runtime.Object{
+		&v1.Kamelet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "orders-source"},
+			Spec: v1.KameletSpec{
+				KameletSpecBase: v1.KameletSpecBase{
+					Definition: &v1.JSONSchemaProps{
+						Properties: map[string]v1.JSONSchemaProp{
+							"port": {Type: "integer", Default: &v1.JSON{RawMessage: []byte("8443")}},
+						},
+					},
+					Template: templateOrFail(map[string]interface{}{
+						"from": map[string]interface{}{
+							"uri": "timer:poll",
+							"steps": []interface{}{
+								map[string]interface{}{"to": "https://{{host}}:{{port}}/orders"},
+								map[string]interface{}{"to": "kamelet:kafka-sink"},
+								map[string]interface{}{"to": "kamelet:sink"},
+							},
+						},
+					}),
+				},
+			},
+		},
+		&v1.Kamelet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "kafka-sink"},
+			Spec: v1.KameletSpec{
+				KameletSpecBase: v1.KameletSpecBase{
+					Template: templateOrFail(map[string]interface{}{
+						"from": map[string]interface{}{
+							"uri": "kamelet:source",
+							"steps": []interface{}{
+								map[string]interface{}{"to": "kafka:orders?brokers={{bootstrapServers}}"},
+							},
+						},
+					}),
+				},
+			},
+		},
+	}
+	traitCatalog := NewCatalog(nil)
+	environment := getNetworkPolicyEnv(t, traitCatalog, kamelets...)
+	environment.Integration.Spec.Sources[0].Content = `
+from("kamelet:orders-source?host=orders.example.com")
+	.to("kamelet:missing-sink");
+`
+	environment.Integration.Spec.Traits.Camel = &traitv1.CamelTrait{
+		Properties: []string{"camel.kamelet.kafka-sink.bootstrapServers=my-cluster-kafka-bootstrap.kafka:9092"},
+	}
+
+	_, _, err := traitCatalog.apply(environment)
+	require.NoError(t, err)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionNetworkPolicyAvailable)
+	require.NotNil(t, condition)
+	assert.Contains(t, condition.Message, "orders.example.com on 8443/TCP (any host)")
+	assert.Contains(t, condition.Message, "kafka/my-cluster-kafka-bootstrap pods on 9092/TCP")
+	assert.Contains(t, condition.Message, "unresolved endpoints (not allowed) kamelet:missing-sink")
+}
+
+func TestNetworkPolicyURIEndpoints(t *testing.T) {
+	resolver := &propertyResolver{
+		properties: map[string]string{
+			"camel.component.kafka.brokers": "broker-0:9093, broker-1",
+			"api.host":                      "api.example.com",
+		},
+		envVars: map[string]string{
+			"DB_PORT": "5432",
+		},
+	}
+	resolve := func(uri string) string {
+		return source.ReplacePropertyPlaceholders(uri, resolver.value)
+	}
+
+	assert.Equal(t, []endpoint{{host: "broker-0", port: 9093}, {host: "broker-1", port: 9092}}, uriEndpoints("kafka:orders", resolver))
+	assert.Equal(t, []endpoint{{host: "api.example.com", port: 8443}}, uriEndpoints(resolve("https://{{api.host}}:8443/v1"), resolver))
+	assert.Equal(t, []endpoint{{host: "db", port: 80}}, uriEndpoints(resolve("http://{{db.host:db}}/"), resolver))
+	assert.Equal(t, []endpoint{{host: "db", port: 5432}}, uriEndpoints(resolve("http://db:{{db.port}}/"), resolver))
+	assert.Equal(t, []endpoint{{host: "{{env:HOST}}"}}, uriEndpoints(resolve("http://{{env:HOST}}/"), resolver))
+	assert.Empty(t, uriEndpoints("platform-http:/orders", resolver))
+	assert.Empty(t, uriEndpoints("http://localhost:8080/", resolver))
+}
+
+func getNetworkPolicyEnv(t *testing.T, traitCatalog *Catalog, objects ...runtime.Object) *Environment {
+	t.Helper()
+	environment := getNominalEnv(t, traitCatalog)
+	objects = append(objects,
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "my-svc"},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"app": "my-app"},
+				Ports:    []corev1.ServicePort{{Port: 8080, TargetPort: intstr.FromInt32(9000), Protocol: corev1.ProtocolTCP}},
+			},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "kafka", Name: "my-cluster-kafka-bootstrap"},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"strimzi.io/cluster": "my-cluster", "strimzi.io/kind": "Kafka"},
+				Ports:    []corev1.ServicePort{{Port: 9092, TargetPort: intstr.FromInt32(9092), Protocol: corev1.ProtocolTCP}},
+			},
+		},
+	)
+	client, err := internal.NewFakeClient(objects...)
+	require.NoError(t, err)
+	environment.Client = client
+	environment.Integration.Spec.Sources = []v1.SourceSpec{
+		{
+			DataSpec: v1.DataSpec{
+				Name:    "routes.java",
+				Content: networkPolicyTestRoute,
+			},
+			Language: v1.LanguageJavaSource,
+		},
+	}
+	environment.Integration.Spec.Traits.Mount = nil
+	environment.Integration.Spec.Traits.NetworkPolicy = &traitv1.NetworkPolicyTrait{
+		Trait: traitv1.Trait{
+			Enabled: ptr.To(true),
+		},
+	}
+
+	return environment
+}
+
+func networkPolicyPort(port int32, protocol corev1.Protocol) networkingv1.NetworkPolicyPort {
+	return networkingv1.NetworkPolicyPort{
+		Port:     ptr.To(intstr.FromInt32(port)),
+		Protocol: ptr.To(protocol),
+	}
+}
+
+func findNetworkPolicy(e *Environment) *networkingv1.NetworkPolicy {
+	for _, r := range e.Resources.Items() {
+		if policy, ok := r.(*networkingv1.NetworkPolicy); ok {
+			return policy
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/metadata"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/dsl"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/openapi"
+	"github.com/apache/camel-k/v2/pkg/util/property"
+)
+
+const (
+	// OpenAPIType is the type of the ConfigMap holding the OpenAPI document of an Integration.
+	OpenAPIType = "openapi"
+)
+
+// openAPIValidationProperty is the Camel property enabling the validation of the requests against the OpenAPI document.
+const openAPIValidationProperty = "camel.rest.client-request-validation"
+
+var openAPIMountPath = filepath.Join(camel.ResourcesDefaultMountPath, "openapi")
+
+// exposedPath is a path an Integration exposes, matched either exactly or as a prefix.
+type exposedPath struct {
+	Path  string
+	Exact bool
+}
+
+// getOpenAPISource returns the source holding the OpenAPI document of the Integration, if any.
+func getOpenAPISource(it *v1.Integration) (*v1.SourceSpec, error) {
+	var found *v1.SourceSpec
+	for i := range it.Spec.Sources {
+		if it.Spec.Sources[i].Type != v1.SourceTypeOpenAPI {
+			continue
+		}
+		if found != nil {
+			return nil, errors.New("only one OpenAPI source is supported per Integration")
+		}
+		found = &it.Spec.Sources[i]
+	}
+
+	return found, nil
+}
+
+// getOpenAPIDocument returns the content of the OpenAPI source, looking up the referenced ConfigMap if needed.
+func getOpenAPIDocument(e *Environment, source v1.SourceSpec) (string, error) {
+	data := source.DataSpec
+	err := resolve(&data, func(name string) (*corev1.ConfigMap, error) {
+		return kubernetes.GetConfigMap(e.Ctx, e.Client, name, e.Integration.Namespace)
+	})
+
+	return data.Content, err
+}
+
+// generateOpenAPISource turns the OpenAPI source into the YAML DSL REST services implementing it. The generated source
+// replaces the document in the sources loaded by the runtime, whilst the document is mounted as a resource.
+func generateOpenAPISource(e *Environment) error {
+	source, err := getOpenAPISource(e.Integration)
+	if err != nil || source == nil {
+		return err
+	}
+	content, err := getOpenAPIDocument(e, *source)
+	if err != nil {
+		return err
+	}
+	doc, err := openapi.Parse([]byte(content))
+	if err != nil {
+		return err
+	}
+	id := strings.TrimSuffix(path.Base(source.Name), path.Ext(source.Name))
+	location := path.Join(filepath.ToSlash(openAPIMountPath), path.Base(source.Name))
+	schemaLocation := ""
+	if isOpenAPIValidationEnabled(e) {
+		schemaLocation = filepath.ToSlash(openAPIMountPath)
+	}
+	yamlDSL, err := dsl.OpenAPIToYamlDSL(doc, id, location, openAPIMediaType(content), schemaLocation)
+	if err != nil {
+		return err
+	}
+
+	e.Integration.Status.AddOrReplaceGeneratedSources(v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name:    source.Name,
+			Content: string(yamlDSL),
+		},
+		Language: v1.LanguageYaml,
+	})
+
+	return nil
+}
+
+// computeOpenAPIConfigMap returns the ConfigMap holding the OpenAPI document, which is served by the Integration.
+func computeOpenAPIConfigMap(e *Environment) (*corev1.ConfigMap, error) {
+	source, err := getOpenAPISource(e.Integration)
+	if err != nil || source == nil {
+		return nil, err
+	}
+	content, err := getOpenAPIDocument(e, *source)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]string{
+		path.Base(source.Name): content,
+	}
+	if isOpenAPIValidationEnabled(e) {
+		// the JSON schemas of the request bodies are mounted next to the document for the validation routes
+		schemas, err := computeOpenAPISchemas(content)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(data, schemas)
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name + "-openapi",
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel:           e.Integration.Name,
+				kubernetes.ConfigMapTypeLabel: OpenAPIType,
+			},
+		},
+		Data: data,
+	}, nil
+}
+
+// computeOpenAPISchemas returns the JSON schemas of the JSON request bodies declared in the OpenAPI document,
+// keyed by the name of the file the validation routes load them from.
+func computeOpenAPISchemas(content string) (map[string]string, error) {
+	doc, err := openapi.Parse([]byte(content))
+	if err != nil {
+		return nil, err
+	}
+	endpoints, err := doc.Endpoints()
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := make(map[string]string)
+	for _, endpoint := range endpoints {
+		if endpoint.BodySchema == nil {
+			continue
+		}
+		schema, err := doc.JSONSchema(endpoint.BodySchema)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compute the JSON schema of the %s operation: %w", endpoint.ID, err)
+		}
+		schemas[dsl.OpenAPISchemaName(endpoint.ID)] = string(schema)
+	}
+
+	return schemas, nil
+}
+
+// isOpenAPIValidationEnabled returns true if the Camel property enabling the validation of the requests is set,
+// either in the camel trait or in the Integration configuration.
+func isOpenAPIValidationEnabled(e *Environment) bool {
+	var properties []string
+	if t, ok := e.Catalog.GetTrait(camelTraitID).(*camelTrait); ok && t != nil {
+		properties = append(properties, t.Properties...)
+	}
+	for _, prop := range e.collectConfigurationPairs("property") {
+		properties = append(properties, prop.Name+"="+prop.Value)
+	}
+	for _, prop := range properties {
+		k, v := property.SplitPropertyFileEntry(prop)
+		if k == openAPIValidationProperty {
+			return strings.EqualFold(strings.TrimSpace(v), "true")
+		}
+	}
+
+	return false
+}
+
+// getOpenAPIExposedPaths returns the paths declared by the OpenAPI document of the Integration, as found in the
+// generated REST services, along with the path the document is served at. The templated paths are exposed by prefix.
+func (e *Environment) getOpenAPIExposedPaths() ([]exposedPath, error) {
+	source, err := getOpenAPISource(e.Integration)
+	if err != nil || source == nil || e.CamelCatalog == nil {
+		return nil, err
+	}
+	idx := slices.IndexFunc(e.Integration.Status.GeneratedSources, func(s v1.SourceSpec) bool {
+		return s.Name == source.Name
+	})
+	if idx < 0 {
+		return nil, nil
+	}
+	routes, err := metadata.ExtractRoutes(e.CamelCatalog, e.Integration.Status.GeneratedSources[idx:idx+1])
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []exposedPath
+	for _, p := range append(routes.RestPaths(), dsl.OpenAPIPath) {
+		exposed := exposedPath{Path: p, Exact: true}
+		if openapi.IsTemplated(p) {
+			exposed = exposedPath{Path: strings.TrimSuffix(openapi.StaticPrefix(p), "/")}
+			if exposed.Path == "" {
+				exposed.Path = "/"
+			}
+		}
+		if !slices.Contains(paths, exposed) {
+			paths = append(paths, exposed)
+		}
+	}
+
+	return paths, nil
+}
+
+func openAPIMediaType(content string) string {
+	if strings.HasPrefix(strings.TrimSpace(content), "{") {
+		return "application/json"
+	}
+
+	return "application/yaml"
+}
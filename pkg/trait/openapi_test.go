@@ -0,0 +1,278 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	routev1 "github.com/openshift/api/route/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/metadata"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const petstoreOpenAPI = `
+openapi: 3.0.3
+servers:
+  - url: /api
+paths:
+  /pets:
+    get:
+      operationId: listPets
+    post:
+      operationId: createPet
+  /pets/{petId}:
+    get:
+      operationId: getPet
+`
+
+func TestGenerateOpenAPISource(t *testing.T) {
+	environment := createOpenAPITestEnvironment(t, v1.DataSpec{Name: "petstore.yaml", Content: petstoreOpenAPI})
+
+	require.NoError(t, generateOpenAPISource(environment))
+
+	require.Len(t, environment.Integration.Status.GeneratedSources, 1)
+	source := environment.Integration.Status.GeneratedSources[0]
+	assert.Equal(t, "petstore.yaml", source.Name)
+	assert.Equal(t, v1.LanguageYaml, source.Language)
+	assert.Contains(t, source.Content, "to: direct:listPets")
+	assert.Contains(t, source.Content, "constant: resource:file:/etc/camel/resources/openapi/petstore.yaml")
+
+	// the generated source replaces the document in the sources loaded by the runtime
+	sources := environment.Integration.AllSources()
+	require.Len(t, sources, 1)
+	assert.Equal(t, source, sources[0])
+}
+
+func TestGenerateOpenAPISourceFromConfigmap(t *testing.T) {
+	environment := createOpenAPITestEnvironment(t, v1.DataSpec{Name: "petstore.yaml", ContentRef: "my-cm", ContentKey: "petstore.yaml"})
+
+	require.NoError(t, generateOpenAPISource(environment))
+
+	require.Len(t, environment.Integration.Status.GeneratedSources, 1)
+	assert.Contains(t, environment.Integration.Status.GeneratedSources[0].Content, "to: direct:getPet")
+}
+
+func TestGenerateOpenAPISourceErrors(t *testing.T) {
+	environment := createOpenAPITestEnvironment(t, v1.DataSpec{Name: "petstore.yaml", Content: "swagger: 2.0"})
+	require.EqualError(t, generateOpenAPISource(environment), "cannot parse the OpenAPI document: missing openapi version")
+
+	environment = createOpenAPITestEnvironment(t, v1.DataSpec{Name: "petstore.yaml", Content: petstoreOpenAPI})
+	environment.Integration.Spec.AddSources(v1.SourceSpec{
+		DataSpec: v1.DataSpec{Name: "other.yaml", Content: petstoreOpenAPI},
+		Type:     v1.SourceTypeOpenAPI,
+	})
+	require.EqualError(t, generateOpenAPISource(environment), "only one OpenAPI source is supported per Integration")
+}
+
+func TestOpenAPIConfigMapIsMounted(t *testing.T) {
+	environment := createOpenAPITestEnvironment(t, v1.DataSpec{Name: "petstore.json", Content: `{"openapi": "3.0.0"}`})
+
+	cm, err := computeOpenAPIConfigMap(environment)
+	require.NoError(t, err)
+	require.NotNil(t, cm)
+	assert.Equal(t, "petstore-openapi", cm.Name)
+	assert.Equal(t, OpenAPIType, cm.Labels[kubernetes.ConfigMapTypeLabel])
+	assert.Equal(t, map[string]string{"petstore.json": `{"openapi": "3.0.0"}`}, cm.Data)
+
+	environment.Resources.Add(cm)
+	trait, _ := newMountTrait().(*mountTrait)
+	var vols []corev1.Volume
+	var mnts []corev1.VolumeMount
+	trait.configureCamelVolumesAndMounts(environment, &vols, &mnts)
+
+	require.Len(t, vols, 2)
+	assert.Equal(t, OpenAPIType, vols[1].Name)
+	assert.Equal(t, "petstore-openapi", vols[1].ConfigMap.Name)
+	assert.Equal(t, "/etc/camel/resources/openapi", mnts[1].MountPath)
+}
+
+func TestOpenAPIRequestBodyValidation(t *testing.T) {
+	environment := createOpenAPITestEnvironment(t, v1.DataSpec{Name: "petstore.yaml", Content: `
+openapi: 3.0.3
+paths:
+  /pets:
+    get:
+      operationId: listPets
+    post:
+      operationId: createPet
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+`})
+	camelTrait, _ := environment.Catalog.GetTrait(camelTraitID).(*camelTrait)
+	camelTrait.Properties = []string{"camel.rest.client-request-validation=true"}
+
+	require.NoError(t, generateOpenAPISource(environment))
+	require.Len(t, environment.Integration.Status.GeneratedSources, 1)
+	content := environment.Integration.Status.GeneratedSources[0].Content
+	assert.Contains(t, content, "to: direct:listPets\n")
+	assert.Contains(t, content, "to: direct:createPet-validation")
+	assert.Contains(t, content, "to: json-validator:file:/etc/camel/resources/openapi/createPet.schema.json")
+
+	meta, err := metadata.ExtractAll(environment.CamelCatalog, environment.Integration.Status.GeneratedSources)
+	require.NoError(t, err)
+	assert.Contains(t, meta.Dependencies.List(), "camel:json-validator")
+
+	cm, err := computeOpenAPIConfigMap(environment)
+	require.NoError(t, err)
+	require.NotNil(t, cm)
+	assert.Len(t, cm.Data, 2)
+	assert.JSONEq(t, `{
+		"$schema": "http://json-schema.org/draft-04/schema#",
+		"allOf": [{"$ref": "#/components/schemas/Pet"}],
+		"components": {"schemas": {"Pet": {"type": "object", "required": ["name"]}}}
+	}`, cm.Data["createPet.schema.json"])
+}
+
+func TestOpenAPIExposedPaths(t *testing.T) {
+	environment := createOpenAPITestEnvironment(t, v1.DataSpec{Name: "petstore.yaml", Content: petstoreOpenAPI})
+	require.NoError(t, generateOpenAPISource(environment))
+
+	paths, err := environment.getOpenAPIExposedPaths()
+	require.NoError(t, err)
+	assert.Equal(t, []exposedPath{
+		{Path: "/api/pets", Exact: true},
+		{Path: "/api/pets"},
+		{Path: "/openapi", Exact: true},
+	}, paths)
+
+	ingress, _ := newIngressTrait().(*ingressTrait)
+	require.NoError(t, ingress.Apply(environment))
+	var ing *networkingv1.Ingress
+	environment.Resources.Visit(func(o runtime.Object) {
+		if i, ok := o.(*networkingv1.Ingress); ok {
+			ing = i
+		}
+	})
+	require.NotNil(t, ing)
+	ingressPaths := ing.Spec.Rules[0].HTTP.Paths
+	require.Len(t, ingressPaths, 3)
+	assert.Equal(t, "/api/pets", ingressPaths[0].Path)
+	assert.Equal(t, networkingv1.PathTypeExact, *ingressPaths[0].PathType)
+	assert.Equal(t, networkingv1.PathTypePrefix, *ingressPaths[1].PathType)
+	assert.Equal(t, "/openapi", ingressPaths[2].Path)
+
+	route, _ := newRouteTrait().(*routeTrait)
+	route.service = environment.Resources.GetUserServiceForIntegration(environment.Integration)
+	require.NoError(t, route.Apply(environment))
+	var routes []*routev1.Route
+	environment.Resources.VisitRoute(func(r *routev1.Route) { routes = append(routes, r) })
+	require.Len(t, routes, 3)
+	assert.Equal(t, "petstore", routes[0].Name)
+	assert.Equal(t, "/api/pets", routes[0].Spec.Path)
+	assert.Equal(t, "petstore-001", routes[1].Name)
+	assert.Equal(t, "petstore-002", routes[2].Name)
+	assert.Equal(t, "/openapi", routes[2].Spec.Path)
+
+	gateway, _ := newGatewayTrait().(*gatewayTrait)
+	require.NoError(t, gateway.Apply(environment))
+	var httpRoute *gwv1.HTTPRoute
+	environment.Resources.Visit(func(o runtime.Object) {
+		if r, ok := o.(*gwv1.HTTPRoute); ok {
+			httpRoute = r
+		}
+	})
+	require.NotNil(t, httpRoute)
+	require.Len(t, httpRoute.Spec.Rules, 1)
+	matches := httpRoute.Spec.Rules[0].Matches
+	require.Len(t, matches, 3)
+	assert.Equal(t, gwv1.PathMatchExact, *matches[0].Path.Type)
+	assert.Equal(t, gwv1.PathMatchPathPrefix, *matches[1].Path.Type)
+	assert.Equal(t, "/openapi", *matches[2].Path.Value)
+}
+
+func createOpenAPITestEnvironment(t *testing.T, data v1.DataSpec) *Environment {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	client, err := internal.NewFakeClient(&corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "namespace",
+			Name:      "my-cm",
+		},
+		Data: map[string]string{
+			"petstore.yaml": petstoreOpenAPI,
+		},
+	})
+	require.NoError(t, err)
+
+	return &Environment{
+		Ctx:          t.Context(),
+		Client:       client,
+		CamelCatalog: catalog,
+		Catalog:      NewCatalog(client),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "petstore",
+				Namespace: "namespace",
+			},
+			Spec: v1.IntegrationSpec{
+				Sources: []v1.SourceSpec{
+					{DataSpec: data, Type: v1.SourceTypeOpenAPI},
+				},
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(
+			&corev1.Service{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Service",
+					APIVersion: "v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "petstore",
+					Namespace: "namespace",
+					Labels: map[string]string{
+						v1.IntegrationLabel:             "petstore",
+						"camel.apache.org/service.type": v1.ServiceTypeUser,
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Name: "http", Port: 80}},
+				},
+			},
+		),
+	}
+}
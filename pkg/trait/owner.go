@@ -22,6 +22,7 @@ import (
 	"slices"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -114,6 +115,14 @@ func (t *ownerTrait) Apply(e *Environment) error {
 		t.propagateLabelAndAnnotations(&deployment.Spec.Template, targetLabels, targetAnnotations)
 	})
 
+	e.Resources.VisitStatefulSet(func(statefulSet *appsv1.StatefulSet) {
+		t.propagateLabelAndAnnotations(&statefulSet.Spec.Template, targetLabels, targetAnnotations)
+	})
+
+	e.Resources.VisitJob(func(job *batchv1.Job) {
+		t.propagateLabelAndAnnotations(&job.Spec.Template, targetLabels, targetAnnotations)
+	})
+
 	e.Resources.VisitKnativeService(func(service *serving.Service) {
 		t.propagateLabelAndAnnotations(&service.Spec.Template, targetLabels, targetAnnotations)
 	})
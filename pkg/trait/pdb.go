@@ -19,6 +19,7 @@ package trait
 
 import (
 	"errors"
+	"fmt"
 
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -55,8 +56,8 @@ func (t *pdbTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
 		return false, nil, errors.New("unable to determine the controller strategy")
 	}
 
-	if strategy == ControllerStrategyCronJob {
-		return false, nil, errors.New("poddisruptionbudget isn't supported with cron-job controller strategy")
+	if strategy == ControllerStrategyCronJob || strategy == ControllerStrategyJob {
+		return false, nil, fmt.Errorf("poddisruptionbudget isn't supported with %s controller strategy", strategy)
 	}
 
 	if t.MaxUnavailable != "" && t.MinAvailable != "" {
@@ -0,0 +1,348 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/magiconair/properties"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/metadata"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/property"
+	utilResource "github.com/apache/camel-k/v2/pkg/util/resource"
+	"github.com/apache/camel-k/v2/pkg/util/source"
+)
+
+var envVarNameRegexp = regexp.MustCompile(`[^A-Z0-9]`)
+
+// PropertyPlaceholdersCheck is the result of the analysis of the property placeholders used in the Integration sources.
+type PropertyPlaceholdersCheck struct {
+	// The placeholders requiring some configuration to be resolved
+	Placeholders []string
+	// The placeholders which can't be resolved with the configuration provided
+	Unresolved []string
+	// The mounted ConfigMap and Secret (ie, `secret:name`) which do not exist
+	Missing []string
+	// True if the Integration must not be deployed when any placeholder is unresolved
+	FailOnUnresolved bool
+}
+
+// CheckPropertyPlaceholders verifies that the property placeholders used in the Integration sources can be resolved
+// with the configuration provided: the camel trait properties (including the ones inherited by the IntegrationPlatform
+// and the IntegrationProfile), the mount trait configs, the environment trait variables and the Kamelets property defaults.
+// Placeholders providing an inline default, optional placeholders and the ones resolved by other functions (ie, `env:`)
+// are not verified.
+func CheckPropertyPlaceholders(e *Environment) (*PropertyPlaceholdersCheck, error) {
+	if e.Integration == nil || e.CamelCatalog == nil || e.Catalog == nil {
+		return nil, nil
+	}
+	placeholders, err := e.collectPropertyPlaceholders()
+	if err != nil {
+		return nil, err
+	}
+	check := PropertyPlaceholdersCheck{
+		Placeholders: placeholders,
+	}
+	if ct, ok := e.Catalog.GetTrait(camelTraitID).(*camelTrait); ok {
+		check.FailOnUnresolved = ptr.Deref(ct.FailOnUnresolvedProperties, false)
+	}
+	if len(placeholders) == 0 {
+		return &check, nil
+	}
+	resolver, err := newPropertyResolver(e)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range placeholders {
+		if !resolver.resolve(p) {
+			check.Unresolved = append(check.Unresolved, p)
+		}
+	}
+	check.Missing = resolver.missing
+
+	return &check, nil
+}
+
+// collectPropertyPlaceholders returns the sorted keys of the placeholders used in the original sources which must be resolved
+// from the Integration configuration.
+func (e *Environment) collectPropertyPlaceholders() ([]string, error) {
+	sources, err := resolveIntegrationSources(e.Ctx, e.Client, e.Integration, true, e.Resources)
+	if err != nil {
+		return nil, err
+	}
+	var placeholders []string
+	for _, s := range sources {
+		meta, err := metadata.ExtractAll(e.CamelCatalog, []v1.SourceSpec{s})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range meta.PropertyPlaceholders {
+			if p.IsResolvable() {
+				continue
+			}
+			switch p.Function {
+			case "":
+				// The plain placeholders of a route template are the template parameters
+				if len(meta.RouteTemplates) > 0 {
+					continue
+				}
+			case "configmap", "configmap-binary", "secret", "secret-binary":
+			default:
+				// Any other function is resolved at runtime
+				continue
+			}
+			if !slices.Contains(placeholders, p.Key) {
+				placeholders = append(placeholders, p.Key)
+			}
+		}
+	}
+	slices.Sort(placeholders)
+
+	return placeholders, nil
+}
+
+// propertyResolver holds the configuration available to resolve the property placeholders.
+type propertyResolver struct {
+	properties map[string]string
+	envVars    map[string]string
+	// the keys of the mounted ConfigMap and Secret, indexed by `configmap:name` or `secret:name`
+	resources map[string][]string
+	// the mounted ConfigMap and Secret which do not exist, as `configmap:name` or `secret:name`
+	missing  []string
+	kamelets map[string]*v1.Kamelet
+}
+
+func newPropertyResolver(e *Environment) (*propertyResolver, error) {
+	r := propertyResolver{
+		properties: make(map[string]string),
+		envVars:    make(map[string]string),
+		resources:  make(map[string][]string),
+	}
+	for _, prop := range e.collectConfigurationPairs("property") {
+		r.properties[prop.Name] = prop.Value
+	}
+	for k, v := range e.ApplicationProperties {
+		r.properties[k] = v
+	}
+	if ct, ok := e.Catalog.GetTrait(camelTraitID).(*camelTrait); ok {
+		for _, prop := range ct.Properties {
+			k, v := property.SplitPropertyFileEntry(prop)
+			r.properties[k] = v
+		}
+	}
+	if et, ok := e.Catalog.GetTrait(environmentTraitID).(*environmentTrait); ok {
+		for _, env := range et.Vars {
+			name, value, _ := strings.Cut(env, "=")
+			r.envVars[strings.TrimSpace(name)] = value
+		}
+	}
+	if kt, ok := e.Catalog.GetTrait(kameletsTraitID).(*kameletsTrait); ok {
+		r.kamelets = kt.kamelets
+	}
+	if mt, ok := e.Catalog.GetTrait(mountTraitID).(*mountTrait); ok {
+		for _, c := range mt.Configs {
+			conf, err := utilResource.ParseConfig(c)
+			if err != nil {
+				return nil, err
+			}
+			if err := r.addResource(e, conf); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &r, nil
+}
+
+// addResource registers the keys of a mounted ConfigMap or Secret, including the properties defined in any `.properties` key.
+// A ConfigMap or Secret which does not exist is registered as missing, so that none of its placeholders is resolved.
+func (r *propertyResolver) addResource(e *Environment, conf *utilResource.Config) error {
+	id := fmt.Sprintf("%s:%s", conf.StorageType(), conf.Name())
+	data := make(map[string]string)
+	switch conf.StorageType() {
+	case utilResource.StorageTypeConfigmap:
+		cm, err := kubernetes.GetConfigMap(e.Ctx, e.Client, conf.Name(), e.Integration.Namespace)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		if cm == nil {
+			r.addMissing(id)
+
+			return nil
+		}
+		for k, v := range cm.Data {
+			data[k] = v
+		}
+		for k := range cm.BinaryData {
+			data[k] = ""
+		}
+	case utilResource.StorageTypeSecret:
+		secret, err := kubernetes.GetSecret(e.Ctx, e.Client, conf.Name(), e.Integration.Namespace)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		if secret == nil {
+			r.addMissing(id)
+
+			return nil
+		}
+		for k, v := range secret.Data {
+			data[k] = string(v)
+		}
+		for k, v := range secret.StringData {
+			data[k] = v
+		}
+	default:
+		return nil
+	}
+
+	if _, ok := r.resources[id]; !ok {
+		r.resources[id] = []string{}
+	}
+	for k, v := range data {
+		if conf.Key() != "" && conf.Key() != k {
+			continue
+		}
+		r.resources[id] = append(r.resources[id], k)
+		if strings.HasSuffix(k, ".properties") {
+			props, err := properties.LoadString(v)
+			if err != nil {
+				return fmt.Errorf("could not parse %s in %s: %w", k, id, err)
+			}
+			for _, pk := range props.Keys() {
+				r.properties[pk] = props.GetString(pk, "")
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *propertyResolver) addMissing(id string) {
+	if !slices.Contains(r.missing, id) {
+		r.missing = append(r.missing, id)
+		slices.Sort(r.missing)
+	}
+}
+
+// resolve returns true if the given placeholder key can be resolved.
+func (r *propertyResolver) resolve(key string) bool {
+	if function, argument, ok := strings.Cut(key, ":"); ok {
+		switch function {
+		case "configmap", "configmap-binary", "secret", "secret-binary":
+			name, k, hasKey := strings.Cut(argument, "/")
+			keys, mounted := r.resources[strings.TrimSuffix(function, "-binary")+":"+name]
+
+			return mounted && (!hasKey || slices.Contains(keys, k))
+		}
+	}
+	if _, ok := r.property(key); ok {
+		return true
+	}
+	_, ok := r.kameletDefault(key)
+
+	return ok
+}
+
+// value returns the value the given placeholder resolves to with the configuration provided, falling back to its
+// inline default. The placeholders resolved by a function other than `env:` are only known at runtime.
+func (r *propertyResolver) value(p source.PropertyPlaceholder) (string, bool) {
+	switch p.Function {
+	case "":
+		if v, ok := r.property(p.Key); ok {
+			return v, true
+		}
+		if v, ok := r.kameletDefault(p.Key); ok {
+			return v, true
+		}
+	case "env":
+		if v, ok := r.envVars[strings.TrimPrefix(p.Key, "env:")]; ok {
+			return v, true
+		}
+	default:
+		return "", false
+	}
+	if p.Default != nil {
+		return *p.Default, true
+	}
+
+	return "", false
+}
+
+// property returns the value of the given property, either configured or mapped to an environment variable.
+func (r *propertyResolver) property(key string) (string, bool) {
+	if v, ok := r.properties[key]; ok {
+		return v, true
+	}
+	v, ok := r.envVars[envVarName(key)]
+
+	return v, ok
+}
+
+// kameletDefault returns the default value of the key if it's a Kamelet property (ie, `camel.kamelet.name.prop` or
+// `camel.kamelet.name.id.prop`) with a default value.
+func (r *propertyResolver) kameletDefault(key string) (string, bool) {
+	name, prop, ok := strings.Cut(strings.TrimPrefix(key, "camel.kamelet."), ".")
+	if !ok || !strings.HasPrefix(key, "camel.kamelet.") {
+		return "", false
+	}
+	kamelet := r.kamelets[name]
+	if kamelet == nil || kamelet.Spec.Definition == nil {
+		return "", false
+	}
+	if _, p, ok := strings.Cut(prop, "."); ok {
+		if def, ok := kamelet.Spec.Definition.Properties[p]; ok {
+			if v, ok := defaultValue(def.Default); ok {
+				return v, true
+			}
+		}
+	}
+	if def, ok := kamelet.Spec.Definition.Properties[prop]; ok {
+		return defaultValue(def.Default)
+	}
+
+	return "", false
+}
+
+func defaultValue(value *v1.JSON) (string, bool) {
+	if value == nil || len(value.RawMessage) == 0 {
+		return "", false
+	}
+	var v any
+	if json.Unmarshal(value.RawMessage, &v) != nil || v == nil {
+		return "", false
+	}
+	if s, ok := v.(string); ok {
+		return s, true
+	}
+
+	return string(value.RawMessage), true
+}
+
+// envVarName returns the name of the environment variable mapped to the given property, ie, `MY_PROP` for `my.prop`.
+func envVarName(key string) string {
+	return envVarNameRegexp.ReplaceAllString(strings.ToUpper(key), "_")
+}
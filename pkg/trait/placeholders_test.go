@@ -0,0 +1,197 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestCheckPropertyPlaceholders(t *testing.T) {
+	route := `
+- from:
+    uri: "timer:tick?period={{my.period:1000}}"
+    steps:
+      - setBody:
+          simple: "{{my.message}} {{my.env.var}} {{from.file}} {{camel.kamelet.my-source.topic}} {{missing}}"
+      - setHeader:
+          name: token
+          simple: "{{secret:my-secret/token}} {{secret:my-secret/missing}} {{configmap:not-mounted/key}}"
+      - setHeader:
+          name: home
+          simple: "{{env:HOME}} {{?optional}}"
+      - to: "log:info"
+`
+	template := `
+- routeTemplate:
+    id: my-template
+    from:
+      uri: "timer:{{name}}"
+      steps:
+        - to: "log:{{name}}"
+`
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-cm",
+		},
+		Data: map[string]string{
+			"app.properties": "from.file=value",
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-secret",
+		},
+		Data: map[string][]byte{
+			"token": []byte("s3cr3t"),
+		},
+	}
+	e := newPlaceholdersTestEnvironment(t, []v1.SourceSpec{
+		v1.NewSourceSpec("route.yaml", route, v1.LanguageYaml),
+		v1.NewSourceSpec("template.yaml", template, v1.LanguageYaml),
+	}, cm, secret)
+
+	ct, ok := e.Catalog.GetTrait(camelTraitID).(*camelTrait)
+	require.True(t, ok)
+	ct.Properties = []string{"my.message=hello"}
+	ct.FailOnUnresolvedProperties = ptr.To(true)
+	et, ok := e.Catalog.GetTrait(environmentTraitID).(*environmentTrait)
+	require.True(t, ok)
+	et.Vars = []string{"MY_ENV_VAR=value"}
+	mt, ok := e.Catalog.GetTrait(mountTraitID).(*mountTrait)
+	require.True(t, ok)
+	mt.Configs = []string{"configmap:my-cm", "secret:my-secret"}
+	kt, ok := e.Catalog.GetTrait(kameletsTraitID).(*kameletsTrait)
+	require.True(t, ok)
+	kt.kamelets = map[string]*v1.Kamelet{
+		"my-source": {
+			Spec: v1.KameletSpec{
+				KameletSpecBase: v1.KameletSpecBase{
+					Definition: &v1.JSONSchemaProps{
+						Properties: map[string]v1.JSONSchemaProp{
+							"topic": {Default: &v1.JSON{RawMessage: []byte(`"my-topic"`)}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	check, err := CheckPropertyPlaceholders(e)
+	require.NoError(t, err)
+	require.NotNil(t, check)
+	assert.True(t, check.FailOnUnresolved)
+	assert.Equal(t, []string{
+		"camel.kamelet.my-source.topic",
+		"configmap:not-mounted/key",
+		"from.file",
+		"missing",
+		"my.env.var",
+		"my.message",
+		"secret:my-secret/missing",
+		"secret:my-secret/token",
+	}, check.Placeholders)
+	assert.Equal(t, []string{
+		"configmap:not-mounted/key",
+		"missing",
+		"secret:my-secret/missing",
+	}, check.Unresolved)
+}
+
+func TestCheckPropertyPlaceholdersMissingResource(t *testing.T) {
+	e := newPlaceholdersTestEnvironment(t, []v1.SourceSpec{
+		v1.NewSourceSpec("route.yaml", `
+- from:
+    uri: "timer:tick"
+    steps:
+      - setBody:
+          simple: "{{secret:my-secret}} {{configmap:my-cm}}"
+      - to: "log:info"
+`, v1.LanguageYaml),
+	})
+	mt, ok := e.Catalog.GetTrait(mountTraitID).(*mountTrait)
+	require.True(t, ok)
+	mt.Configs = []string{"secret:my-secret", "configmap:my-cm"}
+
+	check, err := CheckPropertyPlaceholders(e)
+	require.NoError(t, err)
+	require.NotNil(t, check)
+	assert.Equal(t, []string{"configmap:my-cm", "secret:my-secret"}, check.Placeholders)
+	assert.Equal(t, []string{"configmap:my-cm", "secret:my-secret"}, check.Unresolved)
+	assert.Equal(t, []string{"configmap:my-cm", "secret:my-secret"}, check.Missing)
+}
+
+func TestCheckPropertyPlaceholdersNone(t *testing.T) {
+	e := newPlaceholdersTestEnvironment(t, []v1.SourceSpec{
+		v1.NewSourceSpec("route.yaml", `
+- from:
+    uri: "timer:tick"
+    steps:
+      - to: "log:info"
+`, v1.LanguageYaml),
+	})
+
+	check, err := CheckPropertyPlaceholders(e)
+	require.NoError(t, err)
+	require.NotNil(t, check)
+	assert.Empty(t, check.Placeholders)
+	assert.Empty(t, check.Unresolved)
+	assert.False(t, check.FailOnUnresolved)
+}
+
+func newPlaceholdersTestEnvironment(t *testing.T, sources []v1.SourceSpec, objects ...runtime.Object) *Environment {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	client, err := internal.NewFakeClient(objects...)
+	require.NoError(t, err)
+
+	return &Environment{
+		Ctx:          t.Context(),
+		Client:       client,
+		Catalog:      NewCatalog(client),
+		CamelCatalog: catalog,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "it",
+			},
+			Spec: v1.IntegrationSpec{
+				Sources: sources,
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseInitialization,
+			},
+		},
+		Resources: kubernetes.NewCollection(),
+	}
+}
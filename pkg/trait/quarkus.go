@@ -146,7 +146,12 @@ func (t *quarkusTrait) Matches(trait Trait) bool {
 		otherNativeBaseImage = QuarkusNativeDefaultBaseImageName
 	}
 
-	return thisNativeBaseImage == otherNativeBaseImage
+	if thisNativeBaseImage != otherNativeBaseImage {
+		return false
+	}
+
+	// The AppCDS archive is part of the kit
+	return ptr.Deref(t.AppCDS, false) == ptr.Deref(qt.AppCDS, false)
 }
 
 func (t *quarkusTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
@@ -417,6 +422,9 @@ func (t *quarkusTrait) applyWhenBuildSubmitted(e *Environment) error {
 		// Default, if nothing is specified
 		buildTask.Maven.Properties["quarkus.package.jar.type"] = string(fastJarPackageType)
 		packageSteps = append(packageSteps, builder.Quarkus.ComputeQuarkusDependencies)
+		if ptr.Deref(t.AppCDS, false) {
+			packageSteps = append(packageSteps, builder.Quarkus.GenerateAppCDSArchive)
+		}
 		if t.isIncrementalImageBuild(e) {
 			packageSteps = append(packageSteps, builder.Image.IncrementalImageContext)
 		} else {
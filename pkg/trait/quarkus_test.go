@@ -24,6 +24,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/boolean"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 
@@ -58,6 +59,27 @@ func TestConfigureQuarkusTraitBuildSubmitted(t *testing.T) {
 	assert.Len(t, packageTask.Steps, 4)
 }
 
+func TestConfigureQuarkusTraitAppCDS(t *testing.T) {
+	quarkusTrait, environment := createNominalQuarkusTest()
+	quarkusTrait.AppCDS = ptr.To(true)
+	environment.IntegrationKit.Status.Phase = v1.IntegrationKitPhaseBuildSubmitted
+
+	configured, _, err := quarkusTrait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configured)
+	require.NoError(t, quarkusTrait.Apply(environment))
+
+	packageTask := getPackageTask(environment.Pipeline)
+	require.NotNil(t, packageTask)
+	assert.Equal(t, builder.StepIDsFor(
+		builder.Quarkus.LoadCamelQuarkusCatalog,
+		builder.Quarkus.ComputeQuarkusDependencies,
+		builder.Quarkus.GenerateAppCDSArchive,
+		builder.Image.IncrementalImageContext,
+		builder.Image.JvmDockerfile,
+	), packageTask.Steps)
+}
+
 func TestConfigureQuarkusTraitNativeNotSupported(t *testing.T) {
 	quarkusTrait, environment := createNominalQuarkusTest()
 	// Set a source not supporting Quarkus native
@@ -219,6 +241,11 @@ func TestQuarkusMatches(t *testing.T) {
 	assert.True(t, qt.Matches(&qt2))
 	qt2.NativeBaseImage = "docker.io/my-new-native-base"
 	assert.False(t, qt.Matches(&qt2))
+	qt2.NativeBaseImage = ""
+	qt2.AppCDS = ptr.To(true)
+	assert.False(t, qt.Matches(&qt2))
+	qt.AppCDS = ptr.To(true)
+	assert.True(t, qt.Matches(&qt2))
 }
 
 func TestConfigureQuarkusTraitDeprecatedPackageType(t *testing.T) {
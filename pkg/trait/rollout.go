@@ -0,0 +1,830 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	serving "knative.dev/serving/pkg/apis/serving/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	kubeutil "github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	rolloutTraitID    = "rollout"
+	rolloutTraitOrder = 2450
+
+	// rolloutLabel distinguishes the pods of the stable version from the ones of the version being delivered.
+	rolloutLabel = "camel.apache.org/rollout"
+	// rolloutRevisionLabel identifies the revision of the Integration, derived from its digest, of the pods and of the
+	// ConfigMaps copied for each version.
+	rolloutRevisionLabel = "camel.apache.org/rollout-revision"
+	rolloutStable        = "stable"
+	rolloutCanary        = "canary"
+	rolloutCanarySuffix  = "-canary"
+	rolloutStableSuffix  = "-stable"
+
+	knativeRevisionLabel = "serving.knative.dev/revision"
+
+	defaultRolloutStepDuration     = time.Minute
+	defaultRolloutProgressDeadline = 10 * time.Minute
+	defaultRolloutMaxFailureRate   = int32(5)
+	defaultRolloutMinExchanges     = int32(10)
+	// rolloutHoldInterval is the delay before a held analysis is run again.
+	rolloutHoldInterval       = 10 * time.Second
+	defaultRolloutMetricsPort = int32(8080)
+	defaultRolloutMetricsPath = "/q/metrics"
+
+	camelExchangesTotalMetric       = "camel_exchanges_total"
+	camelExchangesFailedTotalMetric = "camel_exchanges_failed_total"
+	camelRoutePolicyMetric          = "camel_route_policy_seconds"
+)
+
+// errRolloutAnalysisHeld reports that the version being delivered can't be analysed yet.
+var errRolloutAnalysisHeld = errors.New("analysis held")
+
+// scrapeRolloutMetrics returns the metric families exposed by the given pod, proxied by the API server,
+// so that the operator does not have to reach the pod network.
+func scrapeRolloutMetrics(ctx context.Context, c kubernetes.Interface, pod *corev1.Pod, port int32, path string) (map[string]*dto.MetricFamily, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	data, err := c.CoreV1().
+		Pods(pod.Namespace).
+		ProxyGet("http", pod.Name, strconv.Itoa(int(port)), path, nil).
+		DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+
+	return parser.TextToMetricFamilies(bytes.NewReader(data))
+}
+
+type rolloutTrait struct {
+	BaseTrait
+	traitv1.RolloutTrait `property:",squash"`
+
+	strategy         ControllerStrategy
+	stepDuration     time.Duration
+	progressDeadline time.Duration
+}
+
+func newRolloutTrait() Trait {
+	return &rolloutTrait{
+		BaseTrait: NewBaseTrait(rolloutTraitID, rolloutTraitOrder),
+	}
+}
+
+func (t *rolloutTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil {
+		return false, nil, nil
+	}
+	if !ptr.Deref(t.Enabled, false) {
+		e.Integration.Status.Rollout = nil
+
+		return false, nil, nil
+	}
+	if !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return false, nil, errors.New("unable to determine the controller strategy")
+	}
+	if strategy == ControllerStrategyCronJob || strategy == ControllerStrategyStatefulSet || strategy == ControllerStrategyJob {
+		return false, nil, fmt.Errorf("rollout isn't supported with %s controller strategy", strategy)
+	}
+	t.strategy = strategy
+
+	t.stepDuration = defaultRolloutStepDuration
+	if t.StepDuration != "" {
+		if t.stepDuration, err = time.ParseDuration(t.StepDuration); err != nil {
+			return false, nil, fmt.Errorf("invalid rollout step duration %q: %w", t.StepDuration, err)
+		}
+	}
+	t.progressDeadline = max(defaultRolloutProgressDeadline, t.stepDuration)
+	if t.ProgressDeadline != "" {
+		if t.progressDeadline, err = time.ParseDuration(t.ProgressDeadline); err != nil {
+			return false, nil, fmt.Errorf("invalid rollout progress deadline %q: %w", t.ProgressDeadline, err)
+		}
+		if t.progressDeadline < t.stepDuration {
+			return false, nil, fmt.Errorf("invalid rollout progress deadline %q: it must not be shorter than the step duration", t.ProgressDeadline)
+		}
+	}
+	previous := int32(0)
+	for _, step := range t.getSteps() {
+		if step <= previous || step > 100 {
+			return false, nil, fmt.Errorf("invalid rollout steps %v: the percentages must be ascending and between 1 and 100", t.Steps)
+		}
+		previous = step
+	}
+
+	return true, nil, nil
+}
+
+func (t *rolloutTrait) Apply(e *Environment) error {
+	wasProgressing := t.isProgressing(e.Integration)
+	if err := t.reconcileStatus(e); err != nil {
+		return err
+	}
+	t.setCondition(e.Integration)
+
+	switch t.strategy {
+	case ControllerStrategyKnativeService:
+		if err := t.applyKnativeService(e); err != nil {
+			return err
+		}
+	default:
+		if err := t.applyDeployment(e); err != nil {
+			return err
+		}
+	}
+	if wasProgressing && !t.isProgressing(e.Integration) {
+		// Register a post action that deletes the resources of the version that is no longer running
+		e.PostActions = append(e.PostActions, t.deleteCanaryResources)
+	}
+
+	return nil
+}
+
+func (t *rolloutTrait) getSteps() []int32 {
+	if len(t.Steps) > 0 {
+		return t.Steps
+	}
+	if t.Strategy == traitv1.RolloutStrategyBlueGreen {
+		return []int32{100}
+	}
+
+	return []int32{10, 25, 50}
+}
+
+func (t *rolloutTrait) isProgressing(it *v1.Integration) bool {
+	return it.Status.Rollout != nil && it.Status.Rollout.Phase == v1.RolloutPhaseProgressing
+}
+
+// reconcileStatus moves the rollout forward, starting a new one when the Integration digest changes,
+// and analysing the version being delivered at the end of each step.
+func (t *rolloutTrait) reconcileStatus(e *Environment) error {
+	digest := e.Integration.Status.Digest
+	image := e.Integration.Status.Image
+	status := e.Integration.Status.Rollout
+	if status == nil || status.StableDigest == "" {
+		// the first deployed version is the stable one
+		e.Integration.Status.Rollout = &v1.RolloutStatus{
+			Phase:        v1.RolloutPhasePromoted,
+			StableDigest: digest,
+			StableImage:  image,
+		}
+
+		return nil
+	}
+
+	now := metav1.Now()
+	steps := t.getSteps()
+	switch {
+	case digest == status.StableDigest:
+		// the container image changes with the same digest when the Integration kit is rebuilt
+		status.StableImage = image
+		if status.Phase == v1.RolloutPhaseProgressing {
+			status.Phase = v1.RolloutPhaseAborted
+			status.CanaryDigest = ""
+			status.CanaryImage = ""
+			status.Weight = 0
+			status.StepStartTime = nil
+			status.NextAnalysisTime = nil
+			status.Message = "the Integration was reverted to the stable version"
+		}
+	case digest != status.CanaryDigest:
+		if t.strategy == ControllerStrategyKnativeService {
+			revision, err := t.getLatestReadyRevision(e)
+			if err != nil {
+				return err
+			}
+			if revision == "" {
+				// there is no revision to shift the traffic from
+				status.Phase = v1.RolloutPhasePromoted
+				status.StableDigest = digest
+				status.StableImage = image
+				status.Message = "no stable revision available: the new version is promoted"
+
+				return nil
+			}
+			status.StableRevision = revision
+		}
+		status.Phase = v1.RolloutPhaseProgressing
+		status.CanaryDigest = digest
+		status.CanaryImage = image
+		status.Step = 0
+		status.Weight = steps[0]
+		status.StepStartTime = &now
+		status.NextAnalysisTime = new(metav1.NewTime(now.Add(t.stepDuration)))
+		status.Message = fmt.Sprintf("shifting %d%% of the traffic to the new version", status.Weight)
+	case status.Phase == v1.RolloutPhaseProgressing && status.NextAnalysisTime != nil && !now.Before(status.NextAnalysisTime):
+		status.CanaryImage = image
+		err := t.analyse(e)
+		switch {
+		case errors.Is(err, errRolloutAnalysisHeld) && status.StepStartTime != nil && now.Sub(status.StepStartTime.Time) < t.progressDeadline:
+			status.NextAnalysisTime = new(metav1.NewTime(now.Add(min(rolloutHoldInterval, t.stepDuration))))
+			status.Message = fmt.Sprintf("holding the analysis with %d%% of the traffic shifted to the new version: %s", status.Weight, err.Error())
+
+			return nil
+		case errors.Is(err, errRolloutAnalysisHeld):
+			t.abort(status, fmt.Sprintf("the new version could not be analysed within the progress deadline of step %d: %s", status.Step+1, err.Error()))
+
+			return nil
+		case err != nil:
+			t.abort(status, fmt.Sprintf("the new version failed the analysis of step %d: %s", status.Step+1, err.Error()))
+
+			return nil
+		}
+		if int(status.Step)+1 >= len(steps) {
+			status.Phase = v1.RolloutPhasePromoted
+			status.StableDigest = status.CanaryDigest
+			status.StableImage = status.CanaryImage
+			status.StableRevision = ""
+			status.CanaryDigest = ""
+			status.CanaryImage = ""
+			status.Step = 0
+			status.Weight = 0
+			status.StepStartTime = nil
+			status.NextAnalysisTime = nil
+			status.Message = "the new version passed the analysis of every step"
+
+			return nil
+		}
+		status.Step++
+		status.Weight = steps[status.Step]
+		status.StepStartTime = &now
+		status.NextAnalysisTime = new(metav1.NewTime(now.Add(t.stepDuration)))
+		status.Message = fmt.Sprintf("shifting %d%% of the traffic to the new version", status.Weight)
+	default:
+		// the container image changes with the same digest when the Integration kit is rebuilt
+		status.CanaryImage = image
+	}
+
+	return nil
+}
+
+// abort stops the delivery of the new version, the stable version running alone until the Integration digest changes.
+func (t *rolloutTrait) abort(status *v1.RolloutStatus, message string) {
+	status.Phase = v1.RolloutPhaseAborted
+	status.Weight = 0
+	status.StepStartTime = nil
+	status.NextAnalysisTime = nil
+	status.Message = message
+}
+
+func (t *rolloutTrait) setCondition(it *v1.Integration) {
+	status := it.Status.Rollout
+	switch status.Phase {
+	case v1.RolloutPhaseProgressing:
+		it.Status.SetCondition(v1.IntegrationConditionRollout, corev1.ConditionFalse,
+			v1.IntegrationConditionRolloutProgressingReason,
+			fmt.Sprintf("step %d/%d: %s", status.Step+1, len(t.getSteps()), status.Message))
+	case v1.RolloutPhaseAborted:
+		it.Status.SetCondition(v1.IntegrationConditionRollout, corev1.ConditionFalse,
+			v1.IntegrationConditionRolloutAbortedReason, status.Message)
+	default:
+		message := status.Message
+		if message == "" {
+			message = "running the stable version"
+		}
+		it.Status.SetCondition(v1.IntegrationConditionRollout, corev1.ConditionTrue,
+			v1.IntegrationConditionRolloutPromotedReason, message)
+	}
+}
+
+// analyse verifies the exchanges failure rate and mean processing time of the version being delivered. The analysis is
+// held, returning errRolloutAnalysisHeld, until the pods of the new version are ready and have processed enough exchanges.
+func (t *rolloutTrait) analyse(e *Environment) error {
+	pods, err := t.getCanaryPods(e)
+	if err != nil {
+		return err
+	}
+	ready := make([]*corev1.Pod, 0, len(pods))
+	for i := range pods {
+		if condition := kubeutil.GetPodCondition(pods[i], corev1.PodReady); condition != nil && condition.Status == corev1.ConditionTrue {
+			ready = append(ready, &pods[i])
+		}
+	}
+	expected := max(len(pods), 1)
+	if t.strategy != ControllerStrategyKnativeService {
+		expected = int(t.getCanaryReplicas(e))
+	}
+	if len(ready) < expected {
+		return fmt.Errorf("%w: %d/%d pods of the new version are ready", errRolloutAnalysisHeld, len(ready), expected)
+	}
+
+	port := ptr.Deref(t.MetricsPort, defaultRolloutMetricsPort)
+	path := t.MetricsPath
+	if path == "" {
+		path = defaultRolloutMetricsPath
+	}
+	var total, failed, latencyCount, latencySum float64
+	for _, pod := range ready {
+		families, err := scrapeRolloutMetrics(e.Ctx, e.Client, pod, port, path)
+		if err != nil {
+			return fmt.Errorf("could not scrape the metrics of pod %s: %w", pod.Name, err)
+		}
+		total += sumCounter(families[camelExchangesTotalMetric])
+		failed += sumCounter(families[camelExchangesFailedTotalMetric])
+		count, sum := sumObservations(families[camelRoutePolicyMetric])
+		latencyCount += count
+		latencySum += sum
+	}
+
+	if minExchanges := ptr.Deref(t.MinExchanges, defaultRolloutMinExchanges); total < float64(minExchanges) {
+		return fmt.Errorf("%w: %.0f/%d exchanges processed by the new version", errRolloutAnalysisHeld, total, minExchanges)
+	}
+	if total > 0 {
+		maxFailureRate := ptr.Deref(t.MaxFailureRate, defaultRolloutMaxFailureRate)
+		if rate := failed * 100 / total; rate > float64(maxFailureRate) {
+			return fmt.Errorf("failure rate %.2f%% exceeds %d%%", rate, maxFailureRate)
+		}
+	}
+	if t.MaxLatency != nil && latencyCount > 0 {
+		if latency := latencySum * 1000 / latencyCount; latency > float64(*t.MaxLatency) {
+			return fmt.Errorf("mean processing time %.0fms exceeds %dms", latency, *t.MaxLatency)
+		}
+	}
+
+	return nil
+}
+
+// getCanaryPods returns the running pods of the version being delivered.
+func (t *rolloutTrait) getCanaryPods(e *Environment) ([]corev1.Pod, error) {
+	status := e.Integration.Status.Rollout
+	selector := ctrl.MatchingLabels{v1.IntegrationLabel: e.Integration.Name}
+	if t.strategy != ControllerStrategyKnativeService {
+		selector[rolloutLabel] = rolloutCanary
+		selector[rolloutRevisionLabel] = rolloutRevision(status.CanaryDigest)
+	}
+	list := &corev1.PodList{}
+	if err := e.Client.List(e.Ctx, list, ctrl.InNamespace(e.Integration.Namespace), selector); err != nil {
+		return nil, err
+	}
+
+	pods := make([]corev1.Pod, 0, len(list.Items))
+	for _, pod := range list.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if t.strategy == ControllerStrategyKnativeService && pod.Labels[knativeRevisionLabel] == status.StableRevision {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+
+	return pods, nil
+}
+
+// rolloutRevision returns the revision of the Integration version with the given digest, used to label its pods and
+// to name the copies of its ConfigMaps.
+func rolloutRevision(digest string) string {
+	sum := sha256.Sum256([]byte(digest))
+
+	return hex.EncodeToString(sum[:5])
+}
+
+func sumCounter(family *dto.MetricFamily) float64 {
+	var total float64
+	if family == nil {
+		return total
+	}
+	for _, m := range family.GetMetric() {
+		total += m.GetCounter().GetValue()
+	}
+
+	return total
+}
+
+func sumObservations(family *dto.MetricFamily) (float64, float64) {
+	var count, sum float64
+	if family == nil {
+		return count, sum
+	}
+	for _, m := range family.GetMetric() {
+		if s := m.GetSummary(); s != nil {
+			count += float64(s.GetSampleCount())
+			sum += s.GetSampleSum()
+		} else if h := m.GetHistogram(); h != nil {
+			count += float64(h.GetSampleCount())
+			sum += h.GetSampleSum()
+		}
+	}
+
+	return count, sum
+}
+
+// getLatestReadyRevision returns the latest ready revision of the existing Knative Service, if any.
+func (t *rolloutTrait) getLatestReadyRevision(e *Environment) (string, error) {
+	ksvc := &serving.Service{}
+	key := ctrl.ObjectKey{Namespace: e.Integration.Namespace, Name: e.Integration.Name}
+	if err := e.Client.Get(e.Ctx, key, ksvc); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return ksvc.Status.LatestReadyRevisionName, nil
+}
+
+// applyKnativeService splits the traffic between the stable revision and the latest one, each revision mounting the
+// copy of the ConfigMaps of its own version.
+func (t *rolloutTrait) applyKnativeService(e *Environment) error {
+	ksvc := e.Resources.GetKnativeService(func(s *serving.Service) bool {
+		return s.Name == e.Integration.Name
+	})
+	if ksvc == nil {
+		return nil
+	}
+	status := e.Integration.Status.Rollout
+	revision := rolloutRevision(e.Integration.Status.Digest)
+	setRolloutLabels(&ksvc.Spec.Template.ObjectMeta, "", revision)
+	if err := t.versionConfiguration(e, &ksvc.Spec.Template.Spec.PodSpec, revision); err != nil {
+		return err
+	}
+	if status.Phase == v1.RolloutPhasePromoted {
+		return nil
+	}
+	if stableRevision := rolloutRevision(status.StableDigest); stableRevision != revision {
+		// the stable Knative revision keeps mounting the copy of the ConfigMaps of its version
+		if _, err := t.keepConfiguration(e, stableRevision); err != nil {
+			return err
+		}
+	}
+	if status.StableRevision == "" {
+		return nil
+	}
+
+	traffic := []serving.TrafficTarget{
+		{
+			RevisionName:   status.StableRevision,
+			LatestRevision: ptr.To(false),
+			Percent:        ptr.To(int64(100 - status.Weight)),
+		},
+	}
+	if status.Phase == v1.RolloutPhaseProgressing {
+		traffic = append(traffic, serving.TrafficTarget{
+			LatestRevision: ptr.To(true),
+			Percent:        ptr.To(int64(status.Weight)),
+		})
+	}
+	ksvc.Spec.Traffic = traffic
+
+	return nil
+}
+
+// applyDeployment runs the stable and the new version in two Deployments, distributing the replicas
+// according to the rollout weight, and splits the HTTP traffic when the Integration is exposed by a Gateway.
+// Each Deployment selects the pods of its version, which mount the copy of the ConfigMaps of their revision.
+func (t *rolloutTrait) applyDeployment(e *Environment) error {
+	deployment := e.Resources.GetDeployment(func(d *appsv1.Deployment) bool {
+		return d.Name == e.Integration.Name
+	})
+	if deployment == nil {
+		return nil
+	}
+	status := e.Integration.Status.Rollout
+	revision := rolloutRevision(e.Integration.Status.Digest)
+	stableRevision := rolloutRevision(status.StableDigest)
+	deployment.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			v1.IntegrationLabel: e.Integration.Name,
+			rolloutLabel:        rolloutStable,
+		},
+	}
+
+	if status.Phase == v1.RolloutPhasePromoted || status.StableImage == "" {
+		setRolloutLabels(&deployment.Spec.Template.ObjectMeta, rolloutStable, revision)
+
+		return t.versionConfiguration(e, &deployment.Spec.Template.Spec, revision)
+	}
+
+	replicas := ptr.Deref(e.Integration.Spec.Replicas, 1)
+	canaryReplicas := t.getCanaryReplicas(e)
+	if status.Phase == v1.RolloutPhaseProgressing {
+		canary := t.getCanaryDeployment(e, deployment, canaryReplicas, revision)
+		if err := t.versionConfiguration(e, &canary.Spec.Template.Spec, revision); err != nil {
+			return err
+		}
+		e.Resources.Add(canary)
+	}
+	stableReplicas := replicas - canaryReplicas
+	if status.Weight < 100 {
+		stableReplicas = max(stableReplicas, 1)
+	}
+	deployment.Spec.Replicas = &stableReplicas
+	setRolloutLabels(&deployment.Spec.Template.ObjectMeta, rolloutStable, stableRevision)
+	if err := t.restoreConfiguration(e, &deployment.Spec.Template.Spec, stableRevision, revision); err != nil {
+		return err
+	}
+	t.setContainerImage(e, &deployment.Spec.Template.Spec, status.StableImage)
+
+	if status.Phase == v1.RolloutPhaseProgressing {
+		t.splitHTTPTraffic(e, status.Weight, stableRevision, revision)
+	}
+
+	return nil
+}
+
+// getCanaryReplicas returns the replicas of the version being delivered, according to the weight of the current step.
+func (t *rolloutTrait) getCanaryReplicas(e *Environment) int32 {
+	status := e.Integration.Status.Rollout
+	if status.Phase != v1.RolloutPhaseProgressing {
+		return 0
+	}
+	replicas := ptr.Deref(e.Integration.Spec.Replicas, 1)
+
+	return min(max((replicas*status.Weight+99)/100, 1), replicas)
+}
+
+func (t *rolloutTrait) getCanaryDeployment(e *Environment, deployment *appsv1.Deployment, replicas int32, revision string) *appsv1.Deployment {
+	canary := deployment.DeepCopy()
+	canary.Name = deployment.Name + rolloutCanarySuffix
+	canary.Spec.Replicas = &replicas
+	canary.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			v1.IntegrationLabel:  e.Integration.Name,
+			rolloutLabel:         rolloutCanary,
+			rolloutRevisionLabel: revision,
+		},
+	}
+	setRolloutLabels(&canary.Spec.Template.ObjectMeta, rolloutCanary, revision)
+
+	return canary
+}
+
+func setRolloutLabels(meta *metav1.ObjectMeta, version, revision string) {
+	if meta.Labels == nil {
+		meta.Labels = make(map[string]string)
+	}
+	if version != "" {
+		meta.Labels[rolloutLabel] = version
+	}
+	meta.Labels[rolloutRevisionLabel] = revision
+}
+
+func (t *rolloutTrait) setContainerImage(e *Environment, spec *corev1.PodSpec, image string) {
+	name := e.GetIntegrationContainerName()
+	for i := range spec.Containers {
+		if spec.Containers[i].Name == name {
+			spec.Containers[i].Image = image
+		}
+	}
+}
+
+// versionConfiguration mounts a copy of the ConfigMaps of the pod, named and labelled after the given revision, so
+// that each version of the Integration keeps its own configuration while both versions run side by side.
+func (t *rolloutTrait) versionConfiguration(e *Environment, spec *corev1.PodSpec, revision string) error {
+	for i := range spec.Volumes {
+		source := spec.Volumes[i].ConfigMap
+		if source == nil {
+			continue
+		}
+		name, err := t.versionConfigMap(e, source.Name, revision)
+		if err != nil {
+			return err
+		}
+		source.Name = name
+	}
+
+	return nil
+}
+
+// versionConfigMap copies the given ConfigMap, either generated by the traits or provided by the user, for the given
+// revision and returns the name of the copy. The name of the ConfigMap is returned when it does not exist.
+func (t *rolloutTrait) versionConfigMap(e *Environment, name, revision string) (string, error) {
+	versionedName := name + "-" + revision
+	if e.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool { return cm.Name == versionedName }) != nil {
+		return versionedName, nil
+	}
+	cm := e.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool {
+		return cm.Name == name
+	})
+	if cm == nil {
+		cm = &corev1.ConfigMap{}
+		key := ctrl.ObjectKey{Namespace: e.Integration.Namespace, Name: name}
+		if err := e.Client.Get(e.Ctx, key, cm); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return name, nil
+			}
+
+			return "", fmt.Errorf("could not copy ConfigMap %s for revision %s: %w", name, revision, err)
+		}
+	}
+	e.Resources.Add(t.getVersionConfigMap(e, versionedName, revision, cm))
+
+	return versionedName, nil
+}
+
+func (t *rolloutTrait) getVersionConfigMap(e *Environment, name, revision string, cm *corev1.ConfigMap) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel:  e.Integration.Name,
+				rolloutRevisionLabel: revision,
+			},
+		},
+		Data:       maps.Clone(cm.Data),
+		BinaryData: maps.Clone(cm.BinaryData),
+	}
+}
+
+// keepConfiguration keeps deployed the copies of the ConfigMaps of the given revision and returns their names.
+func (t *rolloutTrait) keepConfiguration(e *Environment, revision string) (map[string]bool, error) {
+	list := &corev1.ConfigMapList{}
+	selector := ctrl.MatchingLabels{v1.IntegrationLabel: e.Integration.Name, rolloutRevisionLabel: revision}
+	if err := e.Client.List(e.Ctx, list, ctrl.InNamespace(e.Integration.Namespace), selector); err != nil {
+		return nil, err
+	}
+	kept := make(map[string]bool, len(list.Items))
+	for i := range list.Items {
+		cm := &list.Items[i]
+		kept[cm.Name] = true
+		if e.Resources.GetConfigMap(func(c *corev1.ConfigMap) bool { return c.Name == cm.Name }) == nil {
+			e.Resources.Add(t.getVersionConfigMap(e, cm.Name, revision, cm))
+		}
+	}
+
+	return kept, nil
+}
+
+// restoreConfiguration mounts the copies of the ConfigMaps of the stable revision, and the copies of the current
+// revision for the ConfigMaps the stable version does not have.
+func (t *rolloutTrait) restoreConfiguration(e *Environment, spec *corev1.PodSpec, revision, currentRevision string) error {
+	if revision == currentRevision {
+		return t.versionConfiguration(e, spec, revision)
+	}
+	kept, err := t.keepConfiguration(e, revision)
+	if err != nil {
+		return err
+	}
+	for i := range spec.Volumes {
+		source := spec.Volumes[i].ConfigMap
+		if source == nil {
+			continue
+		}
+		if name := source.Name + "-" + revision; kept[name] {
+			source.Name = name
+
+			continue
+		}
+		name, err := t.versionConfigMap(e, source.Name, currentRevision)
+		if err != nil {
+			return err
+		}
+		source.Name = name
+	}
+
+	return nil
+}
+
+// splitHTTPTraffic weights the backends of the HTTPRoute created by the gateway trait.
+func (t *rolloutTrait) splitHTTPTraffic(e *Environment, weight int32, stableRevision, canaryRevision string) {
+	service := e.Resources.GetUserServiceForIntegration(e.Integration)
+	if service == nil {
+		return
+	}
+	var route *gwv1.HTTPRoute
+	for _, r := range e.Resources.Items() {
+		if hr, ok := r.(*gwv1.HTTPRoute); ok && hr.Name == e.Integration.Name {
+			route = hr
+		}
+	}
+	if route == nil {
+		return
+	}
+
+	stable := t.getVersionService(service, rolloutStableSuffix, rolloutStable, stableRevision)
+	canary := t.getVersionService(service, rolloutCanarySuffix, rolloutCanary, canaryRevision)
+	e.Resources.Add(stable)
+	e.Resources.Add(canary)
+
+	for i := range route.Spec.Rules {
+		rule := &route.Spec.Rules[i]
+		refs := make([]gwv1.HTTPBackendRef, 0, 2*len(rule.BackendRefs))
+		for _, ref := range rule.BackendRefs {
+			if string(ref.Name) != service.Name {
+				refs = append(refs, ref)
+
+				continue
+			}
+			stableRef := *ref.DeepCopy()
+			stableRef.Name = gwv1.ObjectName(stable.Name)
+			stableRef.Weight = ptr.To(100 - weight)
+			canaryRef := *ref.DeepCopy()
+			canaryRef.Name = gwv1.ObjectName(canary.Name)
+			canaryRef.Weight = ptr.To(weight)
+			refs = append(refs, stableRef, canaryRef)
+		}
+		rule.BackendRefs = refs
+	}
+}
+
+func (t *rolloutTrait) getVersionService(service *corev1.Service, suffix, version, revision string) *corev1.Service {
+	svc := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      service.Name + suffix,
+			Namespace: service.Namespace,
+			Labels:    maps.Clone(service.Labels),
+		},
+		Spec: corev1.ServiceSpec{
+			Ports:    service.Spec.Ports,
+			Selector: maps.Clone(service.Spec.Selector),
+		},
+	}
+	if svc.Spec.Selector == nil {
+		svc.Spec.Selector = make(map[string]string)
+	}
+	svc.Spec.Selector[rolloutLabel] = version
+	svc.Spec.Selector[rolloutRevisionLabel] = revision
+
+	return svc
+}
+
+// deleteCanaryResources deletes the Deployment and Services of the version that was being delivered, and the copies
+// of the ConfigMaps of the versions other than the stable one.
+func (t *rolloutTrait) deleteCanaryResources(e *Environment) error {
+	namespace := e.Integration.Namespace
+	var resources []ctrl.Object
+	if t.strategy != ControllerStrategyKnativeService {
+		resources = append(resources,
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: e.Integration.Name + rolloutCanarySuffix}},
+		)
+		if service := e.Resources.GetUserServiceForIntegration(e.Integration); service != nil {
+			resources = append(resources,
+				&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: service.Name + rolloutStableSuffix}},
+				&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: service.Name + rolloutCanarySuffix}},
+			)
+		}
+	}
+	configmaps := &corev1.ConfigMapList{}
+	selector := ctrl.MatchingLabels{v1.IntegrationLabel: e.Integration.Name}
+	if err := e.Client.List(e.Ctx, configmaps, ctrl.InNamespace(namespace), selector, ctrl.HasLabels{rolloutRevisionLabel}); err != nil {
+		return err
+	}
+	stableRevision := rolloutRevision(e.Integration.Status.Rollout.StableDigest)
+	for i := range configmaps.Items {
+		if configmaps.Items[i].Labels[rolloutRevisionLabel] != stableRevision {
+			resources = append(resources, &configmaps.Items[i])
+		}
+	}
+	for _, resource := range resources {
+		if err := e.Client.Delete(e.Ctx, resource); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("could not delete %s: %w", resource.GetName(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,546 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	restclient "k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestRolloutTraitDisabled(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t)
+	trait.Enabled = nil
+	environment.Integration.Status.Rollout = &v1.RolloutStatus{Phase: v1.RolloutPhasePromoted}
+
+	configured, _, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.False(t, configured)
+	assert.Nil(t, environment.Integration.Status.Rollout)
+}
+
+func TestRolloutTraitInvalidConfiguration(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t)
+	trait.Steps = []int32{50, 20}
+	_, _, err := trait.Configure(environment)
+	require.Error(t, err)
+
+	trait, environment = createNominalRolloutTest(t)
+	trait.StepDuration = "soon"
+	_, _, err = trait.Configure(environment)
+	require.Error(t, err)
+
+	trait, environment = createNominalRolloutTest(t)
+	trait.StepDuration = "5m"
+	trait.ProgressDeadline = "2m"
+	_, _, err = trait.Configure(environment)
+	require.Error(t, err)
+}
+
+func TestRolloutTraitFirstDeployment(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t)
+	environment.Integration.Status.Rollout = nil
+
+	applyRolloutTrait(t, trait, environment)
+
+	rollout := environment.Integration.Status.Rollout
+	require.NotNil(t, rollout)
+	assert.Equal(t, v1.RolloutPhasePromoted, rollout.Phase)
+	assert.Equal(t, "digest-v2", rollout.StableDigest)
+	assert.Equal(t, "my-image:v2", rollout.StableImage)
+	assert.Len(t, deployments(environment), 1)
+	stable := rolloutDeployment(environment, "my-it")
+	assert.Equal(t, rolloutStable, stable.Spec.Selector.MatchLabels[rolloutLabel])
+	assert.Equal(t, rolloutStable, stable.Spec.Template.Labels[rolloutLabel])
+	assert.Equal(t, rolloutRevision("digest-v2"), stable.Spec.Template.Labels[rolloutRevisionLabel])
+	assert.Equal(t, "my-it-properties-"+rolloutRevision("digest-v2"), stable.Spec.Template.Spec.Volumes[0].ConfigMap.Name)
+	assert.Equal(t, corev1.ConditionTrue, environment.Integration.Status.GetCondition(v1.IntegrationConditionRollout).Status)
+}
+
+func TestRolloutTraitStartCanary(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t)
+
+	applyRolloutTrait(t, trait, environment)
+
+	rollout := environment.Integration.Status.Rollout
+	assert.Equal(t, v1.RolloutPhaseProgressing, rollout.Phase)
+	assert.Equal(t, "digest-v1", rollout.StableDigest)
+	assert.Equal(t, "digest-v2", rollout.CanaryDigest)
+	assert.Equal(t, "my-image:v1", rollout.StableImage)
+	assert.Equal(t, "my-image:v2", rollout.CanaryImage)
+	assert.Equal(t, int32(10), rollout.Weight)
+	assert.NotNil(t, rollout.StepStartTime)
+	assert.NotNil(t, rollout.NextAnalysisTime)
+
+	stableRevision := rolloutRevision("digest-v1")
+	canaryRevision := rolloutRevision("digest-v2")
+	stable := rolloutDeployment(environment, "my-it")
+	assert.Equal(t, int32(9), *stable.Spec.Replicas)
+	assert.Equal(t, "my-image:v1", stable.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, map[string]string{v1.IntegrationLabel: "my-it", rolloutLabel: rolloutStable}, stable.Spec.Selector.MatchLabels)
+	assert.Equal(t, stableRevision, stable.Spec.Template.Labels[rolloutRevisionLabel])
+	canary := rolloutDeployment(environment, "my-it-canary")
+	require.NotNil(t, canary)
+	assert.Equal(t, int32(1), *canary.Spec.Replicas)
+	assert.Equal(t, "my-image:v2", canary.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, map[string]string{
+		v1.IntegrationLabel:  "my-it",
+		rolloutLabel:         rolloutCanary,
+		rolloutRevisionLabel: canaryRevision,
+	}, canary.Spec.Selector.MatchLabels)
+	assert.Equal(t, rolloutCanary, canary.Spec.Template.Labels[rolloutLabel])
+	assert.Equal(t, canaryRevision, canary.Spec.Template.Labels[rolloutRevisionLabel])
+
+	// each version mounts the copy of the configuration of its revision
+	assert.Equal(t, "my-it-properties-"+stableRevision, stable.Spec.Template.Spec.Volumes[0].ConfigMap.Name)
+	assert.Equal(t, "my-it-properties-"+canaryRevision, canary.Spec.Template.Spec.Volumes[0].ConfigMap.Name)
+	stableConfig := rolloutConfigMap(environment, "my-it-properties-"+stableRevision)
+	require.NotNil(t, stableConfig)
+	assert.Equal(t, "my.message=v1", stableConfig.Data["application.properties"])
+	canaryConfig := rolloutConfigMap(environment, "my-it-properties-"+canaryRevision)
+	require.NotNil(t, canaryConfig)
+	assert.Equal(t, "my.message=v2", canaryConfig.Data["application.properties"])
+	assert.Equal(t, canaryRevision, canaryConfig.Labels[rolloutRevisionLabel])
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionRollout)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionRolloutProgressingReason, condition.Reason)
+}
+
+func TestRolloutTraitConfigurationChange(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t)
+	environment.Integration.Status.Image = "my-image:v1"
+	rolloutDeployment(environment, "my-it").Spec.Template.Spec.Containers[0].Image = "my-image:v1"
+
+	applyRolloutTrait(t, trait, environment)
+
+	rollout := environment.Integration.Status.Rollout
+	assert.Equal(t, v1.RolloutPhaseProgressing, rollout.Phase)
+	assert.Equal(t, "digest-v2", rollout.CanaryDigest)
+	stable := rolloutDeployment(environment, "my-it")
+	canary := rolloutDeployment(environment, "my-it-canary")
+	require.NotNil(t, canary)
+	assert.Equal(t, "my-image:v1", stable.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, "my-image:v1", canary.Spec.Template.Spec.Containers[0].Image)
+	assert.NotEqual(t, stable.Spec.Template.Spec.Volumes[0].ConfigMap.Name, canary.Spec.Template.Spec.Volumes[0].ConfigMap.Name)
+}
+
+func TestRolloutTraitSplitHTTPRoute(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t)
+	environment.Resources.Add(buildHTTPRoute("my-it", "my-it", "my-it", "ns", []int32{80}, nil))
+
+	applyRolloutTrait(t, trait, environment)
+
+	assert.NotNil(t, environment.Resources.GetService(func(s *corev1.Service) bool {
+		return s.Name == "my-it-stable" && s.Spec.Selector[rolloutLabel] == rolloutStable &&
+			s.Spec.Selector[rolloutRevisionLabel] == rolloutRevision("digest-v1")
+	}))
+	assert.NotNil(t, environment.Resources.GetService(func(s *corev1.Service) bool {
+		return s.Name == "my-it-canary" && s.Spec.Selector[rolloutLabel] == rolloutCanary &&
+			s.Spec.Selector[rolloutRevisionLabel] == rolloutRevision("digest-v2")
+	}))
+	var route *gwv1.HTTPRoute
+	for _, r := range environment.Resources.Items() {
+		if hr, ok := r.(*gwv1.HTTPRoute); ok {
+			route = hr
+		}
+	}
+	require.NotNil(t, route)
+	refs := route.Spec.Rules[0].BackendRefs
+	require.Len(t, refs, 2)
+	assert.Equal(t, gwv1.ObjectName("my-it-stable"), refs[0].Name)
+	assert.Equal(t, int32(90), *refs[0].Weight)
+	assert.Equal(t, gwv1.ObjectName("my-it-canary"), refs[1].Name)
+	assert.Equal(t, int32(10), *refs[1].Weight)
+}
+
+func TestRolloutTraitAnalysis(t *testing.T) {
+	metrics := `
+# TYPE camel_exchanges_total counter
+camel_exchanges_total{routeId="route1"} 100
+# TYPE camel_exchanges_failed_total counter
+camel_exchanges_failed_total{routeId="route1"} 2
+# TYPE camel_route_policy_seconds summary
+camel_route_policy_seconds_count{routeId="route1"} 100
+camel_route_policy_seconds_sum{routeId="route1"} 5
+`
+
+	trait, environment := createNominalRolloutTest(t, canaryPod(1))
+	mockRolloutMetrics(t, environment, metrics)
+	environment.Integration.Status.Rollout = progressingRollout(0)
+	applyRolloutTrait(t, trait, environment)
+
+	rollout := environment.Integration.Status.Rollout
+	assert.Equal(t, v1.RolloutPhaseProgressing, rollout.Phase)
+	assert.Equal(t, int32(1), rollout.Step)
+	assert.Equal(t, int32(25), rollout.Weight)
+
+	// half of the replicas run the new version at the last step
+	trait, environment = createNominalRolloutTest(t, canaryPod(1), canaryPod(2), canaryPod(3), canaryPod(4), canaryPod(5))
+	mockRolloutMetrics(t, environment, metrics)
+	environment.Integration.Status.Rollout = progressingRollout(2)
+	applyRolloutTrait(t, trait, environment)
+
+	rollout = environment.Integration.Status.Rollout
+	assert.Equal(t, v1.RolloutPhasePromoted, rollout.Phase)
+	assert.Equal(t, "digest-v2", rollout.StableDigest)
+	assert.Equal(t, "my-image:v2", rollout.StableImage)
+	assert.Empty(t, rollout.CanaryDigest)
+	assert.Empty(t, rollout.CanaryImage)
+	assert.Len(t, deployments(environment), 1)
+	stable := rolloutDeployment(environment, "my-it")
+	assert.Equal(t, "my-image:v2", stable.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, "my-it-properties-"+rolloutRevision("digest-v2"), stable.Spec.Template.Spec.Volumes[0].ConfigMap.Name)
+	require.Len(t, environment.PostActions, 1)
+
+	// the copies of the configuration of the previous version are deleted
+	require.NoError(t, environment.PostActions[0](environment))
+	configmaps := &corev1.ConfigMapList{}
+	require.NoError(t, environment.Client.List(context.Background(), configmaps))
+	names := make([]string, 0, len(configmaps.Items))
+	for _, cm := range configmaps.Items {
+		names = append(names, cm.Name)
+	}
+	assert.ElementsMatch(t, []string{"my-it-properties", "my-it-properties-" + rolloutRevision("digest-v2")}, names)
+}
+
+func TestRolloutTraitAnalysisHeld(t *testing.T) {
+	pod := canaryPod(1)
+	pod.Status.Conditions = nil
+	trait, environment := createNominalRolloutTest(t, pod)
+	environment.Integration.Status.Rollout = progressingRollout(0)
+	applyRolloutTrait(t, trait, environment)
+
+	rollout := environment.Integration.Status.Rollout
+	assert.Equal(t, v1.RolloutPhaseProgressing, rollout.Phase)
+	assert.Equal(t, int32(0), rollout.Step)
+	assert.Contains(t, rollout.Message, "0/1 pods of the new version are ready")
+	assert.True(t, rollout.NextAnalysisTime.After(time.Now()))
+
+	metrics := `
+# TYPE camel_exchanges_total counter
+camel_exchanges_total{routeId="route1"} 3
+`
+	trait, environment = createNominalRolloutTest(t, canaryPod(1))
+	mockRolloutMetrics(t, environment, metrics)
+	environment.Integration.Status.Rollout = progressingRollout(0)
+	applyRolloutTrait(t, trait, environment)
+
+	rollout = environment.Integration.Status.Rollout
+	assert.Equal(t, v1.RolloutPhaseProgressing, rollout.Phase)
+	assert.Equal(t, int32(0), rollout.Step)
+	assert.Contains(t, rollout.Message, "3/10 exchanges processed by the new version")
+
+	trait, environment = createNominalRolloutTest(t, canaryPod(1))
+	mockRolloutMetrics(t, environment, metrics)
+	environment.Integration.Status.Rollout = progressingRollout(0)
+	environment.Integration.Status.Rollout.StepStartTime = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+	applyRolloutTrait(t, trait, environment)
+
+	rollout = environment.Integration.Status.Rollout
+	assert.Equal(t, v1.RolloutPhaseAborted, rollout.Phase)
+	assert.Contains(t, rollout.Message, "could not be analysed within the progress deadline of step 1")
+	assert.Len(t, deployments(environment), 1)
+}
+
+func TestRolloutTraitAnalysisAbort(t *testing.T) {
+	metrics := `
+# TYPE camel_exchanges_total counter
+camel_exchanges_total{routeId="route1"} 100
+# TYPE camel_exchanges_failed_total counter
+camel_exchanges_failed_total{routeId="route1"} 2
+# TYPE camel_route_policy_seconds summary
+camel_route_policy_seconds_count{routeId="route1"} 100
+camel_route_policy_seconds_sum{routeId="route1"} 50
+`
+
+	trait, environment := createNominalRolloutTest(t, canaryPod(1))
+	mockRolloutMetrics(t, environment, metrics)
+	trait.MaxLatency = ptr.To(int64(200))
+	environment.Integration.Status.Rollout = progressingRollout(0)
+	applyRolloutTrait(t, trait, environment)
+
+	rollout := environment.Integration.Status.Rollout
+	assert.Equal(t, v1.RolloutPhaseAborted, rollout.Phase)
+	assert.Contains(t, rollout.Message, "mean processing time 500ms exceeds 200ms")
+	stable := rolloutDeployment(environment, "my-it")
+	assert.Equal(t, int32(10), *stable.Spec.Replicas)
+	assert.Equal(t, "my-image:v1", stable.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, "my-it-properties-"+rolloutRevision("digest-v1"), stable.Spec.Template.Spec.Volumes[0].ConfigMap.Name)
+	assert.Len(t, deployments(environment), 1)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionRollout)
+	assert.Equal(t, v1.IntegrationConditionRolloutAbortedReason, condition.Reason)
+}
+
+func TestRolloutTraitBlueGreen(t *testing.T) {
+	trait, environment := createNominalRolloutTest(t)
+	trait.Strategy = traitv1.RolloutStrategyBlueGreen
+
+	applyRolloutTrait(t, trait, environment)
+
+	assert.Equal(t, int32(100), environment.Integration.Status.Rollout.Weight)
+	stable := rolloutDeployment(environment, "my-it")
+	assert.Equal(t, int32(0), *stable.Spec.Replicas)
+	canary := rolloutDeployment(environment, "my-it-canary")
+	require.NotNil(t, canary)
+	assert.Equal(t, int32(10), *canary.Spec.Replicas)
+}
+
+func createNominalRolloutTest(t *testing.T, objects ...runtime.Object) (*rolloutTrait, *Environment) {
+	t.Helper()
+
+	trait, _ := newRolloutTrait().(*rolloutTrait)
+	trait.Enabled = ptr.To(true)
+
+	// the copy of the configuration of the stable version
+	stableRevision := rolloutRevision("digest-v1")
+	objects = append(objects,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-it-properties-" + stableRevision,
+				Namespace: "ns",
+				Labels: map[string]string{
+					v1.IntegrationLabel:  "my-it",
+					rolloutRevisionLabel: stableRevision,
+				},
+			},
+			Data: map[string]string{"application.properties": "my.message=v1"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-it-properties-" + rolloutRevision("digest-v2"),
+				Namespace: "ns",
+				Labels: map[string]string{
+					v1.IntegrationLabel:  "my-it",
+					rolloutRevisionLabel: rolloutRevision("digest-v2"),
+				},
+			},
+			Data: map[string]string{"application.properties": "my.message=v2"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-it-properties",
+				Namespace: "ns",
+				Labels:    map[string]string{v1.IntegrationLabel: "my-it"},
+			},
+			Data: map[string]string{"application.properties": "my.message=v2"},
+		},
+	)
+	client, err := internal.NewFakeClient(objects...)
+	require.NoError(t, err)
+
+	environment := &Environment{
+		Ctx:     context.Background(),
+		Client:  client,
+		Catalog: NewCatalog(nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-it",
+				Namespace: "ns",
+			},
+			Spec: v1.IntegrationSpec{
+				Replicas: ptr.To(int32(10)),
+			},
+			Status: v1.IntegrationStatus{
+				Phase:  v1.IntegrationPhaseRunning,
+				Digest: "digest-v2",
+				Image:  "my-image:v2",
+				Rollout: &v1.RolloutStatus{
+					Phase:        v1.RolloutPhasePromoted,
+					StableDigest: "digest-v1",
+					StableImage:  "my-image:v1",
+				},
+			},
+		},
+		Resources: kubernetes.NewCollection(
+			&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-it",
+					Namespace: "ns",
+					Labels:    map[string]string{v1.IntegrationLabel: "my-it"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To(int32(10)),
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{v1.IntegrationLabel: "my-it"},
+					},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{v1.IntegrationLabel: "my-it"},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{Name: defaultContainerName, Image: "my-image:v2"},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "properties",
+									VolumeSource: corev1.VolumeSource{
+										ConfigMap: &corev1.ConfigMapVolumeSource{
+											LocalObjectReference: corev1.LocalObjectReference{Name: "my-it-properties"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-it-properties",
+					Namespace: "ns",
+					Labels:    map[string]string{v1.IntegrationLabel: "my-it"},
+				},
+				Data: map[string]string{"application.properties": "my.message=v2"},
+			},
+			&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-it",
+					Namespace: "ns",
+					Labels: map[string]string{
+						v1.IntegrationLabel:             "my-it",
+						"camel.apache.org/service.type": v1.ServiceTypeUser,
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Ports:    []corev1.ServicePort{{Port: 80}},
+					Selector: map[string]string{v1.IntegrationLabel: "my-it"},
+				},
+			},
+		),
+	}
+
+	return trait, environment
+}
+
+func applyRolloutTrait(t *testing.T, trait *rolloutTrait, environment *Environment) {
+	t.Helper()
+
+	configured, _, err := trait.Configure(environment)
+	require.NoError(t, err)
+	require.True(t, configured)
+	require.NoError(t, trait.Apply(environment))
+}
+
+func progressingRollout(step int32) *v1.RolloutStatus {
+	return &v1.RolloutStatus{
+		Phase:            v1.RolloutPhaseProgressing,
+		StableDigest:     "digest-v1",
+		CanaryDigest:     "digest-v2",
+		StableImage:      "my-image:v1",
+		CanaryImage:      "my-image:v2",
+		Step:             step,
+		Weight:           []int32{10, 25, 50}[step],
+		StepStartTime:    ptr.To(metav1.NewTime(time.Now().Add(-time.Minute))),
+		NextAnalysisTime: ptr.To(metav1.NewTime(time.Now().Add(-time.Second))),
+	}
+}
+
+func canaryPod(index int) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("my-it-canary-%d", index),
+			Namespace: "ns",
+			Labels: map[string]string{
+				v1.IntegrationLabel:  "my-it",
+				rolloutLabel:         rolloutCanary,
+				rolloutRevisionLabel: rolloutRevision("digest-v2"),
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			PodIP: "10.0.0.1",
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+}
+
+// mockRolloutMetrics serves the given metrics when the pods of the environment are proxied by the API server.
+func mockRolloutMetrics(t *testing.T, environment *Environment, metrics string) {
+	t.Helper()
+
+	client, ok := environment.Client.(*internal.FakeClient)
+	require.True(t, ok)
+	clientset, ok := client.Interface.(*fakeclientset.Clientset)
+	require.True(t, ok)
+	clientset.PrependProxyReactor("pods", func(action k8stesting.Action) (bool, restclient.ResponseWrapper, error) {
+		get, ok := action.(k8stesting.ProxyGetAction)
+		require.True(t, ok)
+		assert.Equal(t, "ns", get.GetNamespace())
+		assert.True(t, strings.HasPrefix(get.GetName(), "my-it-canary-"))
+		assert.Equal(t, "http", get.GetScheme())
+		assert.Equal(t, "8080", get.GetPort())
+		assert.Equal(t, "/q/metrics", get.GetPath())
+
+		return true, rolloutMetricsResponse(strings.TrimPrefix(metrics, "\n")), nil
+	})
+}
+
+type rolloutMetricsResponse string
+
+func (r rolloutMetricsResponse) DoRaw(context.Context) ([]byte, error) {
+	return []byte(r), nil
+}
+
+func (r rolloutMetricsResponse) Stream(context.Context) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(string(r))), nil
+}
+
+func rolloutDeployment(environment *Environment, name string) *appsv1.Deployment {
+	return environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool {
+		return d.Name == name
+	})
+}
+
+func rolloutConfigMap(environment *Environment, name string) *corev1.ConfigMap {
+	return environment.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool {
+		return cm.Name == name
+	})
+}
+
+func deployments(environment *Environment) []*appsv1.Deployment {
+	var result []*appsv1.Deployment
+	environment.Resources.VisitDeployment(func(d *appsv1.Deployment) {
+		result = append(result, d)
+	})
+
+	return result
+}
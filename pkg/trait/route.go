@@ -133,7 +133,22 @@ func (t *routeTrait) Apply(e *Environment) error {
 		},
 	}
 
+	// expose exactly the paths declared by the OpenAPI document, if any: the route named after the service exposes
+	// the first path, and a route is added next to it for each of the other paths
+	exposedPaths, err := e.getOpenAPIExposedPaths()
+	if err != nil {
+		return err
+	}
+	if len(exposedPaths) > 0 {
+		route.Spec.Path = exposedPaths[0].Path
+	}
 	e.Resources.Add(&route)
+	for i := 1; i < len(exposedPaths); i++ {
+		pathRoute := route.DeepCopy()
+		pathRoute.Name = fmt.Sprintf("%s-%03d", t.service.Name, i)
+		pathRoute.Spec.Path = exposedPaths[i].Path
+		e.Resources.Add(pathRoute)
+	}
 
 	var message string
 
@@ -0,0 +1,103 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/source"
+)
+
+// SecurityPolicyCheck is the result of the evaluation of the security policies against the risk categories of an Integration.
+type SecurityPolicyCheck struct {
+	// The risk categories of the security sensitive components used by the Integration sources
+	Categories []string
+	// The risk categories denied, along with the policy denying them (ie, `command-execution (operator)`)
+	Denied []string
+	// The risk categories requiring an approval which has not been granted
+	Unapproved []string
+}
+
+// CheckSecurityPolicy evaluates the operator security policy and the one of the IntegrationProfile against the risk
+// categories reported in the Integration capabilities. A risk category requiring an approval is approved when listed
+// in the `camel.apache.org/security-approval` Integration annotation.
+func CheckSecurityPolicy(e *Environment) *SecurityPolicyCheck {
+	if e.Integration == nil {
+		return nil
+	}
+	check := SecurityPolicyCheck{
+		Categories: source.RiskCategories(e.Integration.Status.Capabilities),
+	}
+	if len(check.Categories) == 0 {
+		return &check
+	}
+	slices.Sort(check.Categories)
+
+	type policy struct {
+		owner string
+		spec  v1.SecurityPolicySpec
+	}
+	policies := []policy{{owner: "operator", spec: platform.SecurityPolicy()}}
+	if e.IntegrationProfile != nil && e.IntegrationProfile.Spec.Security != nil {
+		policies = append(policies, policy{
+			owner: "IntegrationProfile " + e.IntegrationProfile.Name,
+			spec:  *e.IntegrationProfile.Spec.Security,
+		})
+	}
+	approved := securityApprovals(e.Integration)
+
+	for _, category := range check.Categories {
+		denied := false
+		for _, p := range policies {
+			if slices.Contains(p.spec.Deny, category) {
+				check.Denied = append(check.Denied, fmt.Sprintf("%s (%s)", category, p.owner))
+				denied = true
+			}
+		}
+		if denied {
+			continue
+		}
+		for _, p := range policies {
+			if slices.Contains(p.spec.RequireApproval, category) && !approved(category) {
+				check.Unapproved = append(check.Unapproved, category)
+
+				break
+			}
+		}
+	}
+
+	return &check
+}
+
+// securityApprovals returns a function telling whether a risk category is approved by the Integration annotation.
+func securityApprovals(it *v1.Integration) func(string) bool {
+	var approvals []string
+	for a := range strings.SplitSeq(it.Annotations[v1.IntegrationSecurityApprovalAnnotation], ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			approvals = append(approvals, a)
+		}
+	}
+
+	return func(category string) bool {
+		return slices.Contains(approvals, "*") || slices.Contains(approvals, category)
+	}
+}
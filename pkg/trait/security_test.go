@@ -0,0 +1,74 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func TestCheckSecurityPolicy(t *testing.T) {
+	t.Setenv("SECURITY_DENIED_RISK_CATEGORIES", "remote-shell")
+	t.Setenv("SECURITY_APPROVAL_RISK_CATEGORIES", "dynamic-sql")
+
+	newEnv := func(annotations map[string]string, capabilities ...string) *Environment {
+		return &Environment{
+			IntegrationProfile: &v1.IntegrationProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "secure"},
+				Spec: v1.IntegrationProfileSpec{
+					Security: &v1.SecurityPolicySpec{
+						Deny:            []string{"command-execution"},
+						RequireApproval: []string{"script-execution"},
+					},
+				},
+			},
+			Integration: &v1.Integration{
+				ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+				Status: v1.IntegrationStatus{
+					Capabilities: capabilities,
+				},
+			},
+		}
+	}
+
+	check := CheckSecurityPolicy(newEnv(nil, v1.CapabilityRest))
+	require.NotNil(t, check)
+	assert.Empty(t, check.Categories)
+
+	check = CheckSecurityPolicy(newEnv(nil, "risk:command-execution", "risk:remote-shell", "risk:filesystem-access"))
+	assert.Equal(t, []string{"command-execution", "filesystem-access", "remote-shell"}, check.Categories)
+	assert.Equal(t, []string{"command-execution (IntegrationProfile secure)", "remote-shell (operator)"}, check.Denied)
+	assert.Empty(t, check.Unapproved)
+
+	check = CheckSecurityPolicy(newEnv(nil, "risk:dynamic-sql", "risk:script-execution"))
+	assert.Empty(t, check.Denied)
+	assert.Equal(t, []string{"dynamic-sql", "script-execution"}, check.Unapproved)
+
+	check = CheckSecurityPolicy(newEnv(map[string]string{v1.IntegrationSecurityApprovalAnnotation: "dynamic-sql"},
+		"risk:dynamic-sql", "risk:script-execution"))
+	assert.Equal(t, []string{"script-execution"}, check.Unapproved)
+
+	check = CheckSecurityPolicy(newEnv(map[string]string{v1.IntegrationSecurityApprovalAnnotation: "*"},
+		"risk:dynamic-sql", "risk:script-execution"))
+	assert.Empty(t, check.Unapproved)
+}
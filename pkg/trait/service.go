@@ -44,6 +44,8 @@ type serviceTrait struct {
 	traitv1.ServiceTrait `property:",squash"`
 
 	servicePorts []servicePort
+	exposed      bool
+	headless     bool
 }
 
 // servicePort is supporting port parsing.
@@ -112,11 +114,28 @@ func (t *serviceTrait) Configure(e *Environment) (bool, *TraitCondition, error)
 		return false, nil, err
 	}
 	t.servicePorts = servicePorts
+	t.exposed = enabled || len(t.servicePorts) > 0
 
-	return enabled || len(t.servicePorts) > 0, nil, nil
+	// A StatefulSet requires a headless service governing the network identity of its pods
+	if !e.Integration.IsSynthetic() {
+		strategy, err := e.DetermineControllerStrategy()
+		if err != nil {
+			return false, nil, err
+		}
+		t.headless = strategy == ControllerStrategyStatefulSet
+	}
+
+	return t.exposed || t.headless, nil, nil
 }
 
 func (t *serviceTrait) Apply(e *Environment) error {
+	if t.headless {
+		e.Resources.Add(t.getHeadlessServiceFor(e.Integration.Name, e.Integration.Namespace))
+	}
+	if !t.exposed {
+		return nil
+	}
+
 	svc := e.Resources.GetServiceForIntegration(e.Integration)
 	// add a new service if not already created
 	if svc == nil {
@@ -172,6 +191,31 @@ func (t *serviceTrait) getServiceFor(itName, itNamespace string) *corev1.Service
 	}
 }
 
+// getHeadlessServiceFor returns the headless service of a StatefulSet. Like any other resource of the Integration, it
+// is labelled with the Integration label by the gc trait, and it is told apart from the service exposing the Integration
+// by its `None` cluster IP.
+func (t *serviceTrait) getHeadlessServiceFor(itName, itNamespace string) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      itName + headlessServiceSuffix,
+			Namespace: itNamespace,
+			// the Integration label is added by the gc trait, once all the traits are applied
+			Labels: map[string]string{},
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP:                corev1.ClusterIPNone,
+			PublishNotReadyAddresses: true,
+			Selector: map[string]string{
+				v1.IntegrationLabel: itName,
+			},
+		},
+	}
+}
+
 func (t *serviceTrait) parseServicePorts() ([]servicePort, error) {
 	servicePorts := make([]servicePort, 0, len(t.Ports))
 	for _, port := range t.Ports {
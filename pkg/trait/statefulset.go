@@ -0,0 +1,179 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"maps"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	statefulSetTraitID               = "statefulset"
+	statefulSetTraitOrder            = 1120
+	statefulSetStrategySelectorOrder = 50
+
+	headlessServiceSuffix = "-headless"
+)
+
+type statefulSetTrait struct {
+	BaseTrait
+	traitv1.StatefulSetTrait `property:",squash"`
+}
+
+var _ ControllerStrategySelector = &statefulSetTrait{}
+
+func newStatefulSetTrait() Trait {
+	return &statefulSetTrait{
+		BaseTrait: NewBaseTrait(statefulSetTraitID, statefulSetTraitOrder),
+	}
+}
+
+func (t *statefulSetTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+	if !ptr.Deref(t.Enabled, true) {
+		return false, NewIntegrationConditionUserDisabled("StatefulSet"), nil
+	}
+
+	if e.IntegrationInPhase(v1.IntegrationPhaseRunning, v1.IntegrationPhaseError) {
+		condition := e.Integration.Status.GetCondition(v1.IntegrationConditionStatefulSetAvailable)
+
+		return condition != nil && condition.Status == corev1.ConditionTrue, nil, nil
+	}
+
+	// The strategy may also be selected by the deployer trait
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return false, NewIntegrationCondition(
+			"StatefulSet",
+			v1.IntegrationConditionStatefulSetAvailable,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionStatefulSetNotAvailableReason,
+			err.Error(),
+		), err
+	}
+	if strategy != ControllerStrategyStatefulSet {
+		return false, nil, nil
+	}
+
+	return e.IntegrationInPhase(v1.IntegrationPhaseDeploying), nil, nil
+}
+
+func (t *statefulSetTrait) SelectControllerStrategy(e *Environment) (*ControllerStrategy, error) {
+	if !ptr.Deref(t.Enabled, false) {
+		return nil, nil
+	}
+	statefulSetStrategy := ControllerStrategyStatefulSet
+
+	return &statefulSetStrategy, nil
+}
+
+func (t *statefulSetTrait) ControllerStrategySelectorOrder() int {
+	return statefulSetStrategySelectorOrder
+}
+
+func (t *statefulSetTrait) Apply(e *Environment) error {
+	statefulSet := t.getStatefulSetFor(e)
+	e.Resources.Add(statefulSet)
+
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionStatefulSetAvailable,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionStatefulSetAvailableReason,
+		"statefulset name is "+statefulSet.Name,
+	)
+
+	return nil
+}
+
+func (t *statefulSetTrait) getStatefulSetFor(e *Environment) *appsv1.StatefulSet {
+	// create a copy to avoid sharing the underlying annotation map
+	annotations := make(map[string]string)
+	if e.Integration.Annotations != nil {
+		maps.Copy(annotations, filterTransferableAnnotations(e.Integration.Annotations))
+	}
+
+	// Set the default container annotation for kubectl commands
+	annotations[defaultContainerAnnotation] = defaultContainerName
+
+	// StatefulSet replicas defaults to 1, so we avoid forcing
+	// an update to nil that will result to another update cycle
+	// back to that default value by the StatefulSet controller.
+	replicas := e.Integration.Spec.Replicas
+	if replicas == nil {
+		replicas = ptr.To(int32(1))
+	}
+
+	statefulSet := appsv1.StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "StatefulSet",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        e.Integration.Name,
+			Namespace:   e.Integration.Namespace,
+			Labels:      kubernetes.DeploymentLabels(e.Integration.Name),
+			Annotations: annotations,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:            replicas,
+			ServiceName:         e.Integration.Name + headlessServiceSuffix,
+			PodManagementPolicy: t.PodManagementPolicy,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel: e.Integration.Name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						v1.IntegrationLabel: e.Integration.Name,
+					},
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: e.Integration.Spec.ServiceAccountName,
+				},
+			},
+		},
+	}
+
+	if t.Strategy != "" {
+		statefulSet.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+			Type: t.Strategy,
+		}
+	}
+
+	if ptr.Deref(t.DeleteClaims, false) {
+		statefulSet.Spec.PersistentVolumeClaimRetentionPolicy = &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+			WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+			WhenScaled:  appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+		}
+	}
+
+	return &statefulSet
+}
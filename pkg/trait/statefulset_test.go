@@ -0,0 +1,152 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+func TestStatefulSetIntegrationPhaseDeploying(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.StatefulSet = &traitv1.StatefulSetTrait{
+		Trait: traitv1.Trait{
+			Enabled: ptr.To(true),
+		},
+		PodManagementPolicy: appsv1.ParallelPodManagement,
+		DeleteClaims:        ptr.To(true),
+	}
+	environment.Integration.Spec.Traits.Mount.VolumeClaimTemplates = []string{"data:/var/data:1Gi"}
+
+	_, _, err := traitCatalog.apply(environment)
+	require.NoError(t, err)
+	assert.NotNil(t, environment.GetTrait(statefulSetTraitID))
+	assert.Nil(t, environment.GetTrait(deploymentTraitID))
+	assert.True(t, environment.Integration.IsConditionTrue(v1.IntegrationConditionStatefulSetAvailable))
+
+	assert.Nil(t, environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return true }))
+	sts := environment.Resources.GetStatefulSet(func(s *appsv1.StatefulSet) bool { return s.Name == "hello" })
+	require.NotNil(t, sts)
+	assert.Equal(t, "hello-headless", sts.Spec.ServiceName)
+	assert.Equal(t, appsv1.ParallelPodManagement, sts.Spec.PodManagementPolicy)
+	assert.Equal(t, int32(1), *sts.Spec.Replicas)
+	assert.Equal(t, "hello", sts.Spec.Selector.MatchLabels[v1.IntegrationLabel])
+	require.NotNil(t, sts.Spec.PersistentVolumeClaimRetentionPolicy)
+	assert.Equal(t, appsv1.DeletePersistentVolumeClaimRetentionPolicyType, sts.Spec.PersistentVolumeClaimRetentionPolicy.WhenDeleted)
+
+	require.Len(t, sts.Spec.VolumeClaimTemplates, 1)
+	claim := sts.Spec.VolumeClaimTemplates[0]
+	assert.Equal(t, "data", claim.Name)
+	assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}, claim.Spec.AccessModes)
+	assert.Equal(t, resource.MustParse("1Gi"), claim.Spec.Resources.Requests[corev1.ResourceStorage])
+	assert.Nil(t, claim.Spec.StorageClassName)
+
+	container := sts.Spec.Template.Spec.Containers[0]
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{Name: "data", MountPath: "/var/data"})
+	assert.Equal(t, defaultContainerName, container.Name)
+
+	headless := environment.Resources.GetService(func(s *corev1.Service) bool { return s.Name == "hello-headless" })
+	require.NotNil(t, headless)
+	assert.Equal(t, corev1.ClusterIPNone, headless.Spec.ClusterIP)
+	assert.Equal(t, "hello", headless.Spec.Selector[v1.IntegrationLabel])
+	assert.Empty(t, headless.Spec.Ports)
+	assert.Equal(t, "hello", headless.Labels[v1.IntegrationLabel])
+	// The Integration still exposes its HTTP endpoint through the regular service
+	svc := environment.Resources.GetServiceForIntegration(environment.Integration)
+	require.NotNil(t, svc)
+	assert.Equal(t, "hello", svc.Name)
+	assert.NotEmpty(t, svc.Spec.Ports)
+}
+
+func TestStatefulSetSelectedByDeployer(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.Deployer = &traitv1.DeployerTrait{
+		Kind: "statefulset",
+	}
+
+	_, _, err := traitCatalog.apply(environment)
+	require.NoError(t, err)
+
+	sts := environment.Resources.GetStatefulSet(func(s *appsv1.StatefulSet) bool { return s.Name == "hello" })
+	require.NotNil(t, sts)
+	assert.Empty(t, sts.Spec.VolumeClaimTemplates)
+	assert.Nil(t, sts.Spec.PersistentVolumeClaimRetentionPolicy)
+	assert.Nil(t, environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return true }))
+	assert.NotNil(t, environment.Resources.GetService(func(s *corev1.Service) bool { return s.Name == "hello-headless" }))
+}
+
+func TestStatefulSetRunningWithoutAvailableCondition(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Status.Phase = v1.IntegrationPhaseRunning
+	trait, _ := newStatefulSetTrait().(*statefulSetTrait)
+	trait.Enabled = ptr.To(true)
+
+	configured, condition, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.Nil(t, condition)
+	assert.False(t, configured)
+
+	environment.Integration.Status.SetCondition(
+		v1.IntegrationConditionStatefulSetAvailable,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionStatefulSetAvailableReason,
+		"statefulset name is hello",
+	)
+	configured, _, err = trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configured)
+}
+
+func TestVolumeClaimTemplatesRequireStatefulSet(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.Mount.VolumeClaimTemplates = []string{"data:/var/data:1Gi"}
+
+	_, _, err := traitCatalog.apply(environment)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "volume claim templates are only supported with the statefulset controller strategy")
+}
+
+func TestParseVolumeClaimTemplate(t *testing.T) {
+	pvc, vm, err := ParseVolumeClaimTemplate("my-data:/var/data:10Gi:ReadWriteOncePod:fast")
+	require.NoError(t, err)
+	assert.Equal(t, "my-data", pvc.Name)
+	assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOncePod}, pvc.Spec.AccessModes)
+	assert.Equal(t, resource.MustParse("10Gi"), pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+	assert.Equal(t, "fast", ptr.Deref(pvc.Spec.StorageClassName, ""))
+	assert.Equal(t, "my-data", vm.Name)
+	assert.Equal(t, "/var/data", vm.MountPath)
+
+	_, _, err = ParseVolumeClaimTemplate("my-data:/var/data")
+	require.Error(t, err)
+	_, _, err = ParseVolumeClaimTemplate("my-data:/var/data:lots")
+	require.Error(t, err)
+}
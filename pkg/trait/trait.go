@@ -220,6 +220,8 @@ func getCamelAppObject(ctx context.Context, c client.Client, kind, namespace, na
 		return c.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
 	case "CronJob":
 		return c.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	case "StatefulSet":
+		return c.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
 	case "KnativeService":
 		ksvc := &serving.Service{
 			TypeMeta: metav1.TypeMeta{
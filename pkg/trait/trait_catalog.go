@@ -41,13 +41,7 @@ func NewCatalog(c client.Client) *Catalog {
 	for _, factory := range FactoryList {
 		traitList = append(traitList, factory())
 	}
-	sort.Slice(traitList, func(i, j int) bool {
-		if traitList[i].Order() != traitList[j].Order() {
-			return traitList[i].Order() < traitList[j].Order()
-		}
-
-		return string(traitList[i].ID()) < string(traitList[j].ID())
-	})
+	sortTraits(traitList)
 
 	catalog := Catalog{
 		L:      log.Log.WithName("trait"),
@@ -63,6 +57,17 @@ func NewCatalog(c client.Client) *Catalog {
 	return &catalog
 }
 
+// sortTraits sorts the traits by order, then by ID.
+func sortTraits(traits []Trait) {
+	sort.Slice(traits, func(i, j int) bool {
+		if traits[i].Order() != traits[j].Order() {
+			return traits[i].Order() < traits[j].Order()
+		}
+
+		return string(traits[i].ID()) < string(traits[j].ID())
+	})
+}
+
 func (c *Catalog) AllTraits() []Trait {
 	return append([]Trait(nil), c.traits...)
 }
@@ -94,6 +99,9 @@ func (c *Catalog) TraitsForProfile(profile v1.TraitProfile) []Trait {
 
 func (c *Catalog) apply(environment *Environment) ([]*TraitCondition, *v1.Traits, error) {
 	traitsConditions := []*TraitCondition{}
+	if err := c.loadExternalTraits(environment); err != nil {
+		return traitsConditions, nil, err
+	}
 	if err := c.Configure(environment); err != nil {
 		return traitsConditions, nil, err
 	}
@@ -141,7 +149,8 @@ func (c *Catalog) apply(environment *Environment) ([]*TraitCondition, *v1.Traits
 
 func (c *Catalog) executedTraitCondition(executedTrait []Trait) (*TraitCondition, v1.Traits, error) {
 	var traits v1.Traits
-	var traitMap = make(map[string]map[string]any)
+	var traitMap = make(map[string]any)
+	var addons = make(map[string]any)
 	traitIds := make([]string, 0)
 	for _, trait := range executedTrait {
 		data, err := json.Marshal(trait)
@@ -153,12 +162,20 @@ func (c *Catalog) executedTraitCondition(executedTrait []Trait) (*TraitCondition
 			return nil, traits, err
 		}
 		if len(traitIDMap) > 0 {
-			traitMap[string(trait.ID())] = traitIDMap
+			if _, ok := trait.(*externalTrait); ok {
+				addons[string(trait.ID())] = traitIDMap
+			} else {
+				traitMap[string(trait.ID())] = traitIDMap
+			}
 		}
 
 		traitIds = append(traitIds, string(trait.ID()))
 	}
 
+	if len(addons) > 0 {
+		traitMap["addons"] = addons
+	}
+
 	traitData, err := json.Marshal(traitMap)
 	if err != nil {
 		return nil, traits, err
@@ -56,6 +56,16 @@ func (c *Catalog) Configure(env *Environment) error {
 			}
 		}
 
+		// The modeline options of the sources are overridden by the Integration spec, as kamel run flags do
+		if options := modelineTraitOptions(env.Integration); len(options) > 0 {
+			traits := v1.Traits{}
+			if err := ConfigureTraits(options, &traits, c); err != nil {
+				return err
+			}
+			if err := c.configureTraits(traits); err != nil {
+				return err
+			}
+		}
 		if err := c.configureTraits(env.Integration.Spec.Traits); err != nil {
 			return err
 		}
@@ -75,6 +85,31 @@ func (c *Catalog) configureTraits(traits any) error {
 	}
 
 	for id, trait := range traitMap {
+		if id == "addons" {
+			if err := c.configureAddons(trait); err != nil {
+				return err
+			}
+
+			continue
+		}
+		if err := c.configureTrait(id, trait); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// configureAddons configures the external traits from the `addons` section of the traits.
+func (c *Catalog) configureAddons(addons map[string]any) error {
+	for id, addon := range addons {
+		trait, ok := addon.(map[string]any)
+		if !ok {
+			return fmt.Errorf("wrong format for addon %q: expected an object", id)
+		}
+		if _, ok := c.GetTrait(id).(*externalTrait); !ok {
+			continue
+		}
 		if err := c.configureTrait(id, trait); err != nil {
 			return err
 		}
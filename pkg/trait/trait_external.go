@@ -0,0 +1,305 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/json"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/trait/external"
+)
+
+const (
+	externalTraitDefaultTimeoutSeconds = 10
+	externalTraitDefaultServicePort    = 443
+)
+
+var externalTraitDefaultPhases = []v1.IntegrationPhase{
+	v1.IntegrationPhaseDeploying,
+	v1.IntegrationPhaseRunning,
+	v1.IntegrationPhaseError,
+}
+
+// externalTrait executes a trait implemented by a webhook, as registered with an ExternalTrait resource.
+// It is configured in the `addons` section of the Integration traits.
+type externalTrait struct {
+	BaseTrait
+	registration *v1.ExternalTrait
+	// The trait configuration, sent as is to the webhook
+	Configuration map[string]any `property:",remain"`
+}
+
+func newExternalTrait(registration *v1.ExternalTrait) *externalTrait {
+	order := TraitOrderPostProcessResources
+	if registration.Spec.Order != nil {
+		order = *registration.Spec.Order
+	}
+
+	return &externalTrait{
+		BaseTrait:    NewBaseTrait(registration.Spec.ID, order),
+		registration: registration,
+	}
+}
+
+// UnmarshalJSON merges the given configuration into the trait one.
+func (t *externalTrait) UnmarshalJSON(data []byte) error {
+	var configuration map[string]any
+	if err := json.Unmarshal(data, &configuration); err != nil {
+		return err
+	}
+	if t.Configuration == nil {
+		t.Configuration = make(map[string]any, len(configuration))
+	}
+	for k, v := range configuration {
+		t.Configuration[k] = v
+	}
+
+	return nil
+}
+
+// MarshalJSON returns the trait configuration.
+func (t *externalTrait) MarshalJSON() ([]byte, error) {
+	if t.Configuration == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(t.Configuration)
+}
+
+func (t *externalTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil {
+		return false, nil, nil
+	}
+	phases := t.registration.Spec.Phases
+	if len(phases) == 0 {
+		phases = externalTraitDefaultPhases
+	}
+	if !e.IntegrationInPhase(phases...) {
+		return false, nil, nil
+	}
+	enabled, configured := t.enabled()
+	if configured && !enabled {
+		return false, NewIntegrationConditionUserDisabled(string(t.ID())), nil
+	}
+	if !configured && !t.registration.Spec.EnabledByDefault {
+		return false, nil, nil
+	}
+
+	response, err := t.call(e, external.OperationConfigure)
+	if err != nil {
+		if t.ignoreFailures() {
+			return false, NewIntegrationCondition(
+				string(t.ID()),
+				v1.IntegrationConditionTraitInfo,
+				corev1.ConditionTrue,
+				TraitConfigurationReason,
+				"external trait ignored: "+err.Error(),
+			), nil
+		}
+
+		return false, nil, err
+	}
+	if response.Error != "" {
+		return false, nil, errors.New(response.Error)
+	}
+
+	return response.Enabled, nil, nil
+}
+
+func (t *externalTrait) Apply(e *Environment) error {
+	response, err := t.call(e, external.OperationApply)
+	if err == nil && response.Error == "" {
+		err = external.ApplyPatches(e.Client.GetScheme(), e.Resources.Items(), response.Patches)
+	}
+	if err != nil {
+		if t.ignoreFailures() {
+			t.L.ForIntegration(e.Integration).Infof("External trait ignored: %v", err)
+
+			return nil
+		}
+
+		return err
+	}
+	if response.Error != "" {
+		return errors.New(response.Error)
+	}
+
+	return nil
+}
+
+// enabled returns the enabled property of the configuration, and whether the trait is configured at all.
+func (t *externalTrait) enabled() (bool, bool) {
+	switch enabled := t.Configuration["enabled"].(type) {
+	case bool:
+		return enabled, true
+	case string:
+		// set from the trait annotations
+		if b, err := strconv.ParseBool(enabled); err == nil {
+			return b, true
+		}
+	}
+
+	return t.Configuration != nil, t.Configuration != nil
+}
+
+func (t *externalTrait) ignoreFailures() bool {
+	return t.registration.Spec.FailurePolicy == v1.ExternalTraitFailurePolicyIgnore
+}
+
+func (t *externalTrait) call(e *Environment, operation external.Operation) (*external.Response, error) {
+	client, err := t.webhookClient()
+	if err != nil {
+		return nil, err
+	}
+	var resources []ctrl.Object
+	if operation == external.OperationApply {
+		resources = e.Resources.Items()
+	}
+	request, err := external.NewRequest(e.Client.GetScheme(), string(t.ID()), operation, t.Configuration, e.Integration, resources)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Call(e.Ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("calling the %s webhook of external trait %s failed: %w", operation, t.registration.Name, err)
+	}
+
+	return response, nil
+}
+
+func (t *externalTrait) webhookClient() (*external.Client, error) {
+	webhook := t.registration.Spec.Webhook
+	var url string
+	switch {
+	case webhook.URL != "" && webhook.Service != nil:
+		return nil, fmt.Errorf("external trait %s webhook must have either an url or a service, not both", t.registration.Name)
+	case webhook.URL != "":
+		url = webhook.URL
+	case webhook.Service != nil:
+		namespace := webhook.Service.Namespace
+		if namespace == "" {
+			namespace = t.registration.Namespace
+		}
+		port := int32(externalTraitDefaultServicePort)
+		if webhook.Service.Port != nil {
+			port = *webhook.Service.Port
+		}
+		url = fmt.Sprintf("https://%s.%s.svc:%d%s", webhook.Service.Name, namespace, port, webhook.Service.Path)
+	default:
+		return nil, fmt.Errorf("external trait %s webhook must have an url or a service", t.registration.Name)
+	}
+
+	timeout := time.Duration(externalTraitDefaultTimeoutSeconds) * time.Second
+	if t.registration.Spec.TimeoutSeconds != nil {
+		timeout = time.Duration(*t.registration.Spec.TimeoutSeconds) * time.Second
+	}
+	//nolint:forcetypeassert
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(webhook.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(webhook.CABundle) {
+			return nil, fmt.Errorf("external trait %s webhook has an invalid CA bundle", t.registration.Name)
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return &external.Client{
+		URL: url,
+		HTTPClient: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+		},
+	}, nil
+}
+
+// loadExternalTraits adds the external traits registered in the operator namespace to the catalog,
+// replacing the ones previously loaded.
+func (c *Catalog) loadExternalTraits(e *Environment) error {
+	if e.Client == nil || e.Integration == nil || e.Integration.IsSynthetic() {
+		c.removeExternalTraits()
+
+		return nil
+	}
+	namespace := platform.GetOperatorNamespace()
+	if namespace == "" {
+		namespace = e.Integration.Namespace
+	}
+
+	return c.LoadExternalTraits(e.Ctx, e.Client, namespace)
+}
+
+// LoadExternalTraits adds the external traits registered in the given namespace, or in any namespace if empty,
+// to the catalog, replacing the ones previously loaded.
+func (c *Catalog) LoadExternalTraits(ctx context.Context, cl client.Client, namespace string) error {
+	c.removeExternalTraits()
+
+	list := v1.ExternalTraitList{}
+	if err := cl.List(ctx, &list, ctrl.InNamespace(namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			// the ExternalTrait CRD is not installed
+			return nil
+		}
+
+		return fmt.Errorf("cannot list external traits: %w", err)
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].Name < list.Items[j].Name
+	})
+	for i := range list.Items {
+		registration := &list.Items[i]
+		if c.GetTrait(registration.Spec.ID) != nil {
+			c.L.Infof("Skipping external trait %s: trait %s already exists", registration.Name, registration.Spec.ID)
+
+			continue
+		}
+		t := newExternalTrait(registration)
+		t.InjectClient(cl)
+		c.traits = append(c.traits, t)
+	}
+	sortTraits(c.traits)
+
+	return nil
+}
+
+func (c *Catalog) removeExternalTraits() {
+	traits := make([]Trait, 0, len(c.traits))
+	for _, t := range c.traits {
+		if _, ok := t.(*externalTrait); !ok {
+			traits = append(traits, t)
+		}
+	}
+	c.traits = traits
+}
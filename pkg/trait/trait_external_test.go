@@ -0,0 +1,347 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/trait/external"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+// chargebackTrait labels the Integration Deployment with the configured cost center.
+type chargebackTrait struct{}
+
+func (chargebackTrait) Configure(request *external.Request) (bool, error) {
+	return request.Configuration["costCenter"] != nil, nil
+}
+
+func (chargebackTrait) Apply(request *external.Request) ([]external.Patch, error) {
+	costCenter, ok := request.Configuration["costCenter"].(string)
+	if !ok {
+		return nil, errors.New("costCenter must be a string")
+	}
+
+	return []external.Patch{{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       request.Integration.Name,
+		Operations: []external.JSONPatchOperation{{
+			Op:    "add",
+			Path:  "/metadata/labels/example.com~1cost-center",
+			Value: costCenter,
+		}},
+	}}, nil
+}
+
+func createExternalTraitTestEnv(t *testing.T, spec v1.ExternalTraitSpec, addons map[string]v1.AddonTrait) (*Catalog, *Environment) {
+	t.Helper()
+
+	registration := &v1.ExternalTrait{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1.ExternalTraitKind,
+			APIVersion: v1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "chargeback",
+		},
+		Spec: spec,
+	}
+	client, err := internal.NewFakeClient(registration)
+	require.NoError(t, err)
+
+	e := &Environment{
+		Ctx:    context.Background(),
+		Client: client,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "hello",
+			},
+			Spec: v1.IntegrationSpec{
+				Traits: v1.Traits{
+					Addons: addons,
+				},
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "hello",
+				Labels: map[string]string{
+					v1.IntegrationLabel: "hello",
+				},
+			},
+		}),
+	}
+	catalog := NewCatalog(client)
+	require.NoError(t, catalog.loadExternalTraits(e))
+	require.NoError(t, catalog.Configure(e))
+
+	return catalog, e
+}
+
+func TestExternalTrait(t *testing.T) {
+	server := httptest.NewServer(external.NewHandler(chargebackTrait{}))
+	defer server.Close()
+
+	catalog, e := createExternalTraitTestEnv(t,
+		v1.ExternalTraitSpec{
+			ID:      "chargeback",
+			Webhook: v1.ExternalTraitWebhook{URL: server.URL},
+		},
+		map[string]v1.AddonTrait{
+			"chargeback": toAddonTrait(t, map[string]any{"costCenter": "42"}),
+		},
+	)
+	trait := catalog.GetTrait("chargeback")
+	require.NotNil(t, trait)
+	assert.Equal(t, TraitOrderPostProcessResources, trait.Order())
+
+	enabled, condition, err := trait.Configure(e)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Nil(t, condition)
+	require.NoError(t, trait.Apply(e))
+
+	deployment := e.Resources.GetDeploymentForIntegration(e.Integration)
+	require.NotNil(t, deployment)
+	assert.Equal(t, "42", deployment.Labels["example.com/cost-center"])
+	assert.Equal(t, "hello", deployment.Labels[v1.IntegrationLabel])
+
+	_, traits, err := catalog.executedTraitCondition([]Trait{trait})
+	require.NoError(t, err)
+	addon := traits.Addons["chargeback"]
+	assert.JSONEq(t, `{"costCenter":"42"}`, addon.String())
+}
+
+func TestExternalTraitNotConfigured(t *testing.T) {
+	catalog, e := createExternalTraitTestEnv(t,
+		v1.ExternalTraitSpec{
+			ID:      "chargeback",
+			Webhook: v1.ExternalTraitWebhook{URL: "http://localhost:1"},
+		},
+		nil,
+	)
+	trait := catalog.GetTrait("chargeback")
+	require.NotNil(t, trait)
+
+	enabled, condition, err := trait.Configure(e)
+	require.NoError(t, err)
+	assert.False(t, enabled)
+	assert.Nil(t, condition)
+}
+
+func TestExternalTraitUserDisabled(t *testing.T) {
+	catalog, e := createExternalTraitTestEnv(t,
+		v1.ExternalTraitSpec{
+			ID:               "chargeback",
+			EnabledByDefault: true,
+			Webhook:          v1.ExternalTraitWebhook{URL: "http://localhost:1"},
+		},
+		map[string]v1.AddonTrait{
+			"chargeback": toAddonTrait(t, map[string]any{"enabled": false}),
+		},
+	)
+
+	enabled, condition, err := catalog.GetTrait("chargeback").Configure(e)
+	require.NoError(t, err)
+	assert.False(t, enabled)
+	require.NotNil(t, condition)
+	assert.Equal(t, userDisabledMessage, condition.message)
+}
+
+func TestExternalTraitOutOfPhases(t *testing.T) {
+	catalog, e := createExternalTraitTestEnv(t,
+		v1.ExternalTraitSpec{
+			ID:               "chargeback",
+			EnabledByDefault: true,
+			Phases:           []v1.IntegrationPhase{v1.IntegrationPhaseInitialization},
+			Webhook:          v1.ExternalTraitWebhook{URL: "http://localhost:1"},
+		},
+		nil,
+	)
+
+	enabled, _, err := catalog.GetTrait("chargeback").Configure(e)
+	require.NoError(t, err)
+	assert.False(t, enabled)
+}
+
+func TestExternalTraitFailurePolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	catalog, e := createExternalTraitTestEnv(t,
+		v1.ExternalTraitSpec{
+			ID:               "chargeback",
+			EnabledByDefault: true,
+			Webhook:          v1.ExternalTraitWebhook{URL: server.URL},
+		},
+		nil,
+	)
+	trait := catalog.GetTrait("chargeback")
+	_, _, err := trait.Configure(e)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "webhook returned HTTP status 503")
+	require.Error(t, trait.Apply(e))
+
+	catalog, e = createExternalTraitTestEnv(t,
+		v1.ExternalTraitSpec{
+			ID:               "chargeback",
+			EnabledByDefault: true,
+			FailurePolicy:    v1.ExternalTraitFailurePolicyIgnore,
+			Webhook:          v1.ExternalTraitWebhook{URL: server.URL},
+		},
+		nil,
+	)
+	trait = catalog.GetTrait("chargeback")
+	enabled, condition, err := trait.Configure(e)
+	require.NoError(t, err)
+	assert.False(t, enabled)
+	require.NotNil(t, condition)
+	assert.Contains(t, condition.message, "external trait ignored")
+	require.NoError(t, trait.Apply(e))
+}
+
+func TestExternalTraitTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	catalog, e := createExternalTraitTestEnv(t,
+		v1.ExternalTraitSpec{
+			ID:               "chargeback",
+			EnabledByDefault: true,
+			TimeoutSeconds:   ptr.To(int32(1)),
+			Webhook:          v1.ExternalTraitWebhook{URL: server.URL},
+		},
+		nil,
+	)
+
+	start := time.Now()
+	_, _, err := catalog.GetTrait("chargeback").Configure(e)
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestExternalTraitErrorIgnoresFailurePolicy(t *testing.T) {
+	server := httptest.NewServer(external.NewHandler(chargebackTrait{}))
+	defer server.Close()
+
+	catalog, e := createExternalTraitTestEnv(t,
+		v1.ExternalTraitSpec{
+			ID:            "chargeback",
+			FailurePolicy: v1.ExternalTraitFailurePolicyIgnore,
+			Webhook:       v1.ExternalTraitWebhook{URL: server.URL},
+		},
+		map[string]v1.AddonTrait{
+			"chargeback": toAddonTrait(t, map[string]any{"costCenter": 42}),
+		},
+	)
+
+	err := catalog.GetTrait("chargeback").Apply(e)
+	require.Error(t, err)
+	assert.Equal(t, "costCenter must be a string", err.Error())
+}
+
+func TestExternalTraitCannotOverrideTrait(t *testing.T) {
+	catalog, _ := createExternalTraitTestEnv(t,
+		v1.ExternalTraitSpec{
+			ID:      "service",
+			Webhook: v1.ExternalTraitWebhook{URL: "http://localhost:1"},
+		},
+		nil,
+	)
+
+	_, ok := catalog.GetTrait("service").(*serviceTrait)
+	assert.True(t, ok)
+}
+
+func TestExternalTraitWebhookServiceURL(t *testing.T) {
+	trait := newExternalTrait(&v1.ExternalTrait{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "camel-k",
+			Name:      "chargeback",
+		},
+		Spec: v1.ExternalTraitSpec{
+			ID: "chargeback",
+			Webhook: v1.ExternalTraitWebhook{
+				Service: &v1.ExternalTraitServiceReference{
+					Name: "chargeback",
+					Path: "/trait",
+				},
+			},
+		},
+	})
+
+	client, err := trait.webhookClient()
+	require.NoError(t, err)
+	assert.Equal(t, "https://chargeback.camel-k.svc:443/trait", client.URL)
+	assert.Equal(t, 10*time.Second, client.HTTPClient.Timeout)
+
+	trait.registration.Spec.Webhook.URL = "https://example.com"
+	_, err = trait.webhookClient()
+	require.Error(t, err)
+}
+
+func TestExternalTraitConfiguredFromAnnotations(t *testing.T) {
+	catalog, e := createExternalTraitTestEnv(t,
+		v1.ExternalTraitSpec{
+			ID:      "chargeback",
+			Webhook: v1.ExternalTraitWebhook{URL: "http://localhost:1"},
+		},
+		nil,
+	)
+	require.NoError(t, catalog.configureTraitsFromAnnotations(map[string]string{
+		v1.TraitAnnotationPrefix + "chargeback.enabled":     "false",
+		v1.TraitAnnotationPrefix + "chargeback.cost-center": "42",
+	}))
+
+	trait, ok := catalog.GetTrait("chargeback").(*externalTrait)
+	require.True(t, ok)
+	assert.Equal(t, map[string]any{"enabled": "false", "cost-center": "42"}, trait.Configuration)
+	enabled, _, err := trait.Configure(e)
+	require.NoError(t, err)
+	assert.False(t, enabled)
+}
@@ -34,10 +34,12 @@ func init() {
 	AddToTraits(newGitTrait)
 	AddToTraits(newGitOpsTrait)
 	AddToTraits(newHealthTrait)
+	AddToTraits(newHPATrait)
 	AddToTraits(newInitContainersTrait)
 	AddToTraits(NewInitTrait)
 	AddToTraits(newIngressTrait)
 	AddToTraits(newIstioTrait)
+	AddToTraits(newJobTrait)
 	AddToTraits(newJvmTrait)
 	AddToTraits(newKameletsTrait)
 	AddToTraits(newKedaTrait)
@@ -46,15 +48,18 @@ func init() {
 	AddToTraits(newLoggingTraitTrait)
 	AddToTraits(NewMasterTrait)
 	AddToTraits(newMountTrait)
+	AddToTraits(newNetworkPolicyTrait)
 	AddToTraits(newOwnerTrait)
 	AddToTraits(newPdbTrait)
 	AddToTraits(newPodTrait)
 	AddToTraits(newPrometheusTrait)
 	AddToTraits(newPullSecretTrait)
 	AddToTraits(newQuarkusTrait)
+	AddToTraits(newRolloutTrait)
 	AddToTraits(newRouteTrait)
 	AddToTraits(newSecurityContextTrait)
 	AddToTraits(newServiceTrait)
+	AddToTraits(newStatefulSetTrait)
 	AddToTraits(NewTelemetryTrait)
 	AddToTraits(newTolerationTrait)
 	// ^^ Declaration order is not important, but let's keep them sorted for debugging.
@@ -18,17 +18,27 @@ limitations under the License.
 package trait
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/util"
 	"github.com/go-viper/mapstructure/v2"
 )
 
 type optionMap map[string]map[string]any
 
-var traitConfigRegexp = regexp.MustCompile(`^([a-z0-9-]+)((?:\.[a-z0-9-]+)(?:\[[0-9]+\]|\..+)*)=(.*)$`)
+// addonsTraitID is the section of the traits holding the configuration of the external traits.
+const addonsTraitID = "addons"
+
+var (
+	traitConfigRegexp          = regexp.MustCompile(`^([a-z0-9-]+)((?:\.[a-z0-9-]+)(?:\[[0-9]+\]|\..+)*)=(.*)$`)
+	unknownTraitRegexp         = regexp.MustCompile(`'v1\.Traits' has invalid keys: (.*)`)
+	unknownTraitPropertyRegexp = regexp.MustCompile(`'([a-z0-9-]+)' has invalid keys: (.*)`)
+)
 
 func ValidateTrait(catalog *Catalog, trait string) error {
 	tr := catalog.GetTrait(trait)
@@ -54,6 +64,9 @@ func ConfigureTraits(options []string, traits any, catalog Finder) error {
 	if err != nil {
 		return err
 	}
+	if err := configureAddonOptions(config, traits); err != nil {
+		return err
+	}
 
 	md := mapstructure.Metadata{}
 	decoder, err := mapstructure.NewDecoder(
@@ -75,6 +88,87 @@ func ConfigureTraits(options []string, traits any, catalog Finder) error {
 	return nil
 }
 
+// configureAddonOptions moves the options of the `addons` section out of the given options, into the addons of the
+// traits, as their configuration is passed as is to the external traits.
+func configureAddonOptions(config optionMap, traits any) error {
+	options, ok := config[addonsTraitID]
+	if !ok {
+		return nil
+	}
+	delete(config, addonsTraitID)
+
+	var addons *map[string]v1.AddonTrait
+	switch t := traits.(type) {
+	case *v1.Traits:
+		addons = &t.Addons
+	case *v1.IntegrationKitTraits:
+		addons = &t.Addons
+	default:
+		return fmt.Errorf("cannot configure the addons of %T", traits)
+	}
+	for id, option := range options {
+		data, err := json.Marshal(option)
+		if err != nil {
+			return err
+		}
+		if *addons == nil {
+			*addons = make(map[string]v1.AddonTrait)
+		}
+		(*addons)[id] = v1.AddonTrait{RawMessage: data}
+	}
+
+	return nil
+}
+
+// ValidateTraitOption checks that the given "<trait>.<prop>=<value>" option targets a trait of the catalog, with a
+// value which can be converted to the property type. The options of the `addons` section must target an external
+// trait of the catalog, whose properties are only known to its webhook.
+func ValidateTraitOption(catalog *Catalog, option string) error {
+	config, err := optionsToMap([]string{option})
+	if err != nil {
+		return err
+	}
+	if addons, ok := config[addonsTraitID]; ok {
+		for id := range addons {
+			if _, ok := catalog.GetTrait(id).(*externalTrait); !ok {
+				return fmt.Errorf("unknown external trait: %s", id)
+			}
+		}
+		delete(config, addonsTraitID)
+	}
+	for id := range config {
+		if catalog.GetTrait(id) == nil {
+			return fmt.Errorf("unknown trait: %s", id)
+		}
+	}
+
+	traits := v1.Traits{}
+	decoder, err := mapstructure.NewDecoder(
+		&mapstructure.DecoderConfig{
+			ErrorUnused:      true,
+			WeaklyTypedInput: true,
+			TagName:          "property",
+			Result:           &traits,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if err := decoder.Decode(config); err != nil {
+		// strip the decoder preamble and report the trait schema violations on a single line
+		msg := err.Error()
+		if _, details, ok := strings.Cut(msg, "\n\n"); ok {
+			msg = strings.ReplaceAll(details, "\n", "; ")
+		}
+		msg = unknownTraitRegexp.ReplaceAllString(msg, "unknown trait: $1")
+		msg = unknownTraitPropertyRegexp.ReplaceAllString(msg, "unknown property of trait $1: $2")
+
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
 func optionsToMap(options []string) (optionMap, error) {
 	optionMap := make(optionMap)
 
@@ -27,10 +27,12 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -147,3 +149,38 @@ func toAddonTrait(t *testing.T, config map[string]interface{}) v1.AddonTrait {
 
 	return addon
 }
+
+func TestValidateTraitOption(t *testing.T) {
+	catalog := NewCatalog(nil)
+	require.NoError(t, ValidateTraitOption(catalog, "service.enabled=false"))
+	require.NoError(t, ValidateTraitOption(catalog, "camel.properties=my.key=my-value"))
+	require.NoError(t, ValidateTraitOption(catalog, "knative-service.min-scale=1"))
+
+	require.EqualError(t, ValidateTraitOption(catalog, "foo.bar=1"), "unknown trait: foo")
+	require.EqualError(t, ValidateTraitOption(catalog, "service.foo=1"), "unknown property of trait service: foo")
+	require.EqualError(t, ValidateTraitOption(catalog, "container.port=abc"),
+		"'container.port' cannot parse value as 'int32': strconv.ParseInt: invalid syntax")
+	require.EqualError(t, ValidateTraitOption(catalog, "service"),
+		`unrecognized config format (expected "<trait>.<prop>=<value>"): service`)
+	require.EqualError(t, ValidateTraitOption(catalog, "addons.chargeback.cost-center=42"), "unknown external trait: chargeback")
+}
+
+func TestValidateExternalTraitOption(t *testing.T) {
+	catalog, _ := createExternalTraitTestEnv(t,
+		v1.ExternalTraitSpec{
+			ID:      "chargeback",
+			Webhook: v1.ExternalTraitWebhook{URL: "http://localhost:1"},
+		},
+		nil,
+	)
+
+	require.NoError(t, ValidateTraitOption(catalog, "addons.chargeback.cost-center=42"))
+	require.EqualError(t, ValidateTraitOption(catalog, "addons.other.cost-center=42"), "unknown external trait: other")
+
+	traits := v1.Traits{}
+	require.NoError(t, ConfigureTraits([]string{"addons.chargeback.cost-center=42", "service.enabled=false"}, &traits, catalog))
+	assert.Equal(t, map[string]v1.AddonTrait{
+		"chargeback": toAddonTrait(t, map[string]interface{}{"cost-center": "42"}),
+	}, traits.Addons)
+	assert.Equal(t, ptr.To(false), traits.Service.Enabled)
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"slices"
 	"sort"
@@ -38,6 +39,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/camel"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/source"
 )
 
 const (
@@ -236,6 +238,14 @@ type Environment struct {
 	ExecutedTraits        []Trait
 	EnvVars               []corev1.EnvVar
 	ApplicationProperties map[string]string
+	// The structured model of the routes, extracted once from either the original or all the Integration sources
+	routes map[bool]*routeModel
+}
+
+// routeModel is the structured model of the routes defined in the Integration sources it was extracted from.
+type routeModel struct {
+	sources []v1.SourceSpec
+	routes  *source.RouteModel
 }
 
 // ControllerStrategy is used to determine the kind of controller that needs to be created for the integration.
@@ -246,6 +256,8 @@ const (
 	ControllerStrategyDeployment     ControllerStrategy = "deployment"
 	ControllerStrategyKnativeService ControllerStrategy = "knative-service"
 	ControllerStrategyCronJob        ControllerStrategy = "cron-job"
+	ControllerStrategyStatefulSet    ControllerStrategy = "statefulset"
+	ControllerStrategyJob            ControllerStrategy = "job"
 
 	DefaultControllerStrategy = ControllerStrategyDeployment
 )
@@ -360,6 +372,22 @@ func (e *Environment) GetIntegrationPodSpec() *corev1.PodSpec {
 		return &deployment.Spec.Template.Spec
 	}
 
+	// StatefulSet
+	statefulSet := e.Resources.GetStatefulSet(func(s *appsv1.StatefulSet) bool {
+		return s.Name == e.Integration.Name
+	})
+	if statefulSet != nil {
+		return &statefulSet.Spec.Template.Spec
+	}
+
+	// Job
+	job := e.Resources.GetJob(func(j *batchv1.Job) bool {
+		return j.Name == e.Integration.Name
+	})
+	if job != nil {
+		return &job.Spec.Template.Spec
+	}
+
 	// Knative service
 	knativeService := e.Resources.GetKnativeService(func(s *serving.Service) bool {
 		return s.Name == e.Integration.Name
@@ -596,6 +624,45 @@ func (e *Environment) consumeSourcesMeta(
 	return consumeMeta(meta), nil
 }
 
+// ConsumeRoutes is used to consume the structured model of the routes defined in the Integration sources.
+// Only the YAML and XML sources contribute to the model, which is extracted once and extracted again only when the
+// Integration sources change (ie, when a trait generates a source).
+func (e *Environment) ConsumeRoutes(originalSourcesOnly bool, consumeRoutes func(*source.RouteModel) bool) (bool, error) {
+	if e.Integration == nil {
+		return false, nil
+	}
+	var sources []v1.SourceSpec
+	if originalSourcesOnly {
+		sources = e.Integration.OriginalSourcesOnly()
+	} else {
+		sources = e.Integration.AllSources()
+	}
+	if len(sources) < 1 {
+		// No sources available
+		return false, nil
+	}
+	if cached, ok := e.routes[originalSourcesOnly]; ok && reflect.DeepEqual(cached.sources, sources) {
+		return consumeRoutes(cached.routes), nil
+	}
+	resolved, err := resolveIntegrationSources(e.Ctx, e.Client, e.Integration, originalSourcesOnly, e.Resources)
+	if err != nil {
+		return false, err
+	}
+	if e.CamelCatalog == nil {
+		return false, errors.New("cannot extract routes from sources. Camel Catalog is null")
+	}
+	routes, err := metadata.ExtractRoutes(e.CamelCatalog, resolved)
+	if err != nil {
+		return false, err
+	}
+	if e.routes == nil {
+		e.routes = make(map[bool]*routeModel)
+	}
+	e.routes[originalSourcesOnly] = &routeModel{sources: sources, routes: routes}
+
+	return consumeRoutes(routes), nil
+}
+
 func (e *Environment) appendCloudPropertiesLocation(cloudPropertiesLocation string) {
 	if e.ApplicationProperties["camel.main.cloud-properties-location"] == "" {
 		e.ApplicationProperties["camel.main.cloud-properties-location"] = cloudPropertiesLocation
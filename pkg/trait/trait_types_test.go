@@ -32,6 +32,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/util/camel"
 	"github.com/apache/camel-k/v2/pkg/util/defaults"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/source"
 )
 
 func TestCollectConfigurationPairs(t *testing.T) {
@@ -249,3 +250,56 @@ func createNonManagedBuildTestEnvironment(t *testing.T, profile v1.TraitProfile)
 
 	return environment
 }
+
+func TestConsumeRoutesExtractedOnce(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	e := &Environment{
+		CamelCatalog: catalog,
+		Integration: &v1.Integration{
+			Spec: v1.IntegrationSpec{
+				Sources: []v1.SourceSpec{
+					v1.NewSourceSpec("rest.yaml", `
+- rest:
+    path: /api
+    get:
+      - path: /orders
+        to: direct:orders
+`, v1.LanguageYaml),
+				},
+			},
+		},
+	}
+	var paths []string
+	consume := func(routes *source.RouteModel) bool {
+		paths = routes.RestPaths()
+
+		return true
+	}
+
+	_, err = e.ConsumeRoutes(false, consume)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/api/orders"}, paths)
+
+	// The sources are not inspected again
+	e.CamelCatalog = nil
+	_, err = e.ConsumeRoutes(false, consume)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/api/orders"}, paths)
+
+	// unless they change
+	e.Integration.Status.GeneratedSources = []v1.SourceSpec{
+		v1.NewSourceSpec("generated.yaml", `
+- rest:
+    get:
+      - path: /users
+        to: direct:users
+`, v1.LanguageYaml),
+	}
+	_, err = e.ConsumeRoutes(false, consume)
+	require.Error(t, err)
+	e.CamelCatalog = catalog
+	_, err = e.ConsumeRoutes(false, consume)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"/api/orders", "/users"}, paths)
+}
@@ -44,6 +44,8 @@ type Binding struct {
 	Traits v1.Traits
 	// ApplicationProperties contain properties that should be set on the integration for the binding to work
 	ApplicationProperties map[string]string
+	// Configs contain the configmap/secret resources (mount trait syntax) holding any referenced property value
+	Configs []string
 }
 
 // BindingProvider maps a Binding endpoint into Camel K resources.
@@ -18,6 +18,10 @@ limitations under the License.
 package bindings
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/util/uri"
 )
@@ -39,14 +43,23 @@ func (k CamelURIBindingProvider) Translate(ctx BindingContext, endpointCtx Endpo
 	}
 
 	endpointURI := *e.URI
-	props, err := e.Properties.GetPropertyMap()
+	props, refs, err := endpointProperties(e)
 	if err != nil {
 		return nil, err
 	}
 	endpointURI = uri.AppendParameters(endpointURI, props)
+	// placeholders must not be escaped in order to be resolved by the runtime
+	for _, r := range refs {
+		separator := "&"
+		if !strings.Contains(endpointURI, "?") {
+			separator = "?"
+		}
+		endpointURI = fmt.Sprintf("%s%s%s=%s", endpointURI, separator, url.QueryEscape(r.Key), r.Placeholder)
+	}
 
 	return &Binding{
-		URI: endpointURI,
+		URI:     endpointURI,
+		Configs: refConfigs(refs),
 	}, nil
 }
 
@@ -83,3 +83,33 @@ func TestCamelURISkip(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, binding)
 }
+
+func TestCamelURIWithSecretRef(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	bindingContext := BindingContext{
+		Ctx:       ctx,
+		Client:    client,
+		Namespace: "test",
+		Profile:   camelv1.TraitProfileKubernetes,
+	}
+
+	endpoint := camelv1.Endpoint{
+		URI: ptr.To("my-component:my-uri"),
+		Properties: &camelv1.EndpointProperties{
+			RawMessage: []byte(`{"prop1":"v1","password":{"secretKeyRef":{"name":"my-secret","key":"pwd"}}}`),
+		},
+	}
+
+	binding, err := CamelURIBindingProvider{}.Translate(bindingContext, EndpointContext{
+		Type: camelv1.EndpointTypeSink,
+	}, endpoint)
+	require.NoError(t, err)
+	assert.NotNil(t, binding)
+	assert.Equal(t, "my-component:my-uri?prop1=v1&password={{secret:my-secret/pwd}}", binding.URI)
+	assert.Equal(t, []string{"secret:my-secret/pwd"}, binding.Configs)
+}
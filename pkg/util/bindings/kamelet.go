@@ -59,7 +59,7 @@ func (k BindingConverter) Translate(ctx BindingContext, endpointCtx EndpointCont
 
 	kameletName := url.PathEscape(e.Ref.Name)
 
-	props, err := e.Properties.GetPropertyMap()
+	props, refs, err := endpointProperties(e)
 	if err != nil {
 		return nil, err
 	}
@@ -85,12 +85,18 @@ func (k BindingConverter) Translate(ctx BindingContext, endpointCtx EndpointCont
 
 	kameletTranslated := getKameletName(kameletName, id, version, namespace)
 
-	binding := Binding{}
+	binding := Binding{
+		Configs: refConfigs(refs),
+	}
 	binding.ApplicationProperties = make(map[string]string)
 	for k, v := range props {
 		propKey := fmt.Sprintf("camel.kamelet.%s.%s.%s", kameletName, id, k)
 		binding.ApplicationProperties[propKey] = v
 	}
+	for _, r := range refs {
+		propKey := fmt.Sprintf("camel.kamelet.%s.%s.%s", kameletName, id, r.Key)
+		binding.ApplicationProperties[propKey] = r.Placeholder
+	}
 
 	dataTypeActionKamelet := ctx.Metadata[v1.KameletDataTypeLabel]
 	if dataTypeActionKamelet == "" {
@@ -0,0 +1,130 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindings
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// propertyValueFrom is an endpoint property value stored into a Secret or a ConfigMap key, ie:
+//
+//	password:
+//	  secretKeyRef:
+//	    name: my-secret
+//	    key: password
+type propertyValueFrom struct {
+	SecretKeyRef    *corev1.SecretKeySelector    `json:"secretKeyRef,omitempty"`
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+}
+
+// propertyRef is an endpoint property whose value is resolved at runtime from a Secret or ConfigMap key.
+type propertyRef struct {
+	// Key is the endpoint property name
+	Key string
+	// Placeholder is the property placeholder resolving the value, ie, {{secret:my-secret/password}}
+	Placeholder string
+	// Config is the resource to be mounted in the mount trait syntax, ie, secret:my-secret/password
+	Config string
+}
+
+// endpointProperties returns the endpoint properties as a map, excluding any value referencing a Secret or
+// ConfigMap key, which is returned as a propertyRef instead, so that the value is never stored in clear text.
+func endpointProperties(e v1.Endpoint) (map[string]string, []propertyRef, error) {
+	props, err := e.Properties.GetPropertyMap()
+	if err != nil || len(props) == 0 {
+		return props, nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(e.Properties.RawMessage, &raw); err != nil {
+		return nil, nil, err
+	}
+	var refs []propertyRef
+	for k, v := range raw {
+		if !bytes.HasPrefix(bytes.TrimSpace(v), []byte("{")) {
+			continue
+		}
+		var valueFrom propertyValueFrom
+		d := json.NewDecoder(bytes.NewReader(v))
+		d.DisallowUnknownFields()
+		if err := d.Decode(&valueFrom); err != nil {
+			// Not a reference, keep the value as it is
+			continue
+		}
+		ref, err := valueFrom.ref(k)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid value for property %q: %w", k, err)
+		}
+		if ref == nil {
+			continue
+		}
+		delete(props, k)
+		refs = append(refs, *ref)
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].Key < refs[j].Key
+	})
+
+	return props, refs, nil
+}
+
+// ref returns the reference to the Secret or ConfigMap key, if any.
+func (p propertyValueFrom) ref(key string) (*propertyRef, error) {
+	var storage, name, selectorKey string
+	switch {
+	case p.SecretKeyRef != nil && p.ConfigMapKeyRef != nil:
+		return nil, errors.New("only one of secretKeyRef or configMapKeyRef can be set")
+	case p.SecretKeyRef != nil:
+		storage, name, selectorKey = "secret", p.SecretKeyRef.Name, p.SecretKeyRef.Key
+	case p.ConfigMapKeyRef != nil:
+		storage, name, selectorKey = "configmap", p.ConfigMapKeyRef.Name, p.ConfigMapKeyRef.Key
+	default:
+		return nil, nil
+	}
+	if name == "" || selectorKey == "" {
+		return nil, fmt.Errorf("both name and key of the %s reference must be set", storage)
+	}
+	resource := fmt.Sprintf("%s:%s/%s", storage, name, selectorKey)
+
+	return &propertyRef{
+		Key:         key,
+		Placeholder: fmt.Sprintf("{{%s}}", resource),
+		Config:      resource,
+	}, nil
+}
+
+// refConfigs returns the resources to be mounted for the given references.
+func refConfigs(refs []propertyRef) []string {
+	if len(refs) == 0 {
+		return nil
+	}
+	configs := make([]string, 0, len(refs))
+	for _, r := range refs {
+		configs = append(configs, r.Config)
+	}
+
+	return configs
+}
@@ -0,0 +1,70 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func TestEndpointPropertiesWithRefs(t *testing.T) {
+	endpoint := camelv1.Endpoint{
+		Properties: &camelv1.EndpointProperties{
+			RawMessage: []byte(`{
+				"user": "admin",
+				"password": {"secretKeyRef": {"name": "my-secret", "key": "pwd"}},
+				"region": {"configMapKeyRef": {"name": "my-cm", "key": "region"}},
+				"nested": {"some": "value"}
+			}`),
+		},
+	}
+
+	props, refs, err := endpointProperties(endpoint)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"user":   "admin",
+		"nested": "map[some:value]",
+	}, props)
+	assert.Equal(t, []propertyRef{
+		{Key: "password", Placeholder: "{{secret:my-secret/pwd}}", Config: "secret:my-secret/pwd"},
+		{Key: "region", Placeholder: "{{configmap:my-cm/region}}", Config: "configmap:my-cm/region"},
+	}, refs)
+	assert.Equal(t, []string{"secret:my-secret/pwd", "configmap:my-cm/region"}, refConfigs(refs))
+}
+
+func TestEndpointPropertiesWithInvalidRefs(t *testing.T) {
+	_, _, err := endpointProperties(camelv1.Endpoint{
+		Properties: &camelv1.EndpointProperties{
+			RawMessage: []byte(`{"password": {"secretKeyRef": {"name": "my-secret"}}}`),
+		},
+	})
+	require.Error(t, err)
+	assert.Equal(t, `invalid value for property "password": both name and key of the secret reference must be set`, err.Error())
+
+	_, _, err = endpointProperties(camelv1.Endpoint{
+		Properties: &camelv1.EndpointProperties{
+			RawMessage: []byte(`{"password": {"secretKeyRef": {"name": "s", "key": "k"}, "configMapKeyRef": {"name": "c", "key": "k"}}}`),
+		},
+	})
+	require.Error(t, err)
+	assert.Equal(t, `invalid value for property "password": only one of secretKeyRef or configMapKeyRef can be set`, err.Error())
+}
@@ -0,0 +1,212 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dsl
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	yaml2 "gopkg.in/yaml.v2"
+
+	"github.com/apache/camel-k/v2/pkg/util/openapi"
+)
+
+// OpenAPIPath is the path the OpenAPI document is served at.
+const OpenAPIPath = "/openapi"
+
+// jsonValidationException is the exception thrown by the json-validator component when a body does not match the schema.
+const jsonValidationException = "org.apache.camel.component.jsonvalidator.JsonValidationException"
+
+// OpenAPISchemaName returns the name of the file holding the JSON schema of the request body of the given operation.
+func OpenAPISchemaName(operationID string) string {
+	return operationID + ".schema.json"
+}
+
+// OpenAPIToYamlDSL converts an OpenAPI document into the Camel YAML DSL REST services implementing it. Each operation
+// is routed to a `direct:<operationId>` endpoint, and the document, found at the given location, is served at `/openapi`.
+// When a schema location is set, the JSON request bodies are first validated against the schemas found at that location,
+// named after the operation IDs, and the requests with an invalid body are rejected with a 400 status code.
+func OpenAPIToYamlDSL(doc *openapi.Document, id, location, mediaType, schemaLocation string) ([]byte, error) {
+	endpoints, err := doc.Endpoints()
+	if err != nil {
+		return nil, err
+	}
+
+	validated := make(map[string]bool)
+	if schemaLocation != "" {
+		for _, endpoint := range endpoints {
+			validated[endpoint.ID] = endpoint.BodySchema != nil
+		}
+	}
+
+	rest := yaml2.MapSlice{{Key: "id", Value: id}}
+	if basePath := doc.BasePath(); basePath != "" {
+		rest = append(rest, yaml2.MapItem{Key: "path", Value: basePath})
+	}
+	for _, method := range openapi.Methods {
+		var verbs []yaml2.MapSlice
+		for _, endpoint := range endpoints {
+			if endpoint.Method == method {
+				verbs = append(verbs, restVerb(endpoint, validated[endpoint.ID]))
+			}
+		}
+		if len(verbs) > 0 {
+			rest = append(rest, yaml2.MapItem{Key: method, Value: verbs})
+		}
+	}
+
+	route := yaml2.MapSlice{
+		{Key: "id", Value: id + "-openapi"},
+		{Key: "from", Value: yaml2.MapSlice{
+			{Key: "uri", Value: "platform-http:" + OpenAPIPath + "?httpMethodRestrict=GET"},
+			{Key: "steps", Value: []yaml2.MapSlice{
+				{{Key: "setHeader", Value: yaml2.MapSlice{
+					{Key: "name", Value: "Content-Type"},
+					{Key: "constant", Value: mediaType},
+				}}},
+				{{Key: "setBody", Value: yaml2.MapSlice{
+					{Key: "constant", Value: "resource:file:" + location},
+				}}},
+			}},
+		}},
+	}
+
+	flows := []yaml2.MapSlice{
+		{{Key: "rest", Value: rest}},
+		{{Key: "route", Value: route}},
+	}
+	for _, endpoint := range endpoints {
+		if validated[endpoint.ID] {
+			flows = append(flows, yaml2.MapSlice{{Key: "route", Value: validationRoute(endpoint, schemaLocation)}})
+		}
+	}
+
+	yamldata, err := yaml2.Marshal(flows)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling to yaml: %w", err)
+	}
+
+	return yamldata, nil
+}
+
+func restVerb(endpoint openapi.Endpoint, validated bool) yaml2.MapSlice {
+	verb := yaml2.MapSlice{
+		{Key: "id", Value: endpoint.ID},
+		{Key: "path", Value: endpoint.Path},
+	}
+	if endpoint.Operation.Summary != "" {
+		verb = append(verb, yaml2.MapItem{Key: "description", Value: endpoint.Operation.Summary})
+	}
+	if len(endpoint.Consumes) > 0 {
+		verb = append(verb, yaml2.MapItem{Key: "consumes", Value: strings.Join(endpoint.Consumes, ",")})
+	}
+	if len(endpoint.Produces) > 0 {
+		verb = append(verb, yaml2.MapItem{Key: "produces", Value: strings.Join(endpoint.Produces, ",")})
+	}
+
+	var params []yaml2.MapSlice
+	for _, p := range endpoint.Parameters {
+		// cookie parameters are not supported by the REST DSL
+		if p.In == "cookie" {
+			continue
+		}
+		params = append(params, restParam(p))
+	}
+	if body := endpoint.Operation.RequestBody; body != nil {
+		params = append(params, yaml2.MapSlice{
+			{Key: "name", Value: "body"},
+			{Key: "type", Value: "body"},
+			{Key: "required", Value: body.Required},
+		})
+	}
+	if len(params) > 0 {
+		verb = append(verb, yaml2.MapItem{Key: "param", Value: params})
+	}
+
+	to := "direct:" + endpoint.ID
+	if validated {
+		to = "direct:" + endpoint.ID + "-validation"
+	}
+
+	return append(verb, yaml2.MapItem{Key: "to", Value: to})
+}
+
+// validationRoute validates the request body against the operation schema before routing it to the operation endpoint.
+func validationRoute(endpoint openapi.Endpoint, schemaLocation string) yaml2.MapSlice {
+	return yaml2.MapSlice{
+		{Key: "id", Value: endpoint.ID + "-validation"},
+		{Key: "from", Value: yaml2.MapSlice{
+			{Key: "uri", Value: "direct:" + endpoint.ID + "-validation"},
+			{Key: "steps", Value: []yaml2.MapSlice{
+				{{Key: "doTry", Value: yaml2.MapSlice{
+					{Key: "steps", Value: []yaml2.MapSlice{
+						{{Key: "to", Value: "json-validator:file:" + path.Join(schemaLocation, OpenAPISchemaName(endpoint.ID))}},
+					}},
+					{Key: "doCatch", Value: []yaml2.MapSlice{{
+						{Key: "exception", Value: []string{jsonValidationException}},
+						{Key: "steps", Value: []yaml2.MapSlice{
+							{{Key: "setHeader", Value: yaml2.MapSlice{
+								{Key: "name", Value: "CamelHttpResponseCode"},
+								{Key: "constant", Value: "400"},
+							}}},
+							{{Key: "setBody", Value: yaml2.MapSlice{
+								{Key: "simple", Value: "${exception.message}"},
+							}}},
+							{{Key: "stop", Value: yaml2.MapSlice{}}},
+						}},
+					}}},
+				}}},
+				{{Key: "to", Value: "direct:" + endpoint.ID}},
+			}},
+		}},
+	}
+}
+
+func restParam(p openapi.Parameter) yaml2.MapSlice {
+	param := yaml2.MapSlice{
+		{Key: "name", Value: p.Name},
+		{Key: "type", Value: p.In},
+		// path parameters are always required
+		{Key: "required", Value: p.Required || p.In == "path"},
+	}
+	if p.Description != "" {
+		param = append(param, yaml2.MapItem{Key: "description", Value: p.Description})
+	}
+	if p.Schema == nil {
+		return param
+	}
+	if p.Schema.Type != "" {
+		param = append(param, yaml2.MapItem{Key: "dataType", Value: p.Schema.Type})
+	}
+	if p.Schema.Format != "" {
+		param = append(param, yaml2.MapItem{Key: "dataFormat", Value: p.Schema.Format})
+	}
+	if p.Schema.Default != nil {
+		param = append(param, yaml2.MapItem{Key: "defaultValue", Value: fmt.Sprint(p.Schema.Default)})
+	}
+	if len(p.Schema.Enum) > 0 {
+		values := make([]yaml2.MapSlice, 0, len(p.Schema.Enum))
+		for _, v := range p.Schema.Enum {
+			values = append(values, yaml2.MapSlice{{Key: "value", Value: fmt.Sprint(v)}})
+		}
+		param = append(param, yaml2.MapItem{Key: "allowableValues", Value: values})
+	}
+
+	return param
+}
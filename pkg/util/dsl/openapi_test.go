@@ -0,0 +1,176 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dsl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/apache/camel-k/v2/pkg/util/openapi"
+)
+
+func TestOpenAPIToYamlDSL(t *testing.T) {
+	doc, err := openapi.Parse([]byte(`
+openapi: 3.0.3
+servers:
+  - url: /api
+paths:
+  /pets:
+    post:
+      operationId: createPet
+      summary: Create a pet
+      requestBody:
+        required: true
+        content:
+          application/json: {}
+      responses:
+        "201":
+          description: created
+  /pets/{petId}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: petId
+          in: path
+          schema:
+            type: string
+        - name: status
+          in: query
+          schema:
+            type: string
+            default: available
+            enum: [available, sold]
+        - name: session
+          in: cookie
+      responses:
+        "200":
+          content:
+            application/json: {}
+`))
+	require.NoError(t, err)
+
+	yamlBytes, err := OpenAPIToYamlDSL(doc, "petstore", "/etc/camel/resources/openapi/petstore.yaml", "application/yaml", "")
+	require.NoError(t, err)
+	expected := `- rest:
+    id: petstore
+    path: /api
+    get:
+    - id: getPet
+      path: /pets/{petId}
+      produces: application/json
+      param:
+      - name: petId
+        type: path
+        required: true
+        dataType: string
+      - name: status
+        type: query
+        required: false
+        dataType: string
+        defaultValue: available
+        allowableValues:
+        - value: available
+        - value: sold
+      to: direct:getPet
+    post:
+    - id: createPet
+      path: /pets
+      description: Create a pet
+      consumes: application/json
+      param:
+      - name: body
+        type: body
+        required: true
+      to: direct:createPet
+- route:
+    id: petstore-openapi
+    from:
+      uri: platform-http:/openapi?httpMethodRestrict=GET
+      steps:
+      - setHeader:
+          name: Content-Type
+          constant: application/yaml
+      - setBody:
+          constant: resource:file:/etc/camel/resources/openapi/petstore.yaml
+`
+	assert.Equal(t, expected, string(yamlBytes))
+}
+
+func TestOpenAPIToYamlDSLWithValidation(t *testing.T) {
+	doc, err := openapi.Parse([]byte(`
+openapi: 3.0.3
+paths:
+  /pets:
+    post:
+      operationId: createPet
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+`))
+	require.NoError(t, err)
+
+	yamlBytes, err := OpenAPIToYamlDSL(doc, "petstore", "/etc/camel/resources/openapi/petstore.yaml", "application/yaml",
+		"/etc/camel/resources/openapi")
+	require.NoError(t, err)
+	expected := `- rest:
+    id: petstore
+    post:
+    - id: createPet
+      path: /pets
+      consumes: application/json
+      param:
+      - name: body
+        type: body
+        required: false
+      to: direct:createPet-validation
+- route:
+    id: petstore-openapi
+    from:
+      uri: platform-http:/openapi?httpMethodRestrict=GET
+      steps:
+      - setHeader:
+          name: Content-Type
+          constant: application/yaml
+      - setBody:
+          constant: resource:file:/etc/camel/resources/openapi/petstore.yaml
+- route:
+    id: createPet-validation
+    from:
+      uri: direct:createPet-validation
+      steps:
+      - doTry:
+          steps:
+          - to: json-validator:file:/etc/camel/resources/openapi/createPet.schema.json
+          doCatch:
+          - exception:
+            - org.apache.camel.component.jsonvalidator.JsonValidationException
+            steps:
+            - setHeader:
+                name: CamelHttpResponseCode
+                constant: "400"
+            - setBody:
+                simple: ${exception.message}
+            - stop: {}
+      - to: direct:createPet
+`
+	assert.Equal(t, expected, string(yamlBytes))
+}
@@ -265,14 +265,15 @@ func (c *Collection) GetUserServiceForIntegration(integration *v1.Integration) *
 	})
 }
 
-// GetServiceForIntegration returns a user Service for the given integration.
+// GetServiceForIntegration returns a user Service for the given integration. The headless Service governing the pods of
+// a StatefulSet is ignored, as it does not expose the integration.
 func (c *Collection) GetServiceForIntegration(integration *v1.Integration) *corev1.Service {
 	if integration == nil {
 		return nil
 	}
 
 	return c.GetService(func(s *corev1.Service) bool {
-		return s.Labels != nil && s.Labels[v1.IntegrationLabel] == integration.Name
+		return s.Labels != nil && s.Labels[v1.IntegrationLabel] == integration.Name && s.Spec.ClusterIP != corev1.ClusterIPNone
 	})
 }
 
@@ -365,6 +366,70 @@ func (c *Collection) VisitCronJobE(visitor func(*batchv1.CronJob) error) error {
 	})
 }
 
+// GetJob returns a Job that matches the given function.
+func (c *Collection) GetJob(filter func(*batchv1.Job) bool) *batchv1.Job {
+	var retValue *batchv1.Job
+	c.VisitJob(func(re *batchv1.Job) {
+		if filter(re) {
+			retValue = re
+		}
+	})
+
+	return retValue
+}
+
+// VisitJob executes the visitor function on all Job resources.
+func (c *Collection) VisitJob(visitor func(*batchv1.Job)) {
+	c.Visit(func(res runtime.Object) {
+		if conv, ok := res.(*batchv1.Job); ok {
+			visitor(conv)
+		}
+	})
+}
+
+// VisitJobE executes the visitor function on all Job resources.
+func (c *Collection) VisitJobE(visitor func(*batchv1.Job) error) error {
+	return c.VisitE(func(res runtime.Object) error {
+		if conv, ok := res.(*batchv1.Job); ok {
+			return visitor(conv)
+		}
+
+		return nil
+	})
+}
+
+// GetStatefulSet returns a StatefulSet that matches the given function.
+func (c *Collection) GetStatefulSet(filter func(*appsv1.StatefulSet) bool) *appsv1.StatefulSet {
+	var retValue *appsv1.StatefulSet
+	c.VisitStatefulSet(func(re *appsv1.StatefulSet) {
+		if filter(re) {
+			retValue = re
+		}
+	})
+
+	return retValue
+}
+
+// VisitStatefulSet executes the visitor function on all StatefulSet resources.
+func (c *Collection) VisitStatefulSet(visitor func(*appsv1.StatefulSet)) {
+	c.Visit(func(res runtime.Object) {
+		if conv, ok := res.(*appsv1.StatefulSet); ok {
+			visitor(conv)
+		}
+	})
+}
+
+// VisitStatefulSetE executes the visitor function on all StatefulSet resources.
+func (c *Collection) VisitStatefulSetE(visitor func(*appsv1.StatefulSet) error) error {
+	return c.VisitE(func(res runtime.Object) error {
+		if conv, ok := res.(*appsv1.StatefulSet); ok {
+			return visitor(conv)
+		}
+
+		return nil
+	})
+}
+
 // VisitKnativeService executes the visitor function on all Knative serving Service resources.
 func (c *Collection) VisitKnativeService(visitor func(*serving.Service)) {
 	c.Visit(func(res runtime.Object) {
@@ -456,9 +521,21 @@ func (c *Collection) VisitContainer(visitor func(container *corev1.Container)) {
 			visitor(cntref)
 		}
 	})
+	c.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+		for idx := range s.Spec.Template.Spec.Containers {
+			cntref := &s.Spec.Template.Spec.Containers[idx]
+			visitor(cntref)
+		}
+	})
+	c.VisitJob(func(j *batchv1.Job) {
+		for idx := range j.Spec.Template.Spec.Containers {
+			cntref := &j.Spec.Template.Spec.Containers[idx]
+			visitor(cntref)
+		}
+	})
 }
 
-// GetController returns the controller associated with the integration (e.g. Deployment, Knative Service or CronJob).
+// GetController returns the controller associated with the integration (e.g. Deployment, Knative Service, CronJob, StatefulSet or Job).
 func (c *Collection) GetController(filter func(object ctrl.Object) bool) ctrl.Object {
 	d := c.GetDeployment(func(deployment *appsv1.Deployment) bool {
 		return filter(deployment)
@@ -478,6 +555,18 @@ func (c *Collection) GetController(filter func(object ctrl.Object) bool) ctrl.Ob
 	if cj != nil {
 		return cj
 	}
+	ss := c.GetStatefulSet(func(set *appsv1.StatefulSet) bool {
+		return filter(set)
+	})
+	if ss != nil {
+		return ss
+	}
+	j := c.GetJob(func(job *batchv1.Job) bool {
+		return filter(job)
+	})
+	if j != nil {
+		return j
+	}
 
 	return nil
 }
@@ -493,6 +582,12 @@ func (c *Collection) VisitPodSpec(visitor func(container *corev1.PodSpec)) {
 	c.VisitCronJob(func(d *batchv1.CronJob) {
 		visitor(&d.Spec.JobTemplate.Spec.Template.Spec)
 	})
+	c.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+		visitor(&s.Spec.Template.Spec)
+	})
+	c.VisitJob(func(j *batchv1.Job) {
+		visitor(&j.Spec.Template.Spec)
+	})
 }
 
 // VisitPodTemplateMeta executes the visitor function on all PodTemplate metadata inside deployments or other resources.
@@ -506,6 +601,12 @@ func (c *Collection) VisitPodTemplateMeta(visitor func(meta *metav1.ObjectMeta))
 	c.VisitCronJob(func(d *batchv1.CronJob) {
 		visitor(&d.Spec.JobTemplate.Spec.Template.ObjectMeta)
 	})
+	c.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+		visitor(&s.Spec.Template.ObjectMeta)
+	})
+	c.VisitJob(func(j *batchv1.Job) {
+		visitor(&j.Spec.Template.ObjectMeta)
+	})
 }
 
 // VisitKnativeConfigurationSpec executes the visitor function on all knative ConfigurationSpec inside serving Services.
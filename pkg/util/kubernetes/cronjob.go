@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"maps"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/apache/camel-k/v2/pkg/util"
+)
+
+const (
+	// CronJobInstantiateAnnotation marks the Jobs created manually from a CronJob, as done by `kubectl create job --from`.
+	CronJobInstantiateAnnotation = "cronjob.kubernetes.io/instantiate"
+	// CronJobInstantiateManual is the value of CronJobInstantiateAnnotation for Jobs created manually.
+	CronJobInstantiateManual = "manual"
+
+	manualJobSuffixLength = 5
+)
+
+// NewManualJobFromCronJob returns a Job created out of the template of the given CronJob, owned by the CronJob,
+// to run it immediately regardless of its schedule.
+func NewManualJobFromCronJob(cronJob *batchv1.CronJob) *batchv1.Job {
+	template := cronJob.Spec.JobTemplate.DeepCopy()
+
+	labels := maps.Clone(template.Spec.Template.Labels)
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	maps.Copy(labels, template.Labels)
+	annotations := maps.Clone(template.Annotations)
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[CronJobInstantiateAnnotation] = CronJobInstantiateManual
+
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: batchv1.SchemeGroupVersion.String(),
+			Kind:       "Job",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        cronJob.Name + "-manual-" + strings.ToLower(util.RandomString(manualJobSuffixLength)),
+			Namespace:   cronJob.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: batchv1.SchemeGroupVersion.String(),
+					Kind:       "CronJob",
+					Name:       cronJob.Name,
+					UID:        cronJob.UID,
+					Controller: ptr.To(true),
+				},
+			},
+		},
+		Spec: template.Spec,
+	}
+}
+
+// IsManualJob returns true if the given Job has been created manually from a CronJob.
+func IsManualJob(job batchv1.Job) bool {
+	return job.Annotations[CronJobInstantiateAnnotation] == CronJobInstantiateManual
+}
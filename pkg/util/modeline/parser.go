@@ -34,14 +34,32 @@ var (
 )
 
 func Parse(name, content string) ([]Option, error) {
+	located, err := ParseLocated(name, content)
+	if err != nil {
+		return nil, err
+	}
+	var res []Option
+	for _, o := range located {
+		res = append(res, o.Option)
+	}
+
+	return res, nil
+}
+
+// ParseLocated parses the modeline options of the given source, along with the line they are declared at.
+func ParseLocated(name, content string) ([]LocatedOption, error) {
 	lang := inferLanguage(name)
 	if lang == "" {
 		return nil, fmt.Errorf("unsupported file type %s", name)
 	}
-	var res []Option
+	var res []LocatedOption
+	line := 0
 	scanner := bufio.NewScanner(strings.NewReader(content))
 	for scanner.Scan() {
-		res = append(res, getModelineOptions(scanner.Text(), lang)...)
+		line++
+		for _, o := range getModelineOptions(scanner.Text(), lang) {
+			res = append(res, LocatedOption{Option: o, Source: name, Line: line})
+		}
 	}
 
 	return res, scanner.Err()
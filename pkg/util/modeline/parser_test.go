@@ -117,3 +117,20 @@ func TestParseXMLFile(t *testing.T) {
 	assert.Contains(t, opts, Option{Name: "ciao"})
 	assert.Contains(t, opts, Option{Name: "language", Value: "xml"})
 }
+
+func TestParseLocated(t *testing.T) {
+	it := `# camel-k: language=yaml
+# Write your routes here
+# camel-k: trait=service.enabled=false dependency=camel:mail
+- from:
+    uri: "timer:yaml"
+`
+	opts, err := ParseLocated("route.yaml", it)
+	require.NoError(t, err)
+	assert.Equal(t, []LocatedOption{
+		{Option: Option{Name: "language", Value: "yaml"}, Source: "route.yaml", Line: 1},
+		{Option: Option{Name: "trait", Value: "service.enabled=false"}, Source: "route.yaml", Line: 3},
+		{Option: Option{Name: "dependency", Value: "camel:mail"}, Source: "route.yaml", Line: 3},
+	}, opts)
+	assert.Equal(t, "route.yaml:3", opts[1].Location())
+}
@@ -0,0 +1,114 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modeline
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/apache/camel-k/v2/pkg/util/resource"
+)
+
+// The kamel run options which can be honoured by the operator.
+const (
+	OptionTrait         = "trait"
+	OptionProperty      = "property"
+	OptionBuildProperty = "build-property"
+	OptionConfig        = "config"
+	OptionResource      = "resource"
+	OptionVolume        = "volume"
+	OptionEnv           = "env"
+	OptionDependency    = "dependency"
+	OptionProfile       = "profile"
+	OptionLanguage      = "language"
+)
+
+var shorthands = map[string]string{
+	"t": OptionTrait,
+	"p": OptionProperty,
+	"d": OptionDependency,
+	"v": OptionVolume,
+	"e": OptionEnv,
+}
+
+// Normalize returns the option using the long name of the kamel run flag.
+func Normalize(o Option) Option {
+	if name, ok := shorthands[o.Name]; ok {
+		o.Name = name
+	}
+
+	return o
+}
+
+// ToTraitOption converts a kamel run modeline option to the "<trait>.<prop>=<value>" trait option
+// it stands for, the same way kamel run does.
+func ToTraitOption(o Option) (string, error) {
+	o = Normalize(o)
+	if o.Value == "" {
+		return "", fmt.Errorf("option %q requires a value", o.Name)
+	}
+
+	switch o.Name {
+	case OptionTrait:
+		return o.Value, nil
+	case OptionProperty, OptionBuildProperty:
+		if strings.HasPrefix(o.Value, "file:") || strings.HasPrefix(o.Value, "configmap:") || strings.HasPrefix(o.Value, "secret:") {
+			return "", fmt.Errorf("option %q only supports key=value properties when applied by the operator", o.Name)
+		}
+		if !strings.Contains(o.Value, "=") {
+			return "", fmt.Errorf("invalid %s %q, expected key=value", o.Name, o.Value)
+		}
+		if o.Name == OptionBuildProperty {
+			return "builder.properties=" + o.Value, nil
+		}
+
+		return "camel.properties=" + o.Value, nil
+	case OptionConfig:
+		config, err := resource.ParseConfig(o.Value)
+		if err != nil {
+			return "", err
+		}
+
+		return "mount.configs=" + config.String(), nil
+	case OptionResource:
+		config, err := resource.ParseResource(o.Value)
+		if err != nil {
+			return "", err
+		}
+
+		return "mount.resources=" + config.String(), nil
+	case OptionVolume:
+		pvc, path, ok := strings.Cut(o.Value, ":")
+		if !ok || strings.TrimSpace(pvc) == "" || strings.TrimSpace(path) == "" {
+			return "", fmt.Errorf("volume %q is invalid, it should be in the format: pvcname:/container/path", o.Value)
+		}
+
+		return "mount.volumes=" + o.Value, nil
+	case OptionEnv:
+		if !strings.Contains(o.Value, "=") {
+			return "", fmt.Errorf("invalid env %q, expected NAME=value", o.Value)
+		}
+
+		return "environment.vars=" + o.Value, nil
+	case OptionDependency, OptionProfile:
+		return "", errors.New("option " + o.Name + " does not configure a trait")
+	}
+
+	return "", fmt.Errorf("option %q is not supported by the operator", o.Name)
+}
@@ -0,0 +1,64 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToTraitOption(t *testing.T) {
+	tests := []struct {
+		option   Option
+		expected string
+	}{
+		{Option{Name: "trait", Value: "service.enabled=false"}, "service.enabled=false"},
+		{Option{Name: "t", Value: "service.enabled=false"}, "service.enabled=false"},
+		{Option{Name: "property", Value: "my.key=my-value"}, "camel.properties=my.key=my-value"},
+		{Option{Name: "build-property", Value: "quarkus.foo=bar"}, "builder.properties=quarkus.foo=bar"},
+		{Option{Name: "config", Value: "configmap:my-cm/key"}, "mount.configs=configmap:my-cm/key"},
+		{Option{Name: "resource", Value: "secret:my-secret@/etc/data"}, "mount.resources=secret:my-secret@/etc/data"},
+		{Option{Name: "volume", Value: "my-pvc:/container/path"}, "mount.volumes=my-pvc:/container/path"},
+		{Option{Name: "e", Value: "MY_VAR=value"}, "environment.vars=MY_VAR=value"},
+	}
+	for _, test := range tests {
+		option, err := ToTraitOption(test.option)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, option)
+	}
+}
+
+func TestToTraitOptionInvalid(t *testing.T) {
+	tests := []struct {
+		option   Option
+		expected string
+	}{
+		{Option{Name: "trait"}, `option "trait" requires a value`},
+		{Option{Name: "property", Value: "file:application.properties"}, `option "property" only supports key=value properties when applied by the operator`},
+		{Option{Name: "property", Value: "my.key"}, `invalid property "my.key", expected key=value`},
+		{Option{Name: "config", Value: "my-cm"}, "could not match config or secret configuration as my-cm"},
+		{Option{Name: "volume", Value: "my-pvc"}, `volume "my-pvc" is invalid, it should be in the format: pvcname:/container/path`},
+		{Option{Name: "label", Value: "app=my-app"}, `option "label" is not supported by the operator`},
+	}
+	for _, test := range tests {
+		_, err := ToTraitOption(test.option)
+		require.EqualError(t, err, test.expected)
+	}
+}
@@ -17,8 +17,22 @@ limitations under the License.
 
 package modeline
 
+import "fmt"
+
 // Option represents a key/(optional)value modeline option.
 type Option struct {
 	Name  string
 	Value string
 }
+
+// LocatedOption represents a modeline option along with the source and the line it is declared at.
+type LocatedOption struct {
+	Option
+	Source string
+	Line   int
+}
+
+// Location returns the "source:line" location of the option.
+func (o LocatedOption) Location() string {
+	return fmt.Sprintf("%s:%d", o.Source, o.Line)
+}
@@ -0,0 +1,401 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	yaml2 "gopkg.in/yaml.v2"
+)
+
+// Methods are the HTTP methods an OpenAPI path item can declare an operation for.
+var Methods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+var (
+	pathParameter    = regexp.MustCompile(`\{[^/{}]+}`)
+	nonAlphaNumerics = regexp.MustCompile(`[^A-Za-z0-9]+`)
+)
+
+// Document is the subset of an OpenAPI 3 document required to generate the REST DSL.
+type Document struct {
+	OpenAPI    string              `yaml:"openapi"`
+	Info       Info                `yaml:"info"`
+	Servers    []Server            `yaml:"servers"`
+	Paths      map[string]PathItem `yaml:"paths"`
+	Components Components          `yaml:"components"`
+}
+
+// Info is the metadata of the API.
+type Info struct {
+	Title   string `yaml:"title"`
+	Version string `yaml:"version"`
+}
+
+// Server is a server hosting the API.
+type Server struct {
+	URL string `yaml:"url"`
+}
+
+// PathItem holds the operations available on a path.
+type PathItem struct {
+	Parameters []Parameter `yaml:"parameters"`
+	Get        *Operation  `yaml:"get"`
+	Put        *Operation  `yaml:"put"`
+	Post       *Operation  `yaml:"post"`
+	Delete     *Operation  `yaml:"delete"`
+	Options    *Operation  `yaml:"options"`
+	Head       *Operation  `yaml:"head"`
+	Patch      *Operation  `yaml:"patch"`
+}
+
+// Operation is an API operation.
+type Operation struct {
+	OperationID string              `yaml:"operationId"`
+	Summary     string              `yaml:"summary"`
+	Description string              `yaml:"description"`
+	Parameters  []Parameter         `yaml:"parameters"`
+	RequestBody *RequestBody        `yaml:"requestBody"`
+	Responses   map[string]Response `yaml:"responses"`
+}
+
+// Parameter is an operation parameter, or a reference to a parameter declared in the components.
+type Parameter struct {
+	Ref         string  `yaml:"$ref"`
+	Name        string  `yaml:"name"`
+	In          string  `yaml:"in"`
+	Description string  `yaml:"description"`
+	Required    bool    `yaml:"required"`
+	Schema      *Schema `yaml:"schema"`
+}
+
+// Schema is the subset of a JSON schema describing a parameter.
+type Schema struct {
+	Type    string `yaml:"type"`
+	Format  string `yaml:"format"`
+	Default any    `yaml:"default"`
+	Enum    []any  `yaml:"enum"`
+}
+
+// RequestBody is the body of an operation request.
+type RequestBody struct {
+	Ref      string         `yaml:"$ref"`
+	Required bool           `yaml:"required"`
+	Content  map[string]any `yaml:"content"`
+}
+
+// Response is an operation response.
+type Response struct {
+	Ref     string         `yaml:"$ref"`
+	Content map[string]any `yaml:"content"`
+}
+
+// Components holds the reusable objects referenced by the operations.
+type Components struct {
+	Parameters    map[string]Parameter   `yaml:"parameters"`
+	RequestBodies map[string]RequestBody `yaml:"requestBodies"`
+	Responses     map[string]Response    `yaml:"responses"`
+	Schemas       map[string]any         `yaml:"schemas"`
+}
+
+// Endpoint is an operation bound to its method and path.
+type Endpoint struct {
+	// The HTTP method, ie, `get`
+	Method string
+	// The path, relative to the API base path, ie, `/pets/{id}`
+	Path string
+	// The operation ID, or an ID derived from the method and the path if the operation does not declare any
+	ID string
+	// The media types of the request body
+	Consumes []string
+	// The schema of the JSON request body, if any
+	BodySchema any
+	// The media types of the responses
+	Produces []string
+	// The path item and the operation parameters, with the references resolved
+	Parameters []Parameter
+	Operation  *Operation
+}
+
+// Parse parses an OpenAPI 3 document, in YAML or JSON format.
+func Parse(content []byte) (*Document, error) {
+	doc := Document{}
+	if err := yaml2.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("cannot parse the OpenAPI document: %w", err)
+	}
+	if doc.OpenAPI == "" {
+		return nil, errors.New("cannot parse the OpenAPI document: missing openapi version")
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI version %s: only OpenAPI 3 documents are supported", doc.OpenAPI)
+	}
+
+	return &doc, nil
+}
+
+// BasePath returns the path of the first server URL, ie, `/api/v3` for `https://petstore.io/api/v3`.
+func (d *Document) BasePath() string {
+	if len(d.Servers) == 0 {
+		return ""
+	}
+	u, err := url.Parse(d.Servers[0].URL)
+	if err != nil || u.Path == "/" {
+		return ""
+	}
+
+	return strings.TrimSuffix(u.Path, "/")
+}
+
+// Endpoints returns the operations declared in the document, sorted by path and method.
+func (d *Document) Endpoints() ([]Endpoint, error) {
+	paths := make([]string, 0, len(d.Paths))
+	for p := range d.Paths {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+
+	ids := make(map[string]string)
+	endpoints := make([]Endpoint, 0, len(paths))
+	for _, p := range paths {
+		item := d.Paths[p]
+		for _, method := range Methods {
+			op := item.operation(method)
+			if op == nil {
+				continue
+			}
+			endpoint, err := d.endpoint(method, p, item, op)
+			if err != nil {
+				return nil, err
+			}
+			if other, ok := ids[endpoint.ID]; ok {
+				return nil, fmt.Errorf("duplicate operation id %s for %s and %s %s", endpoint.ID, other, method, p)
+			}
+			ids[endpoint.ID] = method + " " + p
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	return endpoints, nil
+}
+
+func (d *Document) endpoint(method, p string, item PathItem, op *Operation) (Endpoint, error) {
+	endpoint := Endpoint{
+		Method:    method,
+		Path:      p,
+		ID:        op.OperationID,
+		Operation: op,
+	}
+	if endpoint.ID == "" {
+		endpoint.ID = operationID(method, p)
+	}
+
+	parameters, err := d.parameters(item.Parameters, op.Parameters)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	endpoint.Parameters = parameters
+
+	if op.RequestBody != nil {
+		body := *op.RequestBody
+		if body.Ref != "" {
+			ref, ok := d.Components.RequestBodies[componentName(body.Ref)]
+			if !ok {
+				return Endpoint{}, fmt.Errorf("cannot resolve the request body reference %s", body.Ref)
+			}
+			body = ref
+		}
+		endpoint.Consumes = sortedKeys(body.Content)
+		for _, mediaType := range endpoint.Consumes {
+			if !isJSON(mediaType) {
+				continue
+			}
+			if content, ok := body.Content[mediaType].(map[any]any); ok && content["schema"] != nil {
+				endpoint.BodySchema = content["schema"]
+
+				break
+			}
+		}
+	}
+
+	for _, code := range sortedKeys(op.Responses) {
+		response := op.Responses[code]
+		if response.Ref != "" {
+			ref, ok := d.Components.Responses[componentName(response.Ref)]
+			if !ok {
+				return Endpoint{}, fmt.Errorf("cannot resolve the response reference %s", response.Ref)
+			}
+			response = ref
+		}
+		for _, mediaType := range sortedKeys(response.Content) {
+			if !slices.Contains(endpoint.Produces, mediaType) {
+				endpoint.Produces = append(endpoint.Produces, mediaType)
+			}
+		}
+	}
+
+	return endpoint, nil
+}
+
+// parameters resolves the references and merges the path item parameters with the operation ones,
+// the latter overriding the former when they share the same name and location.
+func (d *Document) parameters(common, specific []Parameter) ([]Parameter, error) {
+	var parameters []Parameter
+	for _, p := range slices.Concat(common, specific) {
+		if p.Ref != "" {
+			ref, ok := d.Components.Parameters[componentName(p.Ref)]
+			if !ok {
+				return nil, fmt.Errorf("cannot resolve the parameter reference %s", p.Ref)
+			}
+			p = ref
+		}
+		idx := slices.IndexFunc(parameters, func(o Parameter) bool {
+			return o.Name == p.Name && o.In == p.In
+		})
+		if idx >= 0 {
+			parameters[idx] = p
+		} else {
+			parameters = append(parameters, p)
+		}
+	}
+
+	return parameters, nil
+}
+
+// JSONSchema returns a standalone JSON schema document for the given schema of the document, embedding the component
+// schemas so that the local references, ie, `#/components/schemas/Pet`, can be resolved.
+func (d *Document) JSONSchema(schema any) ([]byte, error) {
+	root := map[string]any{
+		"$schema": "http://json-schema.org/draft-04/schema#",
+		"allOf":   []any{jsonValue(schema)},
+	}
+	if strings.HasPrefix(d.OpenAPI, "3.1") {
+		root["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	}
+	if len(d.Components.Schemas) > 0 {
+		root["components"] = map[string]any{
+			"schemas": jsonValue(d.Components.Schemas),
+		}
+	}
+
+	return json.Marshal(root)
+}
+
+func (i PathItem) operation(method string) *Operation {
+	switch method {
+	case "get":
+		return i.Get
+	case "put":
+		return i.Put
+	case "post":
+		return i.Post
+	case "delete":
+		return i.Delete
+	case "options":
+		return i.Options
+	case "head":
+		return i.Head
+	case "patch":
+		return i.Patch
+	}
+
+	return nil
+}
+
+// IsTemplated returns true if the path contains parameters, ie, `/pets/{id}`.
+func IsTemplated(p string) bool {
+	return pathParameter.MatchString(p)
+}
+
+// StaticPrefix returns the part of the path preceding the first parameter, ie, `/pets/` for `/pets/{id}/tags`.
+func StaticPrefix(p string) string {
+	if loc := pathParameter.FindStringIndex(p); loc != nil {
+		return p[:loc[0]]
+	}
+
+	return p
+}
+
+// operationID derives an operation ID from the method and the path, ie, `getPetsId` for `get /pets/{id}`.
+func operationID(method, p string) string {
+	var sb strings.Builder
+	sb.WriteString(method)
+	for part := range strings.SplitSeq(nonAlphaNumerics.ReplaceAllString(p, " "), " ") {
+		if part != "" {
+			sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+
+	return sb.String()
+}
+
+// componentName returns the name of the component a local reference points to, ie, `limit` for `#/components/parameters/limit`.
+func componentName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// isJSON returns true if the media type denotes a JSON content, ie, `application/json` or `application/merge-patch+json`.
+func isJSON(mediaType string) bool {
+	mediaType, _, _ = strings.Cut(mediaType, ";")
+	mediaType = strings.TrimSpace(mediaType)
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// jsonValue converts the generic maps decoded from YAML into maps that can be marshalled into JSON.
+func jsonValue(value any) any {
+	switch v := value.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for k, e := range v {
+			m[fmt.Sprint(k)] = jsonValue(e)
+		}
+
+		return m
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, e := range v {
+			m[k] = jsonValue(e)
+		}
+
+		return m
+	case []any:
+		s := make([]any, len(v))
+		for i, e := range v {
+			s[i] = jsonValue(e)
+		}
+
+		return s
+	}
+
+	return value
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
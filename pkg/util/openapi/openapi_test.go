@@ -0,0 +1,197 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const petstore = `
+openapi: 3.0.3
+info:
+  title: Petstore
+  version: 1.0.0
+servers:
+  - url: https://petstore.example.com/api/v3/
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+        - $ref: '#/components/parameters/limit'
+      responses:
+        "200":
+          content:
+            application/json: {}
+        default:
+          $ref: '#/components/responses/Error'
+    post:
+      operationId: createPet
+      requestBody:
+        required: true
+        content:
+          application/json: {}
+          application/xml: {}
+      responses:
+        "201":
+          description: created
+  /pets/{petId}:
+    parameters:
+      - name: petId
+        in: path
+        required: true
+        schema:
+          type: string
+    delete:
+      responses:
+        "204":
+          description: deleted
+components:
+  parameters:
+    limit:
+      name: limit
+      in: query
+      schema:
+        type: integer
+        format: int32
+  responses:
+    Error:
+      content:
+        application/problem+json: {}
+`
+
+func TestParse(t *testing.T) {
+	doc, err := Parse([]byte(petstore))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Petstore", doc.Info.Title)
+	assert.Equal(t, "/api/v3", doc.BasePath())
+
+	endpoints, err := doc.Endpoints()
+	require.NoError(t, err)
+	require.Len(t, endpoints, 3)
+
+	assert.Equal(t, "get", endpoints[0].Method)
+	assert.Equal(t, "listPets", endpoints[0].ID)
+	assert.Equal(t, []string{"application/json", "application/problem+json"}, endpoints[0].Produces)
+	require.Len(t, endpoints[0].Parameters, 1)
+	assert.Equal(t, "limit", endpoints[0].Parameters[0].Name)
+	assert.Equal(t, "integer", endpoints[0].Parameters[0].Schema.Type)
+
+	assert.Equal(t, "post", endpoints[1].Method)
+	assert.Equal(t, []string{"application/json", "application/xml"}, endpoints[1].Consumes)
+
+	assert.Equal(t, "delete", endpoints[2].Method)
+	assert.Equal(t, "/pets/{petId}", endpoints[2].Path)
+	assert.Equal(t, "deletePetsPetId", endpoints[2].ID)
+	require.Len(t, endpoints[2].Parameters, 1)
+	assert.Equal(t, "path", endpoints[2].Parameters[0].In)
+}
+
+func TestParseJSON(t *testing.T) {
+	doc, err := Parse([]byte(`{"openapi": "3.1.0", "paths": {"/hello": {"get": {"operationId": "hello"}}}}`))
+	require.NoError(t, err)
+	assert.Empty(t, doc.BasePath())
+
+	endpoints, err := doc.Endpoints()
+	require.NoError(t, err)
+	require.Len(t, endpoints, 1)
+	assert.Equal(t, "hello", endpoints[0].ID)
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := Parse([]byte(`swagger: "2.0"`))
+	require.EqualError(t, err, "cannot parse the OpenAPI document: missing openapi version")
+
+	_, err = Parse([]byte(`openapi: 2.0.0`))
+	require.EqualError(t, err, "unsupported OpenAPI version 2.0.0: only OpenAPI 3 documents are supported")
+
+	doc, err := Parse([]byte(`
+openapi: 3.0.0
+paths:
+  /a:
+    get:
+      operationId: same
+  /b:
+    get:
+      operationId: same
+`))
+	require.NoError(t, err)
+	_, err = doc.Endpoints()
+	require.EqualError(t, err, "duplicate operation id same for get /a and get /b")
+
+	doc, err = Parse([]byte(`
+openapi: 3.0.0
+paths:
+  /a:
+    get:
+      parameters:
+        - $ref: '#/components/parameters/missing'
+`))
+	require.NoError(t, err)
+	_, err = doc.Endpoints()
+	require.EqualError(t, err, "cannot resolve the parameter reference #/components/parameters/missing")
+}
+
+func TestStaticPrefix(t *testing.T) {
+	assert.False(t, IsTemplated("/pets"))
+	assert.True(t, IsTemplated("/pets/{id}/tags"))
+	assert.Equal(t, "/pets", StaticPrefix("/pets"))
+	assert.Equal(t, "/pets/", StaticPrefix("/pets/{id}/tags"))
+}
+
+func TestJSONSchema(t *testing.T) {
+	doc, err := Parse([]byte(`
+openapi: 3.0.3
+paths:
+  /pets:
+    post:
+      operationId: createPet
+      requestBody:
+        content:
+          application/xml: {}
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+`))
+	require.NoError(t, err)
+
+	endpoints, err := doc.Endpoints()
+	require.NoError(t, err)
+	require.Len(t, endpoints, 1)
+	require.NotNil(t, endpoints[0].BodySchema)
+
+	schema, err := doc.JSONSchema(endpoints[0].BodySchema)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "http://json-schema.org/draft-04/schema#",
+		"allOf": [{"$ref": "#/components/schemas/Pet"}],
+		"components": {"schemas": {"Pet": {"type": "object", "required": ["name"], "properties": {"name": {"type": "string"}}}}}
+	}`, string(schema))
+}
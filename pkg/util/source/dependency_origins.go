@@ -0,0 +1,80 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"regexp"
+	"strings"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// AddDependencyFrom adds a dependency, recording the element of the source requiring it.
+func (m *Metadata) AddDependencyFrom(dependency string, originType v1.DependencyOriginType, detail string) {
+	m.AddDependency(dependency)
+	for _, o := range m.DependencyOrigins {
+		if o.Dependency == dependency && o.Type == originType && o.Detail == detail {
+			return
+		}
+	}
+	m.DependencyOrigins = append(m.DependencyOrigins, v1.DependencyOrigin{
+		Dependency: dependency,
+		Type:       originType,
+		Detail:     detail,
+	})
+}
+
+// LocateDependencyOrigins sets the source name and line of the dependency origins recorded while inspecting the given source.
+func (m *Metadata) LocateDependencyOrigins(source v1.SourceSpec) {
+	for i := range m.DependencyOrigins {
+		origin := &m.DependencyOrigins[i]
+		if origin.Source != "" {
+			continue
+		}
+		origin.Source = source.Name
+		origin.Line = locateDependencyOrigin(source.Content, *origin)
+	}
+}
+
+// locateDependencyOrigin returns the line (starting from 1) of the first occurrence of the origin in the content,
+// or 0 if it can't be found.
+func locateDependencyOrigin(content string, origin v1.DependencyOrigin) int {
+	offset := -1
+	switch origin.Type {
+	case v1.DependencyOriginRule:
+		if re, err := regexp.Compile(origin.Detail); err == nil {
+			if loc := re.FindStringIndex(content); loc != nil {
+				offset = loc[0]
+			}
+		}
+	case v1.DependencyOriginURI:
+		offset = strings.Index(content, origin.Detail)
+		if offset < 0 {
+			// the URI parameters may be declared apart (ie, YAML parameters) or escaped (ie, XML)
+			base, _, _ := strings.Cut(origin.Detail, "?")
+			offset = strings.Index(content, base)
+		}
+	default:
+		offset = strings.Index(content, origin.Detail)
+	}
+	if offset < 0 {
+		return 0
+	}
+
+	return strings.Count(content[:offset], "\n") + 1
+}
@@ -60,6 +60,7 @@ var (
 	xqueryRegexp            = regexp.MustCompile(`.*\.xquery\s*\(.*\).*`)
 	xpathRegexp             = regexp.MustCompile(`.*\.?xpath\s*\(.*\).*`)
 	xtokenizeRegexp         = regexp.MustCompile(`.*\.xtokenize\s*\(.*\).*`)
+	doubleQuotedRouteTmpl   = regexp.MustCompile(`routeTemplate\s*\(\s*"([^"]+)"`)
 	doubleQuotedKameletEip  = regexp.MustCompile(`kamelet\s*\(\s*"(?://)?([a-z0-9-.]+(/[a-z0-9-.]+)?)(?:$|[^a-z0-9-.].*)"`)
 
 	sourceCapabilities = map[*regexp.Regexp][]string{
@@ -243,6 +244,7 @@ func (i *baseInspector) extract(source v1.SourceSpec, meta *Metadata,
 		return err
 	}
 	i.discoverKamelets(meta)
+	i.discoverPropertyPlaceholders(source.Content, meta)
 
 	if hasRest {
 		meta.AddRequiredCapability(v1.CapabilityRest)
@@ -251,7 +253,7 @@ func (i *baseInspector) extract(source v1.SourceSpec, meta *Metadata,
 	meta.ExposesHTTPServices = hasRest || i.containsHTTPURIs(meta.FromURIs)
 	meta.PassiveEndpoints = i.hasOnlyPassiveEndpoints(meta.FromURIs)
 
-	return nil
+	return i.discoverRiskCategories(meta)
 }
 
 // discoverCapabilities returns a list of dependencies required by the given source code.
@@ -290,6 +292,34 @@ func (i *baseInspector) discoverCapabilities(source v1.SourceSpec, meta *Metadat
 	return err
 }
 
+// discoverRiskCategories tags the usage of security sensitive components with the risk categories of the security rules.
+// The risk categories are not validated against the catalog as they don't require any runtime support.
+func (i *baseInspector) discoverRiskCategories(meta *Metadata) error {
+	rules, err := SecurityRules()
+	if err != nil {
+		return err
+	}
+	uris := util.StringSliceJoin(meta.FromURIs, meta.ToURIs)
+	for _, rule := range rules {
+		if rule.Language != "" {
+			if dependency, ok := i.catalog.GetLanguageDependency(rule.Language); ok && meta.Dependencies.Has(dependency) {
+				meta.AddRequiredCapability(RiskCapability(rule.Category))
+			}
+
+			continue
+		}
+		for _, uri := range uris {
+			if rule.MatchURI(uri) {
+				meta.AddRequiredCapability(RiskCapability(rule.Category))
+
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // discoverDependencies returns a list of dependencies required by the given source code.
 func (i *baseInspector) discoverDependencies(source v1.SourceSpec, meta *Metadata) error {
 	for _, uri := range meta.FromURIs {
@@ -312,14 +342,14 @@ func (i *baseInspector) discoverDependencies(source v1.SourceSpec, meta *Metadat
 		}
 
 		for _, dep := range supplier(i.catalog) {
-			meta.AddDependency(dep)
+			meta.AddDependencyFrom(dep, v1.DependencyOriginRule, pattern.String())
 		}
 	}
 
 	for _, match := range languageRegexp.FindAllStringSubmatch(source.Content, -1) {
 		if len(match) > 1 {
 			if dependency, ok := i.catalog.GetLanguageDependency(match[1]); ok {
-				meta.AddDependency(dependency)
+				meta.AddDependencyFrom(dependency, v1.DependencyOriginLanguage, match[1])
 			}
 		}
 	}
@@ -327,7 +357,7 @@ func (i *baseInspector) discoverDependencies(source v1.SourceSpec, meta *Metadat
 	for _, match := range camelTypeRegexp.FindAllStringSubmatch(source.Content, -1) {
 		if len(match) > 1 {
 			if dependency, ok := i.catalog.GetJavaTypeDependency(match[1]); ok {
-				meta.AddDependency(dependency)
+				meta.AddDependencyFrom(dependency, v1.DependencyOriginRule, match[1])
 			}
 		}
 	}
@@ -336,7 +366,7 @@ func (i *baseInspector) discoverDependencies(source v1.SourceSpec, meta *Metadat
 		if len(match) > 1 {
 			_, scheme := i.catalog.DecodeComponent(match[1])
 			if dfDep := i.catalog.GetArtifactByScheme(scheme.ID); dfDep != nil {
-				meta.AddDependency(dfDep.GetDependencyID())
+				meta.AddDependencyFrom(dfDep.GetDependencyID(), v1.DependencyOriginURI, match[1])
 			}
 			if scheme.ID == kamelet {
 				AddKamelet(meta, match[1])
@@ -372,7 +402,7 @@ func (i *baseInspector) addDependencies(uri string, meta *Metadata, consumer boo
 		return nil
 	}
 
-	meta.AddDependency(candidateComp.GetDependencyID())
+	meta.AddDependencyFrom(candidateComp.GetDependencyID(), v1.DependencyOriginURI, uri)
 	var deps []string
 	if consumer {
 		deps = candidateComp.GetConsumerDependencyIDs(scheme.ID)
@@ -380,7 +410,7 @@ func (i *baseInspector) addDependencies(uri string, meta *Metadata, consumer boo
 		deps = candidateComp.GetProducerDependencyIDs(scheme.ID)
 	}
 	for _, dep := range deps {
-		meta.AddDependency(dep)
+		meta.AddDependencyFrom(dep, v1.DependencyOriginURI, uri)
 	}
 
 	// some components require additional dependency resolution based on URI
@@ -403,7 +433,7 @@ func (i *baseInspector) addDependenciesFromURI(uri string, scheme *v1.CamelSchem
 		if df == nil {
 			return fmt.Errorf("dataformat %q not found: %s", name, uri)
 		}
-		meta.AddDependency(df.GetDependencyID())
+		meta.AddDependencyFrom(df.GetDependencyID(), v1.DependencyOriginURI, uri)
 	}
 
 	return nil
@@ -0,0 +1,740 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	javaURIRegexp        = regexp.MustCompile(`^[a-zA-Z0-9-]+:.+`)
+	javaKameletEipRegexp = regexp.MustCompile(`^(?://)?([a-z0-9-.]+(/[a-z0-9-.]+)?)(?:$|[^a-z0-9-.])`)
+
+	// javaRouteMethods are the DSL methods taking endpoint URIs (or route template ids) as arguments. The value
+	// tells if the method must be invoked on a builder (ie, `.to(...)`) rather than being a route starting point.
+	javaRouteMethods = map[string]bool{
+		"from":          false,
+		"fromF":         false,
+		"routeTemplate": false,
+		"to":            true,
+		"toD":           true,
+		"toF":           true,
+		"wireTap":       true,
+		"kamelet":       true,
+	}
+)
+
+type javaTokenKind int
+
+const (
+	javaTokenIdent javaTokenKind = iota
+	javaTokenString
+	javaTokenChar
+	javaTokenNumber
+	javaTokenPunct
+)
+
+// javaToken is a lexical token of a Java source, with its (decoded) value and its position in the source.
+type javaToken struct {
+	kind  javaTokenKind
+	text  string
+	start int
+	end   int
+}
+
+func (t javaToken) is(text string) bool {
+	return t.kind == javaTokenPunct && t.text == text
+}
+
+// tokenizeJava splits the given Java source into tokens, skipping blanks and comments.
+// The value of string and character literals is decoded, text blocks included.
+func tokenizeJava(content string) ([]javaToken, error) {
+	tokens := make([]javaToken, 0, len(content)/4)
+	pos := 0
+	for pos < len(content) {
+		r, size := utf8.DecodeRuneInString(content[pos:])
+		switch {
+		case unicode.IsSpace(r):
+			pos += size
+		case strings.HasPrefix(content[pos:], "//"):
+			end := strings.IndexByte(content[pos:], '\n')
+			if end < 0 {
+				return tokens, nil
+			}
+			pos += end + 1
+		case strings.HasPrefix(content[pos:], "/*"):
+			end := strings.Index(content[pos+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment at offset %d", pos)
+			}
+			pos += end + 4
+		case strings.HasPrefix(content[pos:], `"""`):
+			value, end, err := scanJavaTextBlock(content, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, javaToken{kind: javaTokenString, text: value, start: pos, end: end})
+			pos = end
+		case r == '"' || r == '\'':
+			value, end, err := scanJavaLiteral(content, pos)
+			if err != nil {
+				return nil, err
+			}
+			kind := javaTokenString
+			if r == '\'' {
+				kind = javaTokenChar
+			}
+			tokens = append(tokens, javaToken{kind: kind, text: value, start: pos, end: end})
+			pos = end
+		case r == '_' || r == '$' || unicode.IsLetter(r):
+			end := pos + size
+			for end < len(content) {
+				n, s := utf8.DecodeRuneInString(content[end:])
+				if n != '_' && n != '$' && !unicode.IsLetter(n) && !unicode.IsDigit(n) {
+					break
+				}
+				end += s
+			}
+			tokens = append(tokens, javaToken{kind: javaTokenIdent, text: content[pos:end], start: pos, end: end})
+			pos = end
+		case unicode.IsDigit(r):
+			end := pos + size
+			for end < len(content) {
+				c := content[end]
+				if c != '_' && c != '.' && !('0' <= c && c <= '9') && !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') {
+					break
+				}
+				end++
+			}
+			tokens = append(tokens, javaToken{kind: javaTokenNumber, text: content[pos:end], start: pos, end: end})
+			pos = end
+		default:
+			tokens = append(tokens, javaToken{kind: javaTokenPunct, text: string(r), start: pos, end: pos + size})
+			pos += size
+		}
+	}
+
+	return tokens, nil
+}
+
+// scanJavaLiteral scans the string or character literal starting at the given position, returning its decoded
+// value and the position right after its closing quote.
+func scanJavaLiteral(content string, start int) (string, int, error) {
+	quote := content[start]
+	for pos := start + 1; pos < len(content); pos++ {
+		switch content[pos] {
+		case '\\':
+			pos++
+		case '\n':
+			return "", 0, fmt.Errorf("unterminated literal at offset %d", start)
+		case quote:
+			value, err := unescapeJava(content[start+1 : pos])
+			if err != nil {
+				return "", 0, fmt.Errorf("invalid literal at offset %d: %w", start, err)
+			}
+
+			return value, pos + 1, nil
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated literal at offset %d", start)
+}
+
+// scanJavaTextBlock scans the text block starting at the given position, returning its value (after incidental
+// white space has been stripped and escape sequences have been interpreted) and the position right after it.
+func scanJavaTextBlock(content string, start int) (string, int, error) {
+	pos := start + 3
+	nl := strings.IndexByte(content[pos:], '\n')
+	if nl < 0 || strings.TrimSpace(content[pos:pos+nl]) != "" {
+		return "", 0, fmt.Errorf("invalid text block opening delimiter at offset %d", start)
+	}
+	pos += nl + 1
+	begin := pos
+	for ; pos < len(content); pos++ {
+		if content[pos] == '\\' {
+			pos++
+
+			continue
+		}
+		if strings.HasPrefix(content[pos:], `"""`) {
+			break
+		}
+	}
+	if pos >= len(content) {
+		return "", 0, fmt.Errorf("unterminated text block at offset %d", start)
+	}
+
+	lines := strings.Split(strings.ReplaceAll(content[begin:pos], "\r\n", "\n"), "\n")
+	// the last line is significant to determine the indentation only when the closing delimiter is on its own line
+	last := len(lines) - 1
+	indent := -1
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" && i != last {
+			continue
+		}
+		n := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			lines[i] = ""
+
+			continue
+		}
+		lines[i] = strings.TrimRight(line[indent:], " \t")
+	}
+
+	value, err := unescapeJava(strings.Join(lines, "\n"))
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid text block at offset %d: %w", start, err)
+	}
+
+	return value, pos + 3, nil
+}
+
+func unescapeJava(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+
+			continue
+		}
+		i++
+		if i >= len(s) {
+			return "", errors.New("incomplete escape sequence")
+		}
+		switch c := s[i]; c {
+		case 'b':
+			b.WriteByte('\b')
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'f':
+			b.WriteByte('\f')
+		case 'r':
+			b.WriteByte('\r')
+		case 's':
+			b.WriteByte(' ')
+		case '"', '\'', '\\':
+			b.WriteByte(c)
+		case '\n':
+			// line continuation in text blocks
+		case 'u':
+			for i < len(s) && s[i] == 'u' {
+				i++
+			}
+			if i+4 > len(s) {
+				return "", errors.New("invalid unicode escape sequence")
+			}
+			r, err := strconv.ParseUint(s[i:i+4], 16, 32)
+			if err != nil {
+				return "", errors.New("invalid unicode escape sequence")
+			}
+			b.WriteRune(rune(r))
+			i += 3
+		default:
+			if c < '0' || c > '7' {
+				return "", fmt.Errorf("invalid escape sequence \\%c", c)
+			}
+			end := i + 1
+			for end < len(s) && end < i+3 && '0' <= s[end] && s[end] <= '7' {
+				end++
+			}
+			r, _ := strconv.ParseUint(s[i:end], 8, 32)
+			b.WriteRune(rune(r))
+			i = end - 1
+		}
+	}
+
+	return b.String(), nil
+}
+
+// javaSource is a parsed Java DSL source. It does not provide a complete Java AST, but it understands enough of
+// the language to resolve the values given to the route DSL methods: string literals and text blocks, constants
+// defined in the same file, concatenations, String.format and the Endpoint DSL builders.
+type javaSource struct {
+	content string
+	tokens  []javaToken
+	// constants maps every String variable defined in the source to the token ranges of the values assigned to it
+	constants map[string][][2]int
+	// endpointDSL is true when the source uses the Endpoint DSL, ie, it extends EndpointRouteBuilder
+	endpointDSL bool
+	// isScheme tells if the given name is the scheme of a known component
+	isScheme func(string) bool
+}
+
+// javaCall is the invocation of one of the route DSL methods.
+type javaCall struct {
+	method string
+	// value is the resolved value of the argument (ie, the endpoint URI)
+	value    string
+	resolved bool
+	// start and end are the offsets of the argument expression in the source
+	start int
+	end   int
+	// callStart and callEnd are the offsets of the whole method invocation in the source
+	callStart int
+	callEnd   int
+}
+
+func parseJavaSource(content string, isScheme func(string) bool) (*javaSource, error) {
+	tokens, err := tokenizeJava(content)
+	if err != nil {
+		return nil, err
+	}
+	s := javaSource{
+		content:   content,
+		tokens:    tokens,
+		constants: make(map[string][][2]int),
+		isScheme:  isScheme,
+	}
+	s.scanDeclarations()
+
+	return &s, nil
+}
+
+// scanDeclarations looks up the imports, the classes extending a RouteBuilder and the String variables definitions.
+func (s *javaSource) scanDeclarations() {
+	t := s.tokens
+	for i := range t {
+		switch {
+		case t[i].kind == javaTokenIdent && t[i].text == "import":
+			var path strings.Builder
+			for j := i + 1; j < len(t) && !t[j].is(";"); j++ {
+				if t[j].kind == javaTokenIdent && t[j].text == "static" && j == i+1 {
+					continue
+				}
+				path.WriteString(t[j].text)
+			}
+			if strings.HasPrefix(path.String(), "org.apache.camel.builder.endpoint.") {
+				s.endpointDSL = true
+			}
+		case t[i].kind == javaTokenIdent && t[i].text == "extends" && i+1 < len(t):
+			if strings.HasSuffix(t[i+1].text, "EndpointRouteBuilder") {
+				s.endpointDSL = true
+			}
+		case t[i].kind == javaTokenIdent && i > 0 && i+2 < len(t) && t[i+1].is("=") && !t[i+2].is("="):
+			prev := t[i-1]
+			declaration := prev.kind == javaTokenIdent && (prev.text == "String" || prev.text == "var")
+			assignment := prev.is(";") || prev.is("{") || prev.is("}") ||
+				(prev.is(".") && i > 1 && t[i-2].text == "this")
+			if declaration || assignment {
+				s.constants[t[i].text] = append(s.constants[t[i].text], [2]int{i + 2, s.expressionEnd(i + 2)})
+			}
+		}
+	}
+}
+
+// expressionEnd returns the index of the token ending the expression starting at the given index.
+func (s *javaSource) expressionEnd(start int) int {
+	depth := 0
+	for i := start; i < len(s.tokens); i++ {
+		t := s.tokens[i]
+		switch {
+		case t.is("(") || t.is("[") || t.is("{"):
+			depth++
+		case t.is(")") || t.is("]") || t.is("}"):
+			if depth == 0 {
+				return i
+			}
+			depth--
+		case (t.is(";") || t.is(",")) && depth == 0:
+			return i
+		}
+	}
+
+	return len(s.tokens)
+}
+
+// closing returns the index of the parenthesis closing the one at the given index.
+func (s *javaSource) closing(open int) int {
+	depth := 0
+	for i := open; i < len(s.tokens); i++ {
+		switch t := s.tokens[i]; {
+		case t.is("(") || t.is("[") || t.is("{"):
+			depth++
+		case t.is(")") || t.is("]") || t.is("}"):
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// arguments splits the tokens between the given parenthesis into the ranges of the arguments.
+func (s *javaSource) arguments(open int, closing int) [][2]int {
+	args := make([][2]int, 0)
+	for start := open + 1; start < closing; {
+		end := s.expressionEnd(start)
+		if end > closing {
+			end = closing
+		}
+		args = append(args, [2]int{start, end})
+		start = end + 1
+	}
+
+	return args
+}
+
+// calls returns the invocations of the given route DSL methods, in the order they appear in the source.
+func (s *javaSource) calls(methods ...string) []javaCall {
+	t := s.tokens
+	calls := make([]javaCall, 0)
+	for i := 0; i+1 < len(t); i++ {
+		if t[i].kind != javaTokenIdent || !t[i+1].is("(") {
+			continue
+		}
+		chained, ok := javaRouteMethods[t[i].text]
+		if !ok || !slices.Contains(methods, t[i].text) {
+			continue
+		}
+		if chained && (i == 0 || !t[i-1].is(".")) {
+			continue
+		}
+		if !chained && i > 0 && t[i-1].kind == javaTokenIdent && t[i-1].text != "return" {
+			// a method declaration
+			continue
+		}
+		closing := s.closing(i + 1)
+		if closing < 0 {
+			continue
+		}
+		args := s.arguments(i+1, closing)
+		if len(args) == 0 {
+			continue
+		}
+		call := javaCall{
+			method:    t[i].text,
+			callStart: t[i].start,
+			callEnd:   t[closing].end,
+		}
+		if strings.HasSuffix(call.method, "F") {
+			// fromF and toF take a format and its arguments
+			call.start, call.end = t[args[0][0]].start, t[args[len(args)-1][1]-1].end
+			call.value, call.resolved = s.evalFormat(args, map[string]bool{})
+			calls = append(calls, call)
+
+			continue
+		}
+		for _, arg := range args {
+			if arg[0] >= arg[1] {
+				continue
+			}
+			c := call
+			c.start, c.end = t[arg[0]].start, t[arg[1]-1].end
+			c.value, c.resolved = s.eval(arg[0], arg[1], map[string]bool{})
+			calls = append(calls, c)
+			if call.method == "toD" || call.method == "routeTemplate" || call.method == "kamelet" {
+				break
+			}
+		}
+	}
+
+	return calls
+}
+
+// values returns the distinct resolved values of the invocations of the given route DSL methods.
+func (s *javaSource) values(methods ...string) []string {
+	values := make([]string, 0)
+	for _, c := range s.calls(methods...) {
+		if c.resolved && !slices.Contains(values, c.value) {
+			values = append(values, c.value)
+		}
+	}
+
+	return values
+}
+
+// eval evaluates the expression made of the tokens in the given range, returning false if its value cannot be
+// statically determined.
+func (s *javaSource) eval(start int, end int, visiting map[string]bool) (string, bool) {
+	if start >= end {
+		return "", false
+	}
+	var b strings.Builder
+	depth := 0
+	operand := start
+	for i := start; i <= end; i++ {
+		if i < end {
+			t := s.tokens[i]
+			if t.is("(") || t.is("[") || t.is("{") {
+				depth++
+			} else if t.is(")") || t.is("]") || t.is("}") {
+				depth--
+			}
+			if !t.is("+") || depth != 0 {
+				continue
+			}
+		}
+		value, ok := s.evalOperand(operand, i, visiting)
+		if !ok {
+			return "", false
+		}
+		b.WriteString(value)
+		operand = i + 1
+	}
+
+	return b.String(), true
+}
+
+func (s *javaSource) evalOperand(start int, end int, visiting map[string]bool) (string, bool) {
+	t := s.tokens
+	if start >= end {
+		return "", false
+	}
+	if t[start].is("(") && s.closing(start) == end-1 {
+		return s.eval(start+1, end-1, visiting)
+	}
+	if end-start == 1 {
+		switch t[start].kind {
+		case javaTokenString, javaTokenChar:
+			return t[start].text, true
+		case javaTokenNumber:
+			return strings.TrimRight(strings.ReplaceAll(t[start].text, "_", ""), "lL"), true
+		case javaTokenIdent:
+			return s.evalConstant(t[start].text, visiting)
+		default:
+			return "", false
+		}
+	}
+
+	// "...".formatted(args)
+	if t[start].kind == javaTokenString && end-start > 3 && t[start+1].is(".") && t[start+2].text == "formatted" && t[start+3].is("(") {
+		closing := s.closing(start + 3)
+		if closing != end-1 {
+			return "", false
+		}
+		args := append([][2]int{{start, start + 1}}, s.arguments(start+3, closing)...)
+
+		return s.evalFormat(args, visiting)
+	}
+
+	// qualified references to constants (ie, Constants.URI or this.uri) and String static methods
+	i := start
+	for i+2 < end && t[i].kind == javaTokenIdent && t[i+1].is(".") && t[i+2].kind == javaTokenIdent {
+		i += 2
+	}
+	if i == end-1 && t[i].kind == javaTokenIdent {
+		return s.evalConstant(t[i].text, visiting)
+	}
+	if t[i].kind != javaTokenIdent || i+1 >= end || !t[i+1].is("(") {
+		return "", false
+	}
+	closing := s.closing(i + 1)
+	if closing < 0 || closing >= end {
+		return "", false
+	}
+	args := s.arguments(i+1, closing)
+	if i == start+2 && t[start].text == "String" {
+		if closing != end-1 {
+			return "", false
+		}
+		switch t[i].text {
+		case "format":
+			return s.evalFormat(args, visiting)
+		case "valueOf":
+			if len(args) == 1 {
+				return s.eval(args[0][0], args[0][1], visiting)
+			}
+		}
+
+		return "", false
+	}
+	if i == start {
+		return s.evalEndpointBuilder(i, closing, end, visiting)
+	}
+
+	return "", false
+}
+
+func (s *javaSource) evalConstant(name string, visiting map[string]bool) (string, bool) {
+	definitions, ok := s.constants[name]
+	if !ok || visiting[name] {
+		return "", false
+	}
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	// the variable must always be assigned the same value
+	var value string
+	for i, def := range definitions {
+		v, ok := s.eval(def[0], def[1], visiting)
+		if !ok || (i > 0 && v != value) {
+			return "", false
+		}
+		value = v
+	}
+
+	return value, true
+}
+
+// evalFormat evaluates a format string (the first argument) with its arguments, only the %s and %d verbs
+// are supported.
+func (s *javaSource) evalFormat(args [][2]int, visiting map[string]bool) (string, bool) {
+	if len(args) == 0 {
+		return "", false
+	}
+	values := make([]string, 0, len(args))
+	for _, arg := range args {
+		v, ok := s.eval(arg[0], arg[1], visiting)
+		if !ok {
+			return "", false
+		}
+		values = append(values, v)
+	}
+
+	format, params := values[0], values[1:]
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			b.WriteByte(format[i])
+
+			continue
+		}
+		i++
+		if i >= len(format) {
+			return "", false
+		}
+		switch format[i] {
+		case '%':
+			b.WriteByte('%')
+		case 'n':
+			b.WriteByte('\n')
+		case 's', 'd':
+			if len(params) == 0 {
+				return "", false
+			}
+			b.WriteString(params[0])
+			params = params[1:]
+		default:
+			return "", false
+		}
+	}
+
+	return b.String(), true
+}
+
+// evalEndpointBuilder evaluates an Endpoint DSL builder, ie, `timer("tick").period(1000)`, as the equivalent URI.
+// The options set on the builder are turned into URI parameters when their value can be determined.
+func (s *javaSource) evalEndpointBuilder(name int, closing int, end int, visiting map[string]bool) (string, bool) {
+	t := s.tokens
+	if !s.endpointDSL || s.isScheme == nil {
+		return "", false
+	}
+
+	var scheme, path string
+	args := s.arguments(name+1, closing)
+	switch len(args) {
+	case 1:
+		scheme = javaBuilderScheme(t[name].text)
+		p, ok := s.eval(args[0][0], args[0][1], visiting)
+		if !ok {
+			return "", false
+		}
+		path = p
+	case 2:
+		// the builder variant taking the component name and the path
+		c, ok := s.eval(args[0][0], args[0][1], visiting)
+		if !ok {
+			return "", false
+		}
+		p, ok := s.eval(args[1][0], args[1][1], visiting)
+		if !ok {
+			return "", false
+		}
+		scheme, path = c, p
+	default:
+		return "", false
+	}
+	if !s.isScheme(scheme) {
+		return "", false
+	}
+
+	params := make([]string, 0)
+	for i := closing + 1; i < end; {
+		if !t[i].is(".") || i+2 >= end || t[i+1].kind != javaTokenIdent || !t[i+2].is("(") {
+			return "", false
+		}
+		option := t[i+1].text
+		optionClosing := s.closing(i + 2)
+		if optionClosing < 0 || optionClosing >= end {
+			return "", false
+		}
+		optionArgs := s.arguments(i+2, optionClosing)
+		if len(optionArgs) == 1 {
+			if v, ok := s.eval(optionArgs[0][0], optionArgs[0][1], visiting); ok {
+				params = append(params, option+"="+v)
+			}
+		}
+		i = optionClosing + 1
+	}
+
+	uri := scheme + ":" + path
+	if len(params) > 0 {
+		separator := "?"
+		if strings.Contains(path, "?") {
+			separator = "&"
+		}
+		uri += separator + strings.Join(params, "&")
+	}
+
+	return uri, true
+}
+
+// javaBuilderScheme converts the name of an Endpoint DSL builder method to the component scheme
+// (ie, platformHttp to platform-http).
+func javaBuilderScheme(method string) string {
+	var b strings.Builder
+	for i, r := range method {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// javaQuote returns the given value as a Java string literal.
+func javaQuote(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+
+	return `"` + value + `"`
+}
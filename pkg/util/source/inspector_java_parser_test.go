@@ -0,0 +1,103 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenizeJava(t *testing.T) {
+	content := "from(\"a\\tb\\u0041\").to('c'); // comment\n/* block */ int x = 1_000L;"
+	tokens, err := tokenizeJava(content)
+	require.NoError(t, err)
+
+	texts := make([]string, 0, len(tokens))
+	for _, tk := range tokens {
+		texts = append(texts, tk.text)
+	}
+	assert.Equal(t, []string{"from", "(", "a\tbA", ")", ".", "to", "(", "c", ")", ";", "int", "x", "=", "1_000L", ";"}, texts)
+	assert.Equal(t, javaTokenString, tokens[2].kind)
+	assert.Equal(t, `"a\tb\u0041"`, content[tokens[2].start:tokens[2].end])
+	assert.Equal(t, javaTokenChar, tokens[7].kind)
+}
+
+func TestTokenizeJavaErrors(t *testing.T) {
+	for _, content := range []string{
+		`from("unterminated`,
+		"from(\"new\nline\")",
+		`/* unterminated`,
+		`from("""no new line""")`,
+		"from(\"\"\"\n unterminated",
+		`from("\q")`,
+	} {
+		_, err := tokenizeJava(content)
+		require.Error(t, err, content)
+	}
+}
+
+func TestJavaTextBlock(t *testing.T) {
+	content := "String s = \"\"\"\n" +
+		"        first   \n" +
+		"\n" +
+		"          second\\\n" +
+		"        continued \\\"\"\"\n" +
+		"        \"\"\";"
+	tokens, err := tokenizeJava(content)
+	require.NoError(t, err)
+	require.Len(t, tokens, 5)
+	assert.Equal(t, "first\n\n  secondcontinued \"\"\"\n", tokens[3].text)
+	assert.Equal(t, len(content)-1, tokens[3].end)
+}
+
+func TestJavaSourceEval(t *testing.T) {
+	js, err := parseJavaSource(`
+		class Routes extends EndpointRouteBuilder {
+			static final String A = "a";
+			static final String B = A + "b" + 1 + 'c';
+			static final String LOOP = LOOP + "x";
+			String changing = "one";
+			void configure() {
+				changing = "two";
+				from(B).to(String.format("%s-%d%%", A, 5), "x:" + changing).to(LOOP).to(direct("y").unknown(someMethod()));
+			}
+		}`, func(scheme string) bool { return scheme == "direct" })
+	require.NoError(t, err)
+
+	calls := js.calls("from", "to")
+	require.Len(t, calls, 5)
+	assert.Equal(t, "from", calls[0].method)
+	assert.True(t, calls[0].resolved)
+	assert.Equal(t, "ab1c", calls[0].value)
+	assert.Equal(t, "B", js.content[calls[0].start:calls[0].end])
+	assert.Equal(t, "from(B)", js.content[calls[0].callStart:calls[0].callEnd])
+	assert.Equal(t, "a-5%", calls[1].value)
+	assert.False(t, calls[2].resolved, "a variable assigned different values cannot be resolved")
+	assert.False(t, calls[3].resolved, "a recursive definition cannot be resolved")
+	assert.True(t, calls[4].resolved)
+	assert.Equal(t, "direct:y", calls[4].value)
+}
+
+func TestJavaBuilderScheme(t *testing.T) {
+	assert.Equal(t, "timer", javaBuilderScheme("timer"))
+	assert.Equal(t, "platform-http", javaBuilderScheme("platformHttp"))
+	assert.Equal(t, "aws2-s3", javaBuilderScheme("aws2S3"))
+	assert.Equal(t, "spring-rabbitmq", javaBuilderScheme("springRabbitmq"))
+}
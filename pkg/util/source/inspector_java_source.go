@@ -20,6 +20,8 @@ package source
 import (
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/util"
@@ -37,6 +39,28 @@ type JavaSourceInspector struct {
 
 // Extract extracts all metadata from source spec.
 func (i JavaSourceInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
+	js, err := parseJavaSource(source.Content, i.isScheme)
+	if err != nil {
+		// the source cannot be tokenized, fallback to regular expressions
+		return i.extractWithRegexp(source, meta)
+	}
+
+	from := filterURIs(js.values("from", "fromF"))
+	to := filterURIs(js.values("to", "toD", "toF", "wireTap"))
+	kameletEips := make([]string, 0)
+	for _, k := range js.values("kamelet") {
+		if match := javaKameletEipRegexp.FindStringSubmatch(k); match != nil && !slices.Contains(kameletEips, match[1]) {
+			kameletEips = append(kameletEips, match[1])
+		}
+	}
+	meta.RouteTemplates = append(meta.RouteTemplates, js.values("routeTemplate")...)
+
+	hasRest := restRegexp.MatchString(source.Content)
+
+	return i.extract(source, meta, from, to, kameletEips, hasRest)
+}
+
+func (i JavaSourceInspector) extractWithRegexp(source v1.SourceSpec, meta *Metadata) error {
 	from := util.FindAllDistinctStringSubmatch(
 		source.Content,
 		doubleQuotedFrom,
@@ -52,6 +76,9 @@ func (i JavaSourceInspector) Extract(source v1.SourceSpec, meta *Metadata) error
 	kameletEips := util.FindAllDistinctStringSubmatch(
 		source.Content,
 		doubleQuotedKameletEip)
+	meta.RouteTemplates = append(meta.RouteTemplates, util.FindAllDistinctStringSubmatch(
+		source.Content,
+		doubleQuotedRouteTmpl)...)
 
 	hasRest := restRegexp.MatchString(source.Content)
 
@@ -60,7 +87,57 @@ func (i JavaSourceInspector) Extract(source v1.SourceSpec, meta *Metadata) error
 
 // ReplaceFromURI parses the source content and replace the `from` URI configuration with the a new URI. Returns true if it applies a replacement.
 func (i JavaSourceInspector) ReplaceFromURI(source *v1.SourceSpec, newFromURI string) (bool, error) {
-	return replaceFromURIDoubleQuotesOnly(source, newFromURI)
+	js, err := parseJavaSource(source.Content, i.isScheme)
+	if err != nil {
+		// the source cannot be tokenized, fallback to regular expressions
+		return replaceFromURIDoubleQuotesOnly(source, newFromURI)
+	}
+
+	content := source.Content
+	calls := js.calls("from", "fromF")
+	// replace from the end, so that the positions of the previous calls are still valid
+	for k := len(calls) - 1; k >= 0; k-- {
+		c := calls[k]
+		if !c.resolved || !isScheduledURI(c.value) {
+			continue
+		}
+		if c.method == "fromF" {
+			content = content[:c.callStart] + "from(" + javaQuote(newFromURI) + ")" + content[c.callEnd:]
+		} else {
+			content = content[:c.start] + javaQuote(newFromURI) + content[c.end:]
+		}
+	}
+	replaced := content != source.Content
+	source.Content = content
+
+	return replaced, nil
+}
+
+func (i JavaSourceInspector) isScheme(scheme string) bool {
+	if i.catalog == nil {
+		return false
+	}
+	_, ok := i.catalog.GetScheme(scheme)
+
+	return ok
+}
+
+// filterURIs retains the values looking like endpoint URIs.
+func filterURIs(values []string) []string {
+	uris := make([]string, 0, len(values))
+	for _, v := range values {
+		if javaURIRegexp.MatchString(v) {
+			uris = append(uris, v)
+		}
+	}
+
+	return uris
+}
+
+func isScheduledURI(uri string) bool {
+	scheme, _, _ := strings.Cut(uri, ":")
+
+	return scheme == "timer" || scheme == "cron" || scheme == "quartz"
 }
 
 func replaceFromURIDoubleQuotesOnly(source *v1.SourceSpec, newFromURI string) (bool, error) {
@@ -245,3 +245,185 @@ func TestErrorHandlerDependencies(t *testing.T) {
 		assert.Contains(t, meta.Dependencies.List(), "camel:log")
 	})
 }
+
+func TestJavaSourceRouteTemplate(t *testing.T) {
+	inspector := newTestJavaSourceInspector(t)
+
+	assertExtract(t, inspector, `
+		public void configure() throws Exception {
+			routeTemplate("my-timer-source")
+				.templateParameter("period")
+				.from("timer:tick?period={{period}}")
+				.to("kamelet:sink");
+		}
+		`, func(meta *Metadata) {
+		assert.Equal(t, []string{"my-timer-source"}, meta.RouteTemplates)
+		assert.Contains(t, meta.FromURIs, "timer:tick?period={{period}}")
+		assert.Contains(t, meta.ToURIs, "kamelet:sink")
+		assert.Contains(t, meta.Dependencies.List(), "camel:timer")
+		assert.Contains(t, meta.Dependencies.List(), "camel:kamelet")
+		assert.Empty(t, meta.Kamelets)
+	})
+}
+
+func TestJavaSourceConstants(t *testing.T) {
+	inspector := newTestJavaSourceInspector(t)
+
+	assertExtract(t, inspector, `
+		import org.apache.camel.builder.RouteBuilder;
+
+		public class MyRoutes extends RouteBuilder {
+			private static final String BASE = "kafka:";
+			private static final String TOPIC = BASE + "orders";
+			private static final String PERIOD = "1000";
+
+			@Override
+			public void configure() throws Exception {
+				String sink = String.format("jms:queue:%s", "out");
+				from("timer:tick?period=" + PERIOD)
+					.to(TOPIC)
+					.toF("%s:%s", "seda", "audit")
+					.to(sink, MyRoutes.TOPIC);
+			}
+		}
+		`, func(meta *Metadata) {
+		assert.Equal(t, []string{"timer:tick?period=1000"}, meta.FromURIs)
+		assert.Equal(t, []string{"kafka:orders", "seda:audit", "jms:queue:out"}, meta.ToURIs)
+		assert.Contains(t, meta.Dependencies.List(), "camel:kafka")
+		assert.Contains(t, meta.Dependencies.List(), "camel:seda")
+		assert.Contains(t, meta.Dependencies.List(), "camel:jms")
+	})
+}
+
+func TestJavaSourceTextBlock(t *testing.T) {
+	inspector := newTestJavaSourceInspector(t)
+
+	assertExtract(t, inspector, `
+		public void configure() throws Exception {
+			from("""
+				timer:tick?period=%d""".formatted(1000))
+				.to("""
+				    log:info
+				    """.trim());
+		}
+		`, func(meta *Metadata) {
+		assert.Equal(t, []string{"timer:tick?period=1000"}, meta.FromURIs)
+		assert.Contains(t, meta.Dependencies.List(), "camel:timer")
+	})
+}
+
+func TestJavaSourceEndpointDSL(t *testing.T) {
+	inspector := newTestJavaSourceInspector(t)
+
+	assertExtract(t, inspector, `
+		import org.apache.camel.builder.endpoint.EndpointRouteBuilder;
+
+		public class MyRoutes extends EndpointRouteBuilder {
+			static final String QUEUE = "orders";
+
+			@Override
+			public void configure() throws Exception {
+				from(timer("tick").period(1000).repeatCount(5))
+					.to(kafka(QUEUE).advanced())
+					.to(platformHttp("/hello"))
+					.to(kamelet("my-sink"));
+			}
+		}
+		`, func(meta *Metadata) {
+		assert.Equal(t, []string{"timer:tick?period=1000&repeatCount=5"}, meta.FromURIs)
+		assert.Equal(t, []string{"kafka:orders", "platform-http:/hello", "kamelet:my-sink"}, meta.ToURIs)
+		assert.Contains(t, meta.Dependencies.List(), "camel:kafka")
+		assert.Contains(t, meta.Dependencies.List(), "camel:platform-http")
+		assert.Contains(t, meta.Kamelets, "my-sink")
+	})
+}
+
+func TestJavaSourceEndpointDSLNotEnabled(t *testing.T) {
+	inspector := newTestJavaSourceInspector(t)
+
+	assertExtract(t, inspector, `
+		from("direct:start")
+			.to(kafka("orders"));
+		`, func(meta *Metadata) {
+		assert.Equal(t, []string{"direct:start"}, meta.FromURIs)
+		assert.Empty(t, meta.ToURIs)
+	})
+}
+
+func TestJavaSourceCommentsAreIgnored(t *testing.T) {
+	inspector := newTestJavaSourceInspector(t)
+
+	assertExtract(t, inspector, `
+		// from("kafka:commented")
+		/*
+		 * .to("jms:queue:commented")
+		 */
+		from("timer:tick").to("log:info"); // .to("seda:commented")
+		`, func(meta *Metadata) {
+		assert.Equal(t, []string{"timer:tick"}, meta.FromURIs)
+		assert.Equal(t, []string{"log:info"}, meta.ToURIs)
+	})
+}
+
+func TestJavaSourceRegexpFallback(t *testing.T) {
+	inspector := newTestJavaSourceInspector(t)
+
+	// the unterminated comment cannot be tokenized
+	assertExtract(t, inspector, `
+		from("timer:tick").to("log:info");
+		/* unterminated
+		`, func(meta *Metadata) {
+		assert.Equal(t, []string{"timer:tick"}, meta.FromURIs)
+		assert.Equal(t, []string{"log:info"}, meta.ToURIs)
+	})
+}
+
+func TestJavaReplaceURIConstant(t *testing.T) {
+	inspector := newTestJavaSourceInspector(t)
+
+	sourceSpec := &v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name: "test.java",
+			Content: `static final String CRON = "cron:tab?schedule=0/1 * * * ?";
+from(CRON).to("log:info");
+fromF("timer:%s", "tick").to("log:info");
+from(  "direct:d1" ).to("log:info");`,
+		},
+	}
+	replaced, err := inspector.ReplaceFromURI(
+		sourceSpec,
+		"direct:newURI?hello=world",
+	)
+	require.NoError(t, err)
+	assert.True(t, replaced)
+	assert.Equal(t, `static final String CRON = "cron:tab?schedule=0/1 * * * ?";
+from("direct:newURI?hello=world").to("log:info");
+from("direct:newURI?hello=world").to("log:info");
+from(  "direct:d1" ).to("log:info");`, sourceSpec.Content)
+}
+
+func TestJavaReplaceURIEndpointDSL(t *testing.T) {
+	inspector := newTestJavaSourceInspector(t)
+
+	sourceSpec := &v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name: "test.java",
+			Content: `public class Routes extends EndpointRouteBuilder {
+	public void configure() {
+		from(timer("tick").period(1000)).to(log("info"));
+	}
+}`,
+		},
+	}
+	replaced, err := inspector.ReplaceFromURI(
+		sourceSpec,
+		"direct:newURI?hello=world",
+	)
+	require.NoError(t, err)
+	assert.True(t, replaced)
+	assert.Equal(t, `public class Routes extends EndpointRouteBuilder {
+	public void configure() {
+		from("direct:newURI?hello=world").to(log("info"));
+	}
+}`, sourceSpec.Content)
+}
@@ -54,7 +54,7 @@ func (i XMLInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
 				meta.RequiredCapabilities.Add(v1.CapabilityRest)
 			case "openApi":
 				if dfDep := i.catalog.GetArtifactByScheme("rest-openapi"); dfDep != nil {
-					meta.AddDependency(dfDep.GetDependencyID())
+					meta.AddDependencyFrom(dfDep.GetDependencyID(), v1.DependencyOriginDSL, se.Name.Local)
 				}
 			case "circuitBreaker":
 				meta.RequiredCapabilities.Add(v1.CapabilityCircuitBreaker)
@@ -66,13 +66,13 @@ func (i XMLInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
 					}
 				}
 				if dfDep := i.catalog.GetArtifactByDataFormat(dataFormatID); dfDep != nil {
-					meta.AddDependency(dfDep.GetDependencyID())
+					meta.AddDependencyFrom(dfDep.GetDependencyID(), v1.DependencyOriginDSL, se.Name.Local)
 				}
 			case language:
 				for _, a := range se.Attr {
 					if a.Name.Local == language {
 						if dependency, ok := i.catalog.GetLanguageDependency(a.Value); ok {
-							meta.AddDependency(dependency)
+							meta.AddDependencyFrom(dependency, v1.DependencyOriginLanguage, a.Value)
 						}
 					}
 				}
@@ -81,7 +81,7 @@ func (i XMLInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
 					if a.Name.Local == "deadLetterUri" {
 						_, scheme := i.catalog.DecodeComponent(a.Value)
 						if dfDep := i.catalog.GetArtifactByScheme(scheme.ID); dfDep != nil {
-							meta.AddDependency(dfDep.GetDependencyID())
+							meta.AddDependencyFrom(dfDep.GetDependencyID(), v1.DependencyOriginURI, a.Value)
 						}
 						if scheme.ID == kamelet {
 							AddKamelet(meta, a.Value)
@@ -100,6 +100,12 @@ func (i XMLInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
 						meta.ToURIs = append(meta.ToURIs, a.Value)
 					}
 				}
+			case "routeTemplate":
+				for _, a := range se.Attr {
+					if a.Name.Local == "id" {
+						meta.RouteTemplates = append(meta.RouteTemplates, a.Value)
+					}
+				}
 			case kamelet:
 				for _, a := range se.Attr {
 					if a.Name.Local == "name" {
@@ -109,11 +115,15 @@ func (i XMLInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
 			}
 
 			if dependency, ok := i.catalog.GetLanguageDependency(se.Name.Local); ok {
-				meta.AddDependency(dependency)
+				meta.AddDependencyFrom(dependency, v1.DependencyOriginLanguage, se.Name.Local)
 			}
 		}
 	}
 
+	if meta.Routes != nil {
+		meta.Routes.Merge(i.extractRouteModel(source.Content))
+	}
+
 	if err := i.discoverCapabilities(source, meta); err != nil {
 		return err
 	}
@@ -121,11 +131,12 @@ func (i XMLInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
 		return err
 	}
 	i.discoverKamelets(meta)
+	i.discoverPropertyPlaceholders(source.Content, meta)
 
 	meta.ExposesHTTPServices = meta.ExposesHTTPServices || i.containsHTTPURIs(meta.FromURIs)
 	meta.PassiveEndpoints = i.hasOnlyPassiveEndpoints(meta.FromURIs)
 
-	return nil
+	return i.discoverRiskCategories(meta)
 }
 
 // ReplaceFromURI parses the source content and replace the `from` URI configuration with the a new URI. Returns true if it applies a replacement.
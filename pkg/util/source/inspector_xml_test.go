@@ -268,6 +268,24 @@ func TestXMLReplaceMultiURI(t *testing.T) {
 	assert.Equal(t, expectedXmlReplaceMultiURI, sourceSpec.Content)
 }
 
+func TestXMLRouteTemplateReplaceURI(t *testing.T) {
+	inspector := newTestXMLInspector(t)
+
+	sourceSpec := &v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name:    "my-timer-source.xml",
+			Content: `<routeTemplate id="my-timer-source"><route><from uri="timer:tick"/><to uri="kamelet:sink"/></route></routeTemplate>`,
+		},
+	}
+	replaced, err := inspector.ReplaceFromURI(
+		sourceSpec,
+		"direct:newURI?hello=world",
+	)
+	require.NoError(t, err)
+	assert.True(t, replaced)
+	assert.Equal(t, `<routeTemplate id="my-timer-source"><route><from uri="direct:newURI?hello=world"/><to uri="kamelet:sink"/></route></routeTemplate>`, sourceSpec.Content)
+}
+
 func TestXMLRestOpenapiFirst(t *testing.T) {
 	inspector := newTestXMLInspector(t)
 
@@ -319,3 +337,26 @@ func TestXMLErrorHandlerDependencies(t *testing.T) {
 		assert.Contains(t, meta.Dependencies.List(), "camel:log")
 	})
 }
+
+func TestXMLRouteTemplate(t *testing.T) {
+	xmlCode := `
+	<routeTemplates xmlns="http://camel.apache.org/schema/spring">
+		<routeTemplate id="my-log-sink">
+			<templateParameter name="loggerName"/>
+			<route>
+				<from uri="kamelet:source"/>
+				<to uri="log:{{loggerName}}"/>
+			</route>
+		</routeTemplate>
+	</routeTemplates>
+	`
+	inspector := newTestXMLInspector(t)
+
+	assertExtract(t, inspector, xmlCode, func(meta *Metadata) {
+		assert.Equal(t, []string{"my-log-sink"}, meta.RouteTemplates)
+		assert.Contains(t, meta.FromURIs, "kamelet:source")
+		assert.Contains(t, meta.Dependencies.List(), "camel:log")
+		assert.Contains(t, meta.Dependencies.List(), "camel:kamelet")
+		assert.Empty(t, meta.Kamelets)
+	})
+}
@@ -50,6 +50,9 @@ func (i YAMLInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
 			}
 		}
 	}
+	if meta.Routes != nil {
+		meta.Routes.Merge(i.extractRouteModel(definitions))
+	}
 
 	if err := i.discoverCapabilities(source, meta); err != nil {
 		return err
@@ -58,16 +61,24 @@ func (i YAMLInspector) Extract(source v1.SourceSpec, meta *Metadata) error {
 		return err
 	}
 	i.discoverKamelets(meta)
+	i.discoverPropertyPlaceholders(source.Content, meta)
 
 	meta.ExposesHTTPServices = meta.ExposesHTTPServices || i.containsHTTPURIs(meta.FromURIs)
 	meta.PassiveEndpoints = i.hasOnlyPassiveEndpoints(meta.FromURIs)
 
-	return nil
+	return i.discoverRiskCategories(meta)
 }
 
 //nolint:nestif
 func (i YAMLInspector) parseDefinition(def map[string]any, meta *Metadata) error {
 	for k, v := range def {
+		if k == "routeTemplate" || k == "route-template" {
+			if tmpl, ok := v.(map[any]any); ok {
+				if id, ok := tmpl["id"].(string); ok {
+					meta.RouteTemplates = append(meta.RouteTemplates, id)
+				}
+			}
+		}
 		if k == rest {
 			meta.ExposesHTTPServices = true
 			meta.RequiredCapabilities.Add(v1.CapabilityRest)
@@ -75,7 +86,7 @@ func (i YAMLInspector) parseDefinition(def map[string]any, meta *Metadata) error
 			if oa, ok := v.(map[any]any); ok {
 				if _, oaOk := oa["openApi"]; oaOk {
 					if dfDep := i.catalog.GetArtifactByScheme("rest-openapi"); dfDep != nil {
-						meta.AddDependency(dfDep.GetDependencyID())
+						meta.AddDependencyFrom(dfDep.GetDependencyID(), v1.DependencyOriginDSL, "openApi")
 					}
 				}
 			}
@@ -90,7 +101,7 @@ func (i YAMLInspector) parseStep(key string, content any, meta *Metadata) error
 	switch key {
 	case "bean":
 		if bean := i.catalog.GetArtifactByScheme("bean"); bean != nil {
-			meta.AddDependency(bean.GetDependencyID())
+			meta.AddDependencyFrom(bean.GetDependencyID(), v1.DependencyOriginDSL, key)
 		}
 	case rest:
 		meta.ExposesHTTPServices = true
@@ -107,7 +118,7 @@ func (i YAMLInspector) parseStep(key string, content any, meta *Metadata) error
 					}
 				}
 				if dfDep := i.catalog.GetArtifactByDataFormat(dataFormatID); dfDep != nil {
-					meta.AddDependency(dfDep.GetDependencyID())
+					meta.AddDependencyFrom(dfDep.GetDependencyID(), v1.DependencyOriginDSL, key)
 				}
 			}
 		}
@@ -131,7 +142,7 @@ func (i YAMLInspector) parseStep(key string, content any, meta *Metadata) error
 		for k, v := range t {
 			if s, ok := k.(string); ok {
 				if dependency, ok := i.catalog.GetLanguageDependency(s); ok {
-					meta.AddDependency(dependency)
+					meta.AddDependencyFrom(dependency, v1.DependencyOriginLanguage, s)
 				}
 			}
 
@@ -162,7 +173,7 @@ func (i YAMLInspector) parseStep(key string, content any, meta *Metadata) error
 			case "language":
 				if s, ok := v.(string); ok {
 					if dependency, ok := i.catalog.GetLanguageDependency(s); ok {
-						meta.AddDependency(dependency)
+						meta.AddDependencyFrom(dependency, v1.DependencyOriginLanguage, s)
 					}
 				} else if m, ok := v.(map[any]any); ok {
 					if err := i.parseStep("language", m, meta); err != nil {
@@ -173,7 +184,7 @@ func (i YAMLInspector) parseStep(key string, content any, meta *Metadata) error
 				if s, ok := v.(string); ok {
 					_, scheme := i.catalog.DecodeComponent(s)
 					if dfDep := i.catalog.GetArtifactByScheme(scheme.ID); dfDep != nil {
-						meta.AddDependency(dfDep.GetDependencyID())
+						meta.AddDependencyFrom(dfDep.GetDependencyID(), v1.DependencyOriginURI, s)
 					}
 					if scheme.ID == kamelet {
 						AddKamelet(meta, s)
@@ -896,3 +896,21 @@ func TestYAMLErrorHandlerKamelet(t *testing.T) {
 		})
 	})
 }
+
+func TestYAMLRouteTemplate(t *testing.T) {
+	yamlTemplate := `
+- routeTemplate:
+    id: my-log-sink
+    from:
+      uri: "kamelet:source"
+      steps:
+      - to: "log:info"
+`
+	inspector := newTestYAMLInspector(t)
+
+	assertExtractYAML(t, inspector, yamlTemplate, func(meta *Metadata) {
+		assert.Equal(t, []string{"my-log-sink"}, meta.RouteTemplates)
+		assert.Contains(t, meta.FromURIs, "kamelet:source")
+		assert.Contains(t, meta.Dependencies.List(), "camel:log")
+	})
+}
@@ -39,8 +39,10 @@ func ExtractKamelet(uri string) string {
 	return ""
 }
 
+// AddKamelet adds the Kamelet referenced by the given URI, if any. The `kamelet:source` and `kamelet:sink` endpoints
+// used by route templates are not Kamelets, hence ignored.
 func AddKamelet(meta *Metadata, content string) {
-	if maybeKamelet := ExtractKamelet(content); maybeKamelet != "" {
+	if maybeKamelet := ExtractKamelet(content); maybeKamelet != "" && v1.ValidKameletName(maybeKamelet) {
 		meta.Kamelets = append(meta.Kamelets, maybeKamelet)
 	}
 }
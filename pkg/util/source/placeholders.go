@@ -0,0 +1,107 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	propertyPlaceholderRegexp = regexp.MustCompile(`\{\{(\?)?([^{}]+)\}\}`)
+
+	// propertyFunctions are the Camel property functions which can be used in a placeholder, ie, `{{env:MY_VAR}}`.
+	propertyFunctions = []string{
+		"bean", "configmap", "configmap-binary", "env", "ref", "secret", "secret-binary", "service", "service.host", "service.port", "sys",
+	}
+)
+
+// discoverPropertyPlaceholders collects the property placeholders used in the source.
+func (i *baseInspector) discoverPropertyPlaceholders(source string, meta *Metadata) {
+	for _, p := range findPropertyPlaceholders(source) {
+		if !slices.ContainsFunc(meta.PropertyPlaceholders, p.equals) {
+			meta.PropertyPlaceholders = append(meta.PropertyPlaceholders, p)
+		}
+	}
+}
+
+// findPropertyPlaceholders returns the distinct property placeholders used in the given content, in order of appearance.
+func findPropertyPlaceholders(content string) []PropertyPlaceholder {
+	placeholders := make([]PropertyPlaceholder, 0)
+	for _, match := range propertyPlaceholderRegexp.FindAllStringSubmatch(content, -1) {
+		p, ok := parsePropertyPlaceholder(match[2])
+		if !ok {
+			continue
+		}
+		p.Optional = match[1] != ""
+		if !slices.ContainsFunc(placeholders, p.equals) {
+			placeholders = append(placeholders, p)
+		}
+	}
+
+	return placeholders
+}
+
+// ReplacePropertyPlaceholders replaces the property placeholders used in the given content with the value returned by
+// the given function. The placeholders it does not resolve are left unchanged.
+func ReplacePropertyPlaceholders(content string, value func(PropertyPlaceholder) (string, bool)) string {
+	return propertyPlaceholderRegexp.ReplaceAllStringFunc(content, func(match string) string {
+		groups := propertyPlaceholderRegexp.FindStringSubmatch(match)
+		p, ok := parsePropertyPlaceholder(groups[2])
+		if !ok {
+			return match
+		}
+		p.Optional = groups[1] != ""
+		if v, ok := value(p); ok {
+			return v
+		}
+
+		return match
+	})
+}
+
+func parsePropertyPlaceholder(value string) (PropertyPlaceholder, bool) {
+	p := PropertyPlaceholder{}
+	key := strings.TrimSpace(value)
+	if function, argument, ok := strings.Cut(key, ":"); ok && slices.Contains(propertyFunctions, function) {
+		p.Function = function
+		key = argument
+	}
+	if k, def, ok := strings.Cut(key, ":"); ok {
+		key = k
+		p.Default = &def
+	}
+	if key == "" {
+		return p, false
+	}
+	if p.Function != "" {
+		key = p.Function + ":" + key
+	}
+	p.Key = key
+
+	return p, true
+}
+
+func (p PropertyPlaceholder) equals(other PropertyPlaceholder) bool {
+	if p.Key != other.Key || p.Optional != other.Optional || (p.Default == nil) != (other.Default == nil) {
+		return false
+	}
+
+	return p.Default == nil || *p.Default == *other.Default
+}
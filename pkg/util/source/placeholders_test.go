@@ -0,0 +1,104 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+)
+
+func TestFindPropertyPlaceholders(t *testing.T) {
+	placeholders := findPropertyPlaceholders(`
+		from("timer:tick?period={{my.period:1000}}")
+			.setBody().simple("{{my.message}} {{?my.suffix}} {{my.message}}")
+			.setHeader("token").simple("{{secret:my-secret/token}}")
+			.setHeader("mode").simple("{{configmap:my-cm/mode:dev}}")
+			.setHeader("home").simple("{{env:HOME}}")
+			.setHeader("empty").simple("{{my.empty:}} {{ }}")
+			.to("log:info");
+	`)
+
+	assert.Equal(t, []PropertyPlaceholder{
+		{Key: "my.period", Default: ptr.To("1000")},
+		{Key: "my.message"},
+		{Key: "my.suffix", Optional: true},
+		{Key: "secret:my-secret/token", Function: "secret"},
+		{Key: "configmap:my-cm/mode", Function: "configmap", Default: ptr.To("dev")},
+		{Key: "env:HOME", Function: "env"},
+		{Key: "my.empty", Default: ptr.To("")},
+	}, placeholders)
+	assert.False(t, placeholders[1].IsResolvable())
+	assert.True(t, placeholders[2].IsResolvable())
+	assert.True(t, placeholders[6].IsResolvable())
+}
+
+func TestReplacePropertyPlaceholders(t *testing.T) {
+	props := map[string]string{"my.host": "example.com"}
+	value := func(p PropertyPlaceholder) (string, bool) {
+		if v, ok := props[p.Key]; ok {
+			return v, true
+		}
+		if p.Default != nil {
+			return *p.Default, true
+		}
+
+		return "", false
+	}
+
+	assert.Equal(t, "http://example.com:8080/{{my.path}}",
+		ReplacePropertyPlaceholders("http://{{my.host}}:{{my.port:8080}}/{{my.path}}", value))
+	assert.Equal(t, "{{ }}", ReplacePropertyPlaceholders("{{ }}", value))
+}
+
+func TestExtractPropertyPlaceholders(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	code := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name: "route.yaml",
+			Content: `
+- from:
+    uri: "timer:tick"
+    steps:
+      - setBody:
+          simple: "{{my.message}}"
+      - to: "{{my.endpoint:log:info}}"
+`,
+		},
+		Language: v1.LanguageYaml,
+	}
+
+	meta := NewMetadata()
+	inspector := YAMLInspector{
+		baseInspector: baseInspector{
+			catalog: catalog,
+		},
+	}
+	require.NoError(t, inspector.Extract(code, &meta))
+	assert.Equal(t, []PropertyPlaceholder{
+		{Key: "my.message"},
+		{Key: "my.endpoint", Default: ptr.To("log:info")},
+	}, meta.PropertyPlaceholders)
+}
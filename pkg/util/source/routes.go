@@ -0,0 +1,191 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"path"
+	"slices"
+	"strings"
+)
+
+// coreLanguages are the languages provided by the Camel core, which are not listed as language dependencies in the catalog.
+var coreLanguages = []string{
+	"constant", "csimple", "exchangeProperty", "header", "method", "ref", "simple", "tokenize", "variable",
+}
+
+// RouteModel is the structured model of the routes defined in a source.
+type RouteModel struct {
+	// The routes and the route templates
+	Routes []Route
+	// The REST services
+	Rests []Rest
+	// The error handlers applying to all the routes
+	ErrorHandlers []ErrorHandler
+	// The exception clauses applying to all the routes
+	OnExceptions []OnException
+}
+
+// Route is a route, or a route template.
+type Route struct {
+	ID string
+	// True for a route template
+	Template bool
+	// The parameters of a route template
+	Parameters []string
+	// The URI the route consumes from
+	From string
+	// The ordered steps of the route
+	Steps []Step
+	// The error handler specific to the route, if any
+	ErrorHandler *ErrorHandler
+}
+
+// Step is an EIP of a route, ie, `to`, `split` or `choice`.
+type Step struct {
+	// The EIP name, ie, `split`
+	Kind string
+	ID   string
+	// The endpoint URI, for the EIPs sending to an endpoint
+	URI string
+	// The expression or the predicate, ie, `simple: ${body}`
+	Expression string
+	// The exceptions handled by a `doCatch`
+	Exceptions []string
+	// The nested steps, including the branches of a `choice` (`when` and `otherwise`),
+	// a `doTry` (`doCatch` and `doFinally`) or a `circuitBreaker` (`onFallback`)
+	Steps []Step
+}
+
+// Rest is a REST service.
+type Rest struct {
+	ID string
+	// The base path of the service
+	Path  string
+	Verbs []RestVerb
+}
+
+// RestVerb is an HTTP operation of a REST service.
+type RestVerb struct {
+	ID string
+	// The HTTP method, ie, `get`
+	Method string
+	// The path, relative to the REST service base path
+	Path string
+	// The URI the operation is routed to
+	To string
+}
+
+// ErrorHandler is an error handler, ie, a `deadLetterChannel`.
+type ErrorHandler struct {
+	// The error handler type, ie, `deadLetterChannel`, or `ref` for a reference to another error handler
+	Kind string
+	// The dead letter URI, or the referenced error handler
+	URI string
+}
+
+// OnException is an exception clause.
+type OnException struct {
+	Exceptions []string
+	Steps      []Step
+}
+
+// RestPaths returns the sorted full paths of the REST operations, ie, `/api/orders/{id}`.
+func (m *RouteModel) RestPaths() []string {
+	var paths []string
+	for _, rest := range m.Rests {
+		for _, verb := range rest.Verbs {
+			p := path.Join("/", rest.Path, verb.Path)
+			if !slices.Contains(paths, p) {
+				paths = append(paths, p)
+			}
+		}
+	}
+	slices.Sort(paths)
+
+	return paths
+}
+
+// DeadLetterURIs returns the sorted URIs of the dead letter channels, either applying to all the routes or specific
+// to a route.
+func (m *RouteModel) DeadLetterURIs() []string {
+	var uris []string
+	add := func(eh *ErrorHandler) {
+		if eh != nil && eh.Kind == "deadLetterChannel" && eh.URI != "" && !slices.Contains(uris, eh.URI) {
+			uris = append(uris, eh.URI)
+		}
+	}
+	for i := range m.ErrorHandlers {
+		add(&m.ErrorHandlers[i])
+	}
+	for _, r := range m.Routes {
+		add(r.ErrorHandler)
+	}
+	slices.Sort(uris)
+
+	return uris
+}
+
+// Visit calls the visitor on every step of the routes, including the nested ones, until the visitor returns false.
+func (m *RouteModel) Visit(visitor func(Route, Step) bool) {
+	for _, r := range m.Routes {
+		if !visitSteps(r, r.Steps, visitor) {
+			return
+		}
+	}
+}
+
+func visitSteps(r Route, steps []Step, visitor func(Route, Step) bool) bool {
+	for _, s := range steps {
+		if !visitor(r, s) || !visitSteps(r, s.Steps, visitor) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Merge appends the content of another model.
+func (m *RouteModel) Merge(other *RouteModel) {
+	if other == nil {
+		return
+	}
+	m.Routes = append(m.Routes, other.Routes...)
+	m.Rests = append(m.Rests, other.Rests...)
+	m.ErrorHandlers = append(m.ErrorHandlers, other.ErrorHandlers...)
+	m.OnExceptions = append(m.OnExceptions, other.OnExceptions...)
+}
+
+// isLanguage returns true if the given name is an expression language.
+func (i *baseInspector) isLanguage(name string) bool {
+	if slices.Contains(coreLanguages, name) {
+		return true
+	}
+	_, ok := i.catalog.GetLanguageDependency(name)
+
+	return ok
+}
+
+// isEndpointStep returns true for the EIPs sending to an endpoint.
+func isEndpointStep(kind string) bool {
+	switch strings.ToLower(strings.ReplaceAll(kind, "-", "")) {
+	case "to", "tod", "tof", "wiretap", "enrich", "pollenrich":
+		return true
+	}
+
+	return false
+}
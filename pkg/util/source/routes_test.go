@@ -0,0 +1,242 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+)
+
+const yamlRouteModel = `
+- errorHandler:
+    deadLetterChannel:
+      deadLetterUri: "kafka:dlq"
+- onException:
+    exception:
+      - java.io.IOException
+    steps:
+      - to: "log:io"
+- route:
+    id: orders
+    from:
+      uri: "kafka:orders"
+      parameters:
+        groupId: g1
+      steps:
+        - split:
+            simple: "${body.items}"
+            steps:
+              - choice:
+                  when:
+                    - simple: "${body.priority} == 'high'"
+                      steps:
+                        - to: "direct:urgent"
+                  otherwise:
+                    steps:
+                      - to: "direct:normal"
+        - circuitBreaker:
+            steps:
+              - to: "http://inventory/check"
+            onFallback:
+              steps:
+                - setBody:
+                    constant: "unknown"
+        - aggregate:
+            correlationExpression:
+              header: "orderId"
+            steps:
+              - to: "direct:aggregated"
+- routeTemplate:
+    id: my-template
+    parameters:
+      - name: period
+      - name: message
+    from:
+      uri: "timer:tick"
+      steps:
+        - saga:
+            steps:
+              - to: "direct:book"
+- rest:
+    path: /api
+    get:
+      - path: /orders/{id}
+        to: "direct:get-order"
+    post:
+      - path: /orders
+        to: "direct:create-order"
+`
+
+const xmlRouteModel = `
+<camel>
+  <errorHandler>
+    <deadLetterChannel deadLetterUri="kafka:dlq"/>
+  </errorHandler>
+  <route id="orders" errorHandlerRef="myHandler">
+    <from uri="kafka:orders"/>
+    <split>
+      <simple>${body.items}</simple>
+      <choice>
+        <when>
+          <simple>${body.priority} == 'high'</simple>
+          <to uri="direct:urgent"/>
+        </when>
+        <otherwise>
+          <to uri="direct:normal"/>
+        </otherwise>
+      </choice>
+    </split>
+    <doTry>
+      <to uri="http://inventory/check"/>
+      <doCatch>
+        <exception>java.io.IOException</exception>
+        <to uri="log:io"/>
+      </doCatch>
+    </doTry>
+  </route>
+  <routeTemplate id="my-template">
+    <templateParameter name="period"/>
+    <route>
+      <from uri="timer:tick"/>
+      <to uri="log:info"/>
+    </route>
+  </routeTemplate>
+  <rest path="/api">
+    <get path="/orders/{id}" to="direct:get-order"/>
+    <post path="/orders">
+      <to uri="direct:create-order"/>
+    </post>
+  </rest>
+</camel>
+`
+
+func TestYAMLRouteModel(t *testing.T) {
+	model := inspectRouteModel(t, v1.LanguageYaml, yamlRouteModel)
+
+	require.Len(t, model.Routes, 2)
+	assert.Equal(t, []ErrorHandler{{Kind: "deadLetterChannel", URI: "kafka:dlq"}}, model.ErrorHandlers)
+	require.Len(t, model.OnExceptions, 1)
+	assert.Equal(t, []string{"java.io.IOException"}, model.OnExceptions[0].Exceptions)
+
+	route := model.Routes[0]
+	assert.Equal(t, "orders", route.ID)
+	assert.Equal(t, "kafka:orders?groupId=g1", route.From)
+	require.Len(t, route.Steps, 3)
+	assert.Equal(t, "split", route.Steps[0].Kind)
+	assert.Equal(t, "simple: ${body.items}", route.Steps[0].Expression)
+	assert.Equal(t, "circuitBreaker", route.Steps[1].Kind)
+	assert.Equal(t, "aggregate", route.Steps[2].Kind)
+
+	choice := route.Steps[0].Steps[0]
+	assert.Equal(t, "choice", choice.Kind)
+	require.Len(t, choice.Steps, 2)
+	assert.Equal(t, "when", choice.Steps[0].Kind)
+	assert.Equal(t, "direct:urgent", choice.Steps[0].Steps[0].URI)
+	assert.Equal(t, "otherwise", choice.Steps[1].Kind)
+
+	fallback := route.Steps[1].Steps[len(route.Steps[1].Steps)-1]
+	assert.Equal(t, "onFallback", fallback.Kind)
+
+	template := model.Routes[1]
+	assert.True(t, template.Template)
+	assert.Equal(t, "my-template", template.ID)
+	assert.Equal(t, []string{"period", "message"}, template.Parameters)
+	assert.Equal(t, "saga", template.Steps[0].Kind)
+
+	assert.Equal(t, []string{"/api/orders", "/api/orders/{id}"}, model.RestPaths())
+	assert.Equal(t, []string{"direct:aggregated", "direct:book", "direct:normal", "direct:urgent", "http://inventory/check"}, endpoints(model))
+}
+
+func TestXMLRouteModel(t *testing.T) {
+	model := inspectRouteModel(t, v1.LanguageXML, xmlRouteModel)
+
+	require.Len(t, model.Routes, 2)
+	assert.Equal(t, []ErrorHandler{{Kind: "deadLetterChannel", URI: "kafka:dlq"}}, model.ErrorHandlers)
+
+	route := model.Routes[0]
+	assert.Equal(t, "orders", route.ID)
+	assert.Equal(t, "kafka:orders", route.From)
+	assert.Equal(t, &ErrorHandler{Kind: "ref", URI: "myHandler"}, route.ErrorHandler)
+	assert.Equal(t, []string{"kafka:dlq"}, model.DeadLetterURIs())
+	require.Len(t, route.Steps, 2)
+	assert.Equal(t, "split", route.Steps[0].Kind)
+	assert.Equal(t, "simple: ${body.items}", route.Steps[0].Expression)
+	assert.Equal(t, "when", route.Steps[0].Steps[0].Steps[0].Kind)
+	assert.Equal(t, "simple: ${body.priority} == 'high'", route.Steps[0].Steps[0].Steps[0].Expression)
+
+	doCatch := route.Steps[1].Steps[1]
+	assert.Equal(t, "doCatch", doCatch.Kind)
+	assert.Equal(t, []string{"java.io.IOException"}, doCatch.Exceptions)
+
+	template := model.Routes[1]
+	assert.True(t, template.Template)
+	assert.Equal(t, []string{"period"}, template.Parameters)
+	assert.Equal(t, "timer:tick", template.From)
+
+	require.Len(t, model.Rests, 1)
+	assert.Equal(t, []RestVerb{
+		{Method: "get", Path: "/orders/{id}", To: "direct:get-order"},
+		{Method: "post", Path: "/orders", To: "direct:create-order"},
+	}, model.Rests[0].Verbs)
+	assert.Equal(t, []string{"/api/orders", "/api/orders/{id}"}, model.RestPaths())
+}
+
+func TestRouteModelNotRequested(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	meta := NewMetadata()
+	inspector := YAMLInspector{baseInspector: baseInspector{catalog: catalog}}
+	require.NoError(t, inspector.Extract(v1.SourceSpec{DataSpec: v1.DataSpec{Name: "route.yaml", Content: yamlRouteModel}}, &meta))
+	assert.Nil(t, meta.Routes)
+}
+
+func inspectRouteModel(t *testing.T, language v1.Language, content string) *RouteModel {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	inspector := InspectorForLanguage(catalog, language)
+
+	meta := NewMetadataWithRoutes()
+	require.NoError(t, inspector.Extract(v1.SourceSpec{DataSpec: v1.DataSpec{Name: "route", Content: content}, Language: language}, &meta))
+	require.NotNil(t, meta.Routes)
+
+	return meta.Routes
+}
+
+func endpoints(model *RouteModel) []string {
+	var uris []string
+	model.Visit(func(_ Route, s Step) bool {
+		if s.URI != "" {
+			uris = append(uris, s.URI)
+		}
+
+		return true
+	})
+	slices.Sort(uris)
+
+	return uris
+}
@@ -0,0 +1,238 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"encoding/xml"
+	"slices"
+	"strings"
+)
+
+// xmlConfigurationElements are the EIP children which are options, rather than steps.
+var xmlConfigurationElements = []string{
+	"completionPredicate", "completionSizeExpression", "completionTimeoutExpression", "continued", "description",
+	"faultToleranceConfiguration", "handled", "onWhen", "optimisticLockRetryPolicy", "parameter", "property",
+	"redeliveryPolicy", "resilience4jConfiguration", "retryWhile", "templateParameter",
+}
+
+// xmlNode is a generic XML element.
+type xmlNode struct {
+	name     string
+	attrs    map[string]string
+	text     string
+	children []*xmlNode
+}
+
+func (n *xmlNode) attr(name string) string {
+	return n.attrs[name]
+}
+
+// parseXMLTree parses the XML content as a tree of generic elements. The parsing stops at the first syntax error.
+func parseXMLTree(content string) *xmlNode {
+	root := &xmlNode{}
+	stack := []*xmlNode{root}
+	decoder := xml.NewDecoder(strings.NewReader(content))
+	for {
+		t, err := decoder.Token()
+		if t == nil || err != nil {
+			break
+		}
+		parent := stack[len(stack)-1]
+		switch e := t.(type) {
+		case xml.StartElement:
+			node := &xmlNode{name: e.Name.Local, attrs: make(map[string]string, len(e.Attr))}
+			for _, a := range e.Attr {
+				node.attrs[a.Name.Local] = a.Value
+			}
+			parent.children = append(parent.children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case xml.CharData:
+			parent.text += string(e)
+		}
+	}
+
+	return root
+}
+
+// extractRouteModel builds the structured model of the routes defined in the XML DSL content.
+func (i XMLInspector) extractRouteModel(content string) *RouteModel {
+	model := RouteModel{}
+	i.xmlDefinitions(parseXMLTree(content), &model)
+
+	return &model
+}
+
+func (i XMLInspector) xmlDefinitions(node *xmlNode, model *RouteModel) {
+	for _, child := range node.children {
+		switch child.name {
+		case "route":
+			model.Routes = append(model.Routes, i.xmlRoute(child))
+		case "routeTemplate":
+			model.Routes = append(model.Routes, i.xmlRouteTemplate(child))
+		case rest:
+			model.Rests = append(model.Rests, xmlRest(child))
+		case "errorHandler":
+			if eh := xmlErrorHandler(child); eh != nil {
+				model.ErrorHandlers = append(model.ErrorHandlers, *eh)
+			}
+		case "onException":
+			model.OnExceptions = append(model.OnExceptions, i.xmlOnException(child))
+		default:
+			// ie, routes, rests, routeConfiguration or any other container
+			i.xmlDefinitions(child, model)
+		}
+	}
+}
+
+func (i XMLInspector) xmlRoute(route *xmlNode) Route {
+	r := Route{
+		ID: route.attr("id"),
+	}
+	if ref := route.attr("errorHandlerRef"); ref != "" {
+		r.ErrorHandler = &ErrorHandler{Kind: "ref", URI: ref}
+	}
+	for _, child := range route.children {
+		switch child.name {
+		case "from", "fromF":
+			r.From = child.attr(URI)
+		case "description":
+		default:
+			r.Steps = append(r.Steps, i.xmlStep(child))
+		}
+	}
+
+	return r
+}
+
+func (i XMLInspector) xmlRouteTemplate(tmpl *xmlNode) Route {
+	r := Route{}
+	for _, child := range tmpl.children {
+		if child.name == "route" {
+			r = i.xmlRoute(child)
+		}
+	}
+	r.ID = tmpl.attr("id")
+	r.Template = true
+	for _, child := range tmpl.children {
+		if child.name == "templateParameter" || child.name == "parameter" {
+			r.Parameters = append(r.Parameters, child.attr("name"))
+		}
+	}
+
+	return r
+}
+
+func (i XMLInspector) xmlOnException(clause *xmlNode) OnException {
+	step := i.xmlStep(clause)
+
+	return OnException{
+		Exceptions: step.Exceptions,
+		Steps:      step.Steps,
+	}
+}
+
+func (i XMLInspector) xmlStep(node *xmlNode) Step {
+	s := Step{
+		Kind: node.name,
+		ID:   node.attr("id"),
+	}
+	if isEndpointStep(node.name) {
+		s.URI = node.attr(URI)
+	} else if node.name == kamelet {
+		s.URI = kamelet + ":" + node.attr("name")
+	}
+	if node.name == "marshal" || node.name == "unmarshal" {
+		// the children are data formats
+		return s
+	}
+	for _, child := range node.children {
+		switch {
+		case child.name == "exception":
+			s.Exceptions = append(s.Exceptions, strings.TrimSpace(child.text))
+		case child.name == "expression" || child.name == "correlationExpression":
+			if s.Expression == "" {
+				s.Expression = i.xmlExpression(child)
+			}
+		case i.isLanguage(child.name):
+			if s.Expression == "" {
+				s.Expression = child.name + ": " + strings.TrimSpace(child.text)
+			}
+		case slices.Contains(xmlConfigurationElements, child.name):
+		default:
+			s.Steps = append(s.Steps, i.xmlStep(child))
+		}
+	}
+
+	return s
+}
+
+func (i XMLInspector) xmlExpression(node *xmlNode) string {
+	for _, child := range node.children {
+		if i.isLanguage(child.name) {
+			return child.name + ": " + strings.TrimSpace(child.text)
+		}
+	}
+
+	return ""
+}
+
+func xmlRest(node *xmlNode) Rest {
+	r := Rest{
+		ID:   node.attr("id"),
+		Path: node.attr("path"),
+	}
+	for _, child := range node.children {
+		if !slices.Contains(restVerbs, child.name) {
+			continue
+		}
+		verb := RestVerb{
+			ID:     child.attr("id"),
+			Method: child.name,
+			Path:   child.attr("path"),
+			To:     child.attr("to"),
+		}
+		if verb.Path == "" {
+			verb.Path = child.attr(URI)
+		}
+		for _, c := range child.children {
+			if c.name == "to" || c.name == "toD" {
+				verb.To = c.attr(URI)
+			}
+		}
+		r.Verbs = append(r.Verbs, verb)
+	}
+
+	return r
+}
+
+func xmlErrorHandler(node *xmlNode) *ErrorHandler {
+	if len(node.children) > 0 {
+		child := node.children[0]
+
+		return &ErrorHandler{Kind: child.name, URI: child.attr("deadLetterUri")}
+	}
+	if kind := node.attr("type"); kind != "" {
+		return &ErrorHandler{Kind: strings.ToLower(kind[:1]) + kind[1:], URI: node.attr("deadLetterUri")}
+	}
+
+	return nil
+}
@@ -0,0 +1,321 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/apache/camel-k/v2/pkg/util/uri"
+)
+
+var restVerbs = []string{"get", "post", "put", "patch", "delete", "head", "options"}
+
+// extractRouteModel builds the structured model of the routes defined in the YAML DSL definitions.
+func (i YAMLInspector) extractRouteModel(definitions []map[string]any) *RouteModel {
+	model := RouteModel{}
+	for _, definition := range definitions {
+		for _, k := range sortedKeys(definition) {
+			v, ok := definition[k].(map[any]any)
+			if !ok {
+				continue
+			}
+			switch k {
+			case "from":
+				model.Routes = append(model.Routes, i.yamlRoute(map[any]any{"from": v}))
+			case "route":
+				model.Routes = append(model.Routes, i.yamlRoute(v))
+			case "routeTemplate", "route-template":
+				model.Routes = append(model.Routes, i.yamlRouteTemplate(v))
+			case rest:
+				model.Rests = append(model.Rests, yamlRest(v))
+			case "errorHandler", "error-handler":
+				if eh := yamlErrorHandler(v); eh != nil {
+					model.ErrorHandlers = append(model.ErrorHandlers, *eh)
+				}
+			case "onException", "on-exception":
+				model.OnExceptions = append(model.OnExceptions, i.yamlOnException(v))
+			case "routeConfiguration", "route-configuration":
+				i.yamlRouteConfiguration(v, &model)
+			}
+		}
+	}
+
+	return &model
+}
+
+func (i YAMLInspector) yamlRoute(route map[any]any) Route {
+	r := Route{
+		ID: yamlString(route, "id"),
+	}
+	if from, ok := route["from"].(map[any]any); ok {
+		r.From = yamlURI(from)
+		if r.ID == "" {
+			r.ID = yamlString(from, "id")
+		}
+		r.Steps = i.yamlSteps(from["steps"])
+	}
+	if eh, ok := route["errorHandler"].(map[any]any); ok {
+		r.ErrorHandler = yamlErrorHandler(eh)
+	} else if ref := yamlString(route, "errorHandlerRef"); ref != "" {
+		r.ErrorHandler = &ErrorHandler{Kind: "ref", URI: ref}
+	}
+
+	return r
+}
+
+func (i YAMLInspector) yamlRouteTemplate(tmpl map[any]any) Route {
+	var r Route
+	if route, ok := tmpl["route"].(map[any]any); ok {
+		r = i.yamlRoute(route)
+	} else {
+		r = i.yamlRoute(tmpl)
+	}
+	r.ID = yamlString(tmpl, "id")
+	r.Template = true
+	if params, ok := tmpl["parameters"].([]any); ok {
+		for _, p := range params {
+			if pm, ok := p.(map[any]any); ok {
+				if name := yamlString(pm, "name"); name != "" {
+					r.Parameters = append(r.Parameters, name)
+				}
+			}
+		}
+	}
+
+	return r
+}
+
+func (i YAMLInspector) yamlRouteConfiguration(conf map[any]any, model *RouteModel) {
+	if eh, ok := conf["errorHandler"].(map[any]any); ok {
+		if h := yamlErrorHandler(eh); h != nil {
+			model.ErrorHandlers = append(model.ErrorHandlers, *h)
+		}
+	}
+	if clauses, ok := conf["onException"].([]any); ok {
+		for _, c := range clauses {
+			if cm, ok := c.(map[any]any); ok {
+				if oe, ok := cm["onException"].(map[any]any); ok {
+					model.OnExceptions = append(model.OnExceptions, i.yamlOnException(oe))
+				}
+			}
+		}
+	}
+}
+
+func (i YAMLInspector) yamlOnException(clause map[any]any) OnException {
+	return OnException{
+		Exceptions: yamlStrings(clause["exception"]),
+		Steps:      i.yamlSteps(clause["steps"]),
+	}
+}
+
+func (i YAMLInspector) yamlSteps(raw any) []Step {
+	list, ok := raw.([]any)
+	if !ok {
+		return nil
+	}
+	steps := make([]Step, 0, len(list))
+	for _, el := range list {
+		step, ok := el.(map[any]any)
+		if !ok {
+			continue
+		}
+		for k, v := range step {
+			if kind, ok := k.(string); ok {
+				steps = append(steps, i.yamlStep(kind, v))
+			}
+		}
+	}
+
+	return steps
+}
+
+func (i YAMLInspector) yamlStep(kind string, content any) Step {
+	s := Step{Kind: kind}
+	switch t := content.(type) {
+	case string:
+		if isEndpointStep(kind) {
+			s.URI = t
+		} else if kind == kamelet {
+			s.URI = kamelet + ":" + t
+		}
+	case map[any]any:
+		s.ID = yamlString(t, "id")
+		if isEndpointStep(kind) {
+			s.URI = yamlURI(t)
+		} else if kind == kamelet {
+			if name := yamlString(t, "name"); name != "" {
+				s.URI = kamelet + ":" + name
+			}
+		}
+		if kind != "bean" {
+			// the bean EIP `ref` and `method` options are not expressions
+			s.Expression = i.yamlExpression(t)
+		}
+		s.Exceptions = yamlStrings(t["exception"])
+		s.Steps = i.yamlSteps(t["steps"])
+		for _, branch := range []string{"when", "doCatch"} {
+			if list, ok := t[branch].([]any); ok {
+				for _, el := range list {
+					s.Steps = append(s.Steps, i.yamlStep(branch, el))
+				}
+			}
+		}
+		for _, branch := range []string{"otherwise", "doFinally", "onFallback"} {
+			if m, ok := t[branch].(map[any]any); ok {
+				s.Steps = append(s.Steps, i.yamlStep(branch, m))
+			}
+		}
+	}
+
+	return s
+}
+
+// yamlExpression returns the expression of an EIP, in the `language: expression` format.
+func (i YAMLInspector) yamlExpression(eip map[any]any) string {
+	if e, ok := eip["expression"].(map[any]any); ok {
+		return i.yamlExpression(e)
+	}
+	for _, k := range sortedKeys(eip) {
+		if !i.isLanguage(k) {
+			continue
+		}
+		switch v := eip[k].(type) {
+		case map[any]any:
+			if e := yamlString(v, "expression"); e != "" {
+				return k + ": " + e
+			}
+
+			return k
+		case nil:
+			return k
+		default:
+			return fmt.Sprintf("%s: %v", k, v)
+		}
+	}
+
+	return ""
+}
+
+func yamlRest(r map[any]any) Rest {
+	res := Rest{
+		ID:   yamlString(r, "id"),
+		Path: yamlString(r, "path"),
+	}
+	for _, method := range restVerbs {
+		var operations []any
+		switch t := r[method].(type) {
+		case []any:
+			operations = t
+		case map[any]any:
+			operations = []any{t}
+		}
+		for _, op := range operations {
+			if om, ok := op.(map[any]any); ok {
+				verb := RestVerb{
+					ID:     yamlString(om, "id"),
+					Method: method,
+					Path:   yamlString(om, "path"),
+				}
+				switch to := om["to"].(type) {
+				case string:
+					verb.To = to
+				case map[any]any:
+					verb.To = yamlURI(to)
+				}
+				res.Verbs = append(res.Verbs, verb)
+			}
+		}
+	}
+
+	return res
+}
+
+func yamlErrorHandler(eh map[any]any) *ErrorHandler {
+	for _, kind := range sortedKeys(eh) {
+		h := ErrorHandler{Kind: kind}
+		switch v := eh[kind].(type) {
+		case map[any]any:
+			h.URI = yamlString(v, "deadLetterUri")
+			if h.URI == "" {
+				h.URI = yamlString(v, "ref")
+			}
+		case string:
+			h.URI = v
+		}
+
+		return &h
+	}
+
+	return nil
+}
+
+// yamlURI returns the URI of an endpoint definition, including its parameters.
+func yamlURI(endpoint map[any]any) string {
+	u := yamlString(endpoint, "uri")
+	if u == "" {
+		return ""
+	}
+	if params, ok := endpoint["parameters"].(map[any]any); ok {
+		p := make(map[string]string, len(params))
+		for k, v := range params {
+			p[fmt.Sprintf("%v", k)] = fmt.Sprintf("%v", v)
+		}
+		u = uri.AppendParameters(u, p)
+	}
+
+	return u
+}
+
+func yamlString(m map[any]any, key string) string {
+	if v, ok := m[key]; ok && v != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return ""
+}
+
+func yamlStrings(raw any) []string {
+	switch t := raw.(type) {
+	case string:
+		return []string{t}
+	case []any:
+		res := make([]string, 0, len(t))
+		for _, v := range t {
+			res = append(res, fmt.Sprintf("%v", v))
+		}
+
+		return res
+	}
+
+	return nil
+}
+
+// sortedKeys returns the string keys of a YAML map, sorted to get a deterministic result.
+func sortedKeys[K comparable, V any](m map[K]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		if s, ok := any(k).(string); ok {
+			keys = append(keys, s)
+		}
+	}
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,155 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	yaml2 "gopkg.in/yaml.v2"
+
+	"github.com/apache/camel-k/v2/pkg/resources"
+)
+
+const (
+	// RiskCapabilityPrefix is the prefix of the capabilities reporting the risk categories of an Integration.
+	RiskCapabilityPrefix = "risk:"
+
+	securityRulesResource = "resources/security-rules.yaml"
+)
+
+// SecurityRule tags the usage of a security sensitive component with a risk category.
+type SecurityRule struct {
+	// The risk category
+	Category string `yaml:"category"`
+	// The component scheme of the endpoints the rule applies to
+	Scheme string `yaml:"scheme,omitempty"`
+	// A regular expression the endpoint URIs must match, all the scheme endpoints match when empty
+	URI string `yaml:"uri,omitempty"`
+	// The expression language the rule applies to
+	Language string `yaml:"language,omitempty"`
+	// A human readable description of the risk
+	Description string `yaml:"description,omitempty"`
+
+	uri *regexp.Regexp
+}
+
+type securityRules struct {
+	Rules []SecurityRule `yaml:"rules"`
+}
+
+// ParseSecurityRules parses and validates a rules table.
+func ParseSecurityRules(data []byte) ([]SecurityRule, error) {
+	var table securityRules
+	if err := yaml2.Unmarshal(data, &table); err != nil {
+		return nil, fmt.Errorf("cannot parse security rules: %w", err)
+	}
+	for i := range table.Rules {
+		r := &table.Rules[i]
+		switch {
+		case r.Category == "":
+			return nil, fmt.Errorf("security rule %d: missing category", i)
+		case (r.Scheme == "") == (r.Language == ""):
+			return nil, fmt.Errorf("security rule %d: exactly one of scheme or language is required", i)
+		case r.URI != "" && r.Scheme == "":
+			return nil, fmt.Errorf("security rule %d: uri requires a scheme", i)
+		}
+		if r.URI != "" {
+			exp, err := regexp.Compile(r.URI)
+			if err != nil {
+				return nil, fmt.Errorf("security rule %d: invalid uri expression: %w", i, err)
+			}
+			r.uri = exp
+		}
+	}
+
+	return table.Rules, nil
+}
+
+var defaultSecurityRules = sync.OnceValues(func() ([]SecurityRule, error) {
+	data, err := resources.Resource(securityRulesResource)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseSecurityRules(data)
+})
+
+// DefaultSecurityRules returns the rules table shipped with the operator.
+func DefaultSecurityRules() ([]SecurityRule, error) {
+	return defaultSecurityRules()
+}
+
+var securityRulesOverride atomic.Pointer[[]SecurityRule]
+
+// SetSecurityRules replaces the rules table shipped with the operator, which is restored when rules is nil.
+func SetSecurityRules(rules []SecurityRule) {
+	if rules == nil {
+		securityRulesOverride.Store(nil)
+
+		return
+	}
+	securityRulesOverride.Store(&rules)
+}
+
+// SecurityRules returns the rules table provided to the operator, or the one shipped with the operator by default.
+func SecurityRules() ([]SecurityRule, error) {
+	if rules := securityRulesOverride.Load(); rules != nil {
+		return *rules, nil
+	}
+
+	return DefaultSecurityRules()
+}
+
+// MatchURI returns true if the rule applies to the given endpoint URI.
+func (r SecurityRule) MatchURI(uri string) bool {
+	if r.Scheme == "" {
+		return false
+	}
+	scheme, _, ok := strings.Cut(uri, ":")
+	if !ok || scheme != r.Scheme {
+		return false
+	}
+
+	return r.uri == nil || r.uri.MatchString(uri)
+}
+
+// RiskCapability returns the capability reporting the given risk category.
+func RiskCapability(category string) string {
+	return RiskCapabilityPrefix + category
+}
+
+// RiskCategory returns the risk category reported by the given capability, if any.
+func RiskCategory(capability string) (string, bool) {
+	return strings.CutPrefix(capability, RiskCapabilityPrefix)
+}
+
+// RiskCategories returns the risk categories reported by the given capabilities.
+func RiskCategories(capabilities []string) []string {
+	var categories []string
+	for _, c := range capabilities {
+		if category, ok := RiskCategory(c); ok {
+			categories = append(categories, category)
+		}
+	}
+
+	return categories
+}
@@ -0,0 +1,221 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultSecurityRules(t *testing.T) {
+	rules, err := DefaultSecurityRules()
+	require.NoError(t, err)
+	assert.NotEmpty(t, rules)
+
+	categories := make([]string, 0, len(rules))
+	for _, r := range rules {
+		categories = append(categories, r.Category)
+	}
+	assert.Contains(t, categories, "command-execution")
+	assert.Contains(t, categories, "filesystem-access")
+	assert.Contains(t, categories, "remote-shell")
+	assert.Contains(t, categories, "dynamic-sql")
+	assert.Contains(t, categories, "script-execution")
+}
+
+func TestParseSecurityRules(t *testing.T) {
+	rules, err := ParseSecurityRules([]byte(`
+rules:
+- category: filesystem-access
+  scheme: file
+  uri: '^file:/'
+`))
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.True(t, rules[0].MatchURI("file:/tmp/in"))
+	assert.False(t, rules[0].MatchURI("file:data/in"))
+	assert.False(t, rules[0].MatchURI("ftp:/tmp/in"))
+
+	_, err = ParseSecurityRules([]byte("rules:\n- scheme: exec\n"))
+	require.EqualError(t, err, "security rule 0: missing category")
+	_, err = ParseSecurityRules([]byte("rules:\n- category: c\n  scheme: exec\n  language: groovy\n"))
+	require.EqualError(t, err, "security rule 0: exactly one of scheme or language is required")
+	_, err = ParseSecurityRules([]byte("rules:\n- category: c\n  language: groovy\n  uri: x\n"))
+	require.EqualError(t, err, "security rule 0: uri requires a scheme")
+	_, err = ParseSecurityRules([]byte("rules:\n- category: c\n  scheme: exec\n  uri: '('\n"))
+	require.ErrorContains(t, err, "security rule 0: invalid uri expression")
+}
+
+func TestRiskCategories(t *testing.T) {
+	assert.Equal(t, "risk:remote-shell", RiskCapability("remote-shell"))
+	assert.Equal(t, []string{"remote-shell"}, RiskCategories([]string{"rest", "risk:remote-shell"}))
+	assert.Empty(t, RiskCategories([]string{"rest", "platform-http"}))
+}
+
+func TestYAMLRiskCategories(t *testing.T) {
+	tc := []struct {
+		name       string
+		source     string
+		categories []string
+	}{
+		{
+			name: "exec",
+			source: `
+- from:
+    uri: timer:tick
+    steps:
+      - to: exec:ls
+`,
+			categories: []string{"risk:command-execution"},
+		},
+		{
+			name: "absolute-file",
+			source: `
+- from:
+    uri: file:/var/data/inbox
+    steps:
+      - to: log:info
+`,
+			categories: []string{"risk:filesystem-access"},
+		},
+		{
+			name: "relative-file",
+			source: `
+- from:
+    uri: file:data/inbox
+    steps:
+      - to: log:info
+`,
+		},
+		{
+			name: "ssh",
+			source: `
+- from:
+    uri: ssh:host:22
+    steps:
+      - to: log:info
+`,
+			categories: []string{"risk:remote-shell"},
+		},
+		{
+			name: "dynamic-sql",
+			source: `
+- from:
+    uri: timer:tick
+    steps:
+      - toD: "sql:select * from orders where id = ${header.id}"
+`,
+			categories: []string{"risk:dynamic-sql"},
+		},
+		{
+			name: "static-sql",
+			source: `
+- from:
+    uri: timer:tick
+    steps:
+      - to: "sql:select * from orders where id = :#id"
+`,
+		},
+		{
+			name: "groovy-expression",
+			source: `
+- from:
+    uri: timer:tick
+    steps:
+      - setBody:
+          groovy: "'hello'"
+`,
+			categories: []string{"risk:script-execution"},
+		},
+	}
+
+	inspector := newTestYAMLInspector(t)
+	for _, test := range tc {
+		t.Run(test.name, func(t *testing.T) {
+			assertExtractYAML(t, inspector, test.source, func(meta *Metadata) {
+				assert.ElementsMatch(t, test.categories, riskCapabilities(meta))
+			})
+		})
+	}
+}
+
+func TestJavaRiskCategories(t *testing.T) {
+	inspector := newTestJavaSourceInspector(t)
+	assertExtract(t, &inspector, `
+		from("timer:tick")
+			.setBody().groovy("'hello'")
+			.to("exec:ls");
+	`, func(meta *Metadata) {
+		assert.ElementsMatch(t, []string{"risk:command-execution", "risk:script-execution"}, riskCapabilities(meta))
+	})
+}
+
+func TestXMLRiskCategories(t *testing.T) {
+	inspector := newTestXMLInspector(t)
+	assertExtract(t, &inspector, `
+		<routes>
+			<route>
+				<from uri="ssh:host:22"/>
+				<to uri="file:///var/data/outbox"/>
+			</route>
+		</routes>
+	`, func(meta *Metadata) {
+		assert.ElementsMatch(t, []string{"risk:remote-shell", "risk:filesystem-access"}, riskCapabilities(meta))
+	})
+}
+
+func TestSecurityRulesOverride(t *testing.T) {
+	rules, err := ParseSecurityRules([]byte("rules:\n- category: mail\n  scheme: smtp\n"))
+	require.NoError(t, err)
+	SetSecurityRules(rules)
+	defer SetSecurityRules(nil)
+
+	inspector := newTestYAMLInspector(t)
+	assertExtractYAML(t, inspector, `
+- from:
+    uri: timer:tick
+    steps:
+      - to: smtp:mail.example.com
+      - to: exec:ls
+`, func(meta *Metadata) {
+		assert.ElementsMatch(t, []string{"risk:mail"}, riskCapabilities(meta))
+	})
+
+	SetSecurityRules(nil)
+	defaults, err := DefaultSecurityRules()
+	require.NoError(t, err)
+	current, err := SecurityRules()
+	require.NoError(t, err)
+	assert.Equal(t, defaults, current)
+}
+
+// riskCapabilities returns the capabilities of the metadata reporting a risk category.
+func riskCapabilities(meta *Metadata) []string {
+	var capabilities []string
+	meta.RequiredCapabilities.Each(func(c string) bool {
+		if _, ok := RiskCategory(c); ok {
+			capabilities = append(capabilities, c)
+		}
+
+		return true
+	})
+
+	return capabilities
+}
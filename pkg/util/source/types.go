@@ -18,6 +18,7 @@ limitations under the License.
 package source
 
 import (
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/util/sets"
 )
 
@@ -29,6 +30,8 @@ type Metadata struct {
 	ToURIs []string
 	// All inferred dependencies required to run the integration
 	Dependencies *sets.Set
+	// The elements of the sources requiring the inferred dependencies
+	DependencyOrigins []v1.DependencyOrigin
 	// ExposesHTTPServices indicates if a route defined by the source is exposed
 	// through HTTP
 	ExposesHTTPServices bool
@@ -40,6 +43,30 @@ type Metadata struct {
 	RequiredCapabilities *sets.Set
 	// All kamelets
 	Kamelets []string
+	// All route templates ids
+	RouteTemplates []string
+	// All property placeholders, including the Secret and ConfigMap references
+	PropertyPlaceholders []PropertyPlaceholder
+	// The structured model of the routes, only extracted from YAML and XML sources
+	// when requested (see NewMetadataWithRoutes)
+	Routes *RouteModel
+}
+
+// PropertyPlaceholder is a property placeholder used in a source, ie, `{{my.prop:default}}` or `{{secret:name/key}}`.
+type PropertyPlaceholder struct {
+	// The property key, or the function and its argument (ie, `secret:name/key`)
+	Key string
+	// The function used to resolve the placeholder (ie, `secret`), if any
+	Function string
+	// The inline default value, if any
+	Default *string
+	// True for optional placeholders (ie, `{{?my.prop}}`), which don't need to be resolved
+	Optional bool
+}
+
+// IsResolvable returns true if the placeholder can be resolved without any further configuration.
+func (p PropertyPlaceholder) IsResolvable() bool {
+	return p.Optional || p.Default != nil
 }
 
 // NewMetadata creates a new metadata.
@@ -52,6 +79,14 @@ func NewMetadata() Metadata {
 	}
 }
 
+// NewMetadataWithRoutes creates a new metadata, requesting the extraction of the structured model of the routes.
+func NewMetadataWithRoutes() Metadata {
+	meta := NewMetadata()
+	meta.Routes = &RouteModel{}
+
+	return meta
+}
+
 // AddRequiredCapability -- .
 func (m *Metadata) AddRequiredCapability(capability string) {
 	m.RequiredCapabilities.Add(capability)
@@ -0,0 +1,132 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"slices"
+	"strings"
+
+	knativev1 "github.com/apache/camel-k/v2/pkg/internal/knative"
+	"github.com/apache/camel-k/v2/pkg/util/knative"
+	uriutils "github.com/apache/camel-k/v2/pkg/util/uri"
+)
+
+// httpComponents are the Camel components which can be used to call a Kubernetes Service.
+var httpComponents = []string{"http", "https", "vertx-http", "netty-http"}
+
+// endpoint is an entity shared among several workloads, ie, a Kafka topic.
+type endpoint struct {
+	kind NodeKind
+	name string
+	// an optional detail to match with the counterparts, ie, the Kafka brokers or the Knative event type
+	qualifier string
+}
+
+func (e endpoint) id() string {
+	return strings.ToLower(string(e.kind)) + "/" + e.name
+}
+
+// parseEndpoints returns the shared endpoints referenced by the given Camel URI, if any.
+func parseEndpoints(uri string, namespace string) []endpoint {
+	component := uriutils.GetComponent(uri)
+	switch {
+	case component == "kafka":
+		return parseKafkaEndpoints(uri)
+	case component == "knative":
+		if e, ok := parseKnativeEndpoint(uri); ok {
+			return []endpoint{e}
+		}
+	case component == "kamelet":
+		name := uriPath(uri)
+		name, _, _ = strings.Cut(name, "/")
+		if name != "" && name != "source" && name != "sink" {
+			return []endpoint{{kind: NodeKindKamelet, name: name}}
+		}
+	case component == "platform-http":
+		// the Service exposing the workload, named after the workload itself
+		return []endpoint{{kind: NodeKindService}}
+	case slices.Contains(httpComponents, component):
+		if name := serviceName(uri, namespace); name != "" {
+			return []endpoint{{kind: NodeKindService, name: name}}
+		}
+	}
+
+	return nil
+}
+
+func parseKafkaEndpoints(uri string) []endpoint {
+	brokers := uriutils.GetQueryParameter(uri, "brokers")
+	var endpoints []endpoint
+	for topic := range strings.SplitSeq(uriPath(uri), ",") {
+		if topic = strings.TrimSpace(topic); topic != "" && !strings.Contains(topic, "{{") {
+			endpoints = append(endpoints, endpoint{kind: NodeKindKafkaTopic, name: topic, qualifier: brokers})
+		}
+	}
+
+	return endpoints
+}
+
+func parseKnativeEndpoint(uri string) (endpoint, bool) {
+	ref, err := knative.ExtractObjectReference(uri)
+	if err != nil || ref.Name == "" {
+		return endpoint{}, false
+	}
+	switch {
+	case len(knative.FilterURIs([]string{uri}, knativev1.CamelServiceTypeChannel)) > 0:
+		return endpoint{kind: NodeKindChannel, name: ref.Name}, true
+	case len(knative.FilterURIs([]string{uri}, knativev1.CamelServiceTypeEvent)) > 0:
+		return endpoint{kind: NodeKindBroker, name: ref.Name, qualifier: knative.ExtractEventType(uri)}, true
+	default:
+		return endpoint{kind: NodeKindService, name: ref.Name}, true
+	}
+}
+
+// serviceName returns the name of the Kubernetes Service in the given namespace targeted by an HTTP URI, if any.
+func serviceName(uri string, namespace string) string {
+	address := strings.TrimPrefix(uri, uriutils.GetComponent(uri)+":")
+	address = strings.TrimPrefix(strings.TrimPrefix(address, "http:"), "https:")
+	address = strings.TrimLeft(address, "/")
+	host := address
+	if i := strings.IndexAny(address, ":/?"); i >= 0 {
+		host = address[:i]
+	}
+	if host == "" || strings.Contains(host, "{{") {
+		return ""
+	}
+	parts := strings.Split(host, ".")
+	switch {
+	case len(parts) == 1:
+		return parts[0]
+	case parts[1] != namespace:
+		return ""
+	case len(parts) == 2,
+		len(parts) == 3 && parts[2] == "svc",
+		strings.Join(parts[2:], ".") == "svc.cluster.local":
+		return parts[0]
+	}
+
+	return ""
+}
+
+// uriPath returns the path of the URI, without the component and the query parameters.
+func uriPath(uri string) string {
+	path := strings.TrimPrefix(uri, uriutils.GetComponent(uri)+":")
+	path, _, _ = strings.Cut(path, "?")
+
+	return strings.TrimLeft(path, "/")
+}
@@ -0,0 +1,57 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEndpoints(t *testing.T) {
+	tests := []struct {
+		uri      string
+		expected []endpoint
+	}{
+		{"kafka:orders?brokers=my-cluster:9092", []endpoint{{kind: NodeKindKafkaTopic, name: "orders", qualifier: "my-cluster:9092"}}},
+		{"kafka:a,b", []endpoint{{kind: NodeKindKafkaTopic, name: "a"}, {kind: NodeKindKafkaTopic, name: "b"}}},
+		{"kafka:{{topic}}", nil},
+		{"knative:channel/messages", []endpoint{{kind: NodeKindChannel, name: "messages"}}},
+		{"knative://channel/messages?apiVersion=messaging.knative.dev/v1", []endpoint{{kind: NodeKindChannel, name: "messages"}}},
+		{"knative:event/order.created", []endpoint{{kind: NodeKindBroker, name: "default", qualifier: "order.created"}}},
+		{"knative:event?name=my-broker", []endpoint{{kind: NodeKindBroker, name: "my-broker"}}},
+		{"knative:endpoint/billing", []endpoint{{kind: NodeKindService, name: "billing"}}},
+		{"kamelet:log-sink?showHeaders=true", []endpoint{{kind: NodeKindKamelet, name: "log-sink"}}},
+		{"kamelet:my-route/route-1", []endpoint{{kind: NodeKindKamelet, name: "my-route"}}},
+		{"kamelet:sink", nil},
+		{"platform-http:/orders", []endpoint{{kind: NodeKindService}}},
+		{"http://billing:8080/invoices", []endpoint{{kind: NodeKindService, name: "billing"}}},
+		{"https://billing.ns.svc.cluster.local/invoices", []endpoint{{kind: NodeKindService, name: "billing"}}},
+		{"http:billing.ns.svc/invoices", []endpoint{{kind: NodeKindService, name: "billing"}}},
+		{"vertx-http:http://billing.ns/invoices", []endpoint{{kind: NodeKindService, name: "billing"}}},
+		{"http://billing.other/invoices", nil},
+		{"https://www.example.com", nil},
+		{"log:info", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.uri, func(t *testing.T) {
+			assert.Equal(t, test.expected, parseEndpoints(test.uri, "ns"))
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteDOT writes the Graph in the Graphviz DOT language.
+func WriteDOT(out io.Writer, g Graph) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "digraph %q {\n", g.Namespace)
+	sb.WriteString("  rankdir=LR;\n")
+	for _, n := range g.Nodes {
+		attrs := fmt.Sprintf("label=%q, shape=%s", string(n.Kind)+"\n"+n.Name, dotShape(n.Kind))
+		if n.Kind == NodeKindPipe {
+			attrs += ", style=rounded"
+		}
+		if n.Dangling {
+			attrs += ", color=red"
+		}
+		fmt.Fprintf(&sb, "  %q [%s];\n", n.ID, attrs)
+	}
+	for _, e := range g.Edges {
+		attrs := ""
+		if e.Dangling {
+			attrs = " [color=red, style=dashed]"
+		}
+		fmt.Fprintf(&sb, "  %q -> %q%s;\n", e.From, e.To, attrs)
+	}
+	sb.WriteString("}\n")
+	_, err := io.WriteString(out, sb.String())
+
+	return err
+}
+
+// WriteMermaid writes the Graph as a Mermaid flowchart.
+func WriteMermaid(out io.Writer, g Graph) error {
+	var sb strings.Builder
+	ids := make(map[string]string, len(g.Nodes))
+	sb.WriteString("flowchart LR\n")
+	var dangling []string
+	for i, n := range g.Nodes {
+		id := fmt.Sprintf("n%d", i)
+		ids[n.ID] = id
+		label := strings.ReplaceAll(string(n.Kind)+": "+n.Name, `"`, "#quot;")
+		if isEndpoint(n.Kind) {
+			fmt.Fprintf(&sb, "  %s([\"%s\"])\n", id, label)
+		} else {
+			fmt.Fprintf(&sb, "  %s[\"%s\"]\n", id, label)
+		}
+		if n.Dangling {
+			dangling = append(dangling, id)
+		}
+	}
+	for _, e := range g.Edges {
+		arrow := "-->"
+		if e.Dangling {
+			arrow = "-.->"
+		}
+		fmt.Fprintf(&sb, "  %s %s %s\n", ids[e.From], arrow, ids[e.To])
+	}
+	if len(dangling) > 0 {
+		sb.WriteString("  classDef dangling stroke:#f00,stroke-dasharray:5\n")
+		fmt.Fprintf(&sb, "  class %s dangling\n", strings.Join(dangling, ","))
+	}
+	_, err := io.WriteString(out, sb.String())
+
+	return err
+}
+
+// WriteJSON writes the Graph as JSON.
+func WriteJSON(out io.Writer, g Graph) error {
+	data, err := json.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(out, string(data))
+
+	return err
+}
+
+func dotShape(kind NodeKind) string {
+	if isEndpoint(kind) {
+		return "ellipse"
+	}
+
+	return "box"
+}
@@ -0,0 +1,204 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"cmp"
+	"slices"
+)
+
+// NodeKind is the kind of a node of the topology graph.
+type NodeKind string
+
+const (
+	// NodeKindIntegration is an Integration.
+	NodeKindIntegration NodeKind = "Integration"
+	// NodeKindPipe is a Pipe.
+	NodeKindPipe NodeKind = "Pipe"
+	// NodeKindKafkaTopic is a Kafka topic.
+	NodeKindKafkaTopic NodeKind = "KafkaTopic"
+	// NodeKindChannel is a Knative channel.
+	NodeKindChannel NodeKind = "Channel"
+	// NodeKindBroker is a Knative broker.
+	NodeKindBroker NodeKind = "Broker"
+	// NodeKindService is a Kubernetes or Knative Service.
+	NodeKindService NodeKind = "Service"
+	// NodeKindKamelet is a Kamelet.
+	NodeKindKamelet NodeKind = "Kamelet"
+)
+
+// Graph is the topology of the Integrations and Pipes of a namespace, linked through the endpoints they share.
+type Graph struct {
+	Namespace string `json:"namespace"`
+	Nodes     []Node `json:"nodes"`
+	Edges     []Edge `json:"edges"`
+}
+
+// Node is either a workload (Integration or Pipe) or an endpoint shared among workloads.
+type Node struct {
+	ID   string   `json:"id"`
+	Kind NodeKind `json:"kind"`
+	Name string   `json:"name"`
+	// true for an endpoint missing a producer or a consumer counterpart, or for a missing Kamelet
+	Dangling bool `json:"dangling,omitempty"`
+}
+
+// Edge links a producer to an endpoint or an endpoint to a consumer.
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// the Camel URI, or the Pipe reference, the edge is computed from
+	URI string `json:"uri,omitempty"`
+	// true when the producer or the consumer has no counterpart
+	Dangling bool `json:"dangling,omitempty"`
+
+	qualifier string
+}
+
+// Workload is an Integration or a Pipe, with the URIs it consumes from and produces to.
+type Workload struct {
+	Kind NodeKind
+	Name string
+	From []string
+	To   []string
+	// true when the workload is exposed by a Kubernetes or Knative Service named after it
+	ExposesHTTP bool
+}
+
+// Builder collects the workloads of a namespace to compute the topology Graph.
+type Builder struct {
+	namespace string
+	nodes     map[string]*Node
+	edges     []Edge
+	kamelets  map[string]bool
+}
+
+// NewBuilder creates a Builder for the given namespace.
+func NewBuilder(namespace string) *Builder {
+	return &Builder{
+		namespace: namespace,
+		nodes:     make(map[string]*Node),
+		kamelets:  make(map[string]bool),
+	}
+}
+
+// AddKamelets declares the Kamelets available to the workloads.
+func (b *Builder) AddKamelets(names ...string) {
+	for _, name := range names {
+		b.kamelets[name] = true
+	}
+}
+
+// AddWorkload adds an Integration or a Pipe, linked to the endpoints it consumes from and produces to.
+func (b *Builder) AddWorkload(w Workload) {
+	workload := b.addNode(w.Kind, w.Name)
+	if w.ExposesHTTP {
+		service := endpoint{kind: NodeKindService, name: w.Name}
+		b.addEdge(b.addNode(service.kind, service.name).ID, workload.ID, "", "")
+	}
+	for _, uri := range w.From {
+		for _, e := range parseEndpoints(uri, b.namespace) {
+			if e.kind == NodeKindService {
+				if w.ExposesHTTP {
+					continue
+				}
+				// the workload is the one exposing the Service
+				e.name = w.Name
+			}
+			b.addEdge(b.addNode(e.kind, e.name).ID, workload.ID, uri, e.qualifier)
+		}
+	}
+	for _, uri := range w.To {
+		for _, e := range parseEndpoints(uri, b.namespace) {
+			if e.name == "" {
+				continue
+			}
+			b.addEdge(workload.ID, b.addNode(e.kind, e.name).ID, uri, e.qualifier)
+		}
+	}
+}
+
+// Build computes the Graph, marking the producers and the consumers with no counterpart.
+func (b *Builder) Build() Graph {
+	g := Graph{
+		Namespace: b.namespace,
+		Nodes:     make([]Node, 0, len(b.nodes)),
+		Edges:     make([]Edge, 0, len(b.edges)),
+	}
+	for i := range b.edges {
+		edge := &b.edges[i]
+		if node := b.nodes[edge.From]; isEndpoint(node.Kind) {
+			// a consumer
+			edge.Dangling = !b.hasCounterpart(node, edge, func(other Edge) bool { return other.To == node.ID })
+		} else if node := b.nodes[edge.To]; isEndpoint(node.Kind) {
+			// a producer
+			edge.Dangling = !b.hasCounterpart(node, edge, func(other Edge) bool { return other.From == node.ID })
+		}
+		if edge.Dangling {
+			b.nodes[edge.From].Dangling = b.nodes[edge.From].Dangling || isEndpoint(b.nodes[edge.From].Kind)
+			b.nodes[edge.To].Dangling = b.nodes[edge.To].Dangling || isEndpoint(b.nodes[edge.To].Kind)
+		}
+		g.Edges = append(g.Edges, *edge)
+	}
+	for _, node := range b.nodes {
+		g.Nodes = append(g.Nodes, *node)
+	}
+	slices.SortFunc(g.Nodes, func(a, b Node) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	slices.SortFunc(g.Edges, func(a, b Edge) int {
+		return cmp.Or(cmp.Compare(a.From, b.From), cmp.Compare(a.To, b.To), cmp.Compare(a.URI, b.URI))
+	})
+
+	return g
+}
+
+func (b *Builder) hasCounterpart(node *Node, edge *Edge, isCounterpart func(Edge) bool) bool {
+	if node.Kind == NodeKindKamelet {
+		return b.kamelets[node.Name]
+	}
+	for _, other := range b.edges {
+		if isCounterpart(other) && (edge.qualifier == "" || other.qualifier == "" || edge.qualifier == other.qualifier) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *Builder) addNode(kind NodeKind, name string) *Node {
+	id := endpoint{kind: kind, name: name}.id()
+	if node, ok := b.nodes[id]; ok {
+		return node
+	}
+	node := &Node{ID: id, Kind: kind, Name: name}
+	b.nodes[id] = node
+
+	return node
+}
+
+func (b *Builder) addEdge(from string, to string, uri string, qualifier string) {
+	edge := Edge{From: from, To: to, URI: uri, qualifier: qualifier}
+	if !slices.Contains(b.edges, edge) {
+		b.edges = append(b.edges, edge)
+	}
+}
+
+func isEndpoint(kind NodeKind) bool {
+	return kind != NodeKindIntegration && kind != NodeKindPipe
+}
@@ -0,0 +1,133 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildGraph(t *testing.T) {
+	b := NewBuilder("ns")
+	b.AddKamelets("timer-source")
+	b.AddWorkload(Workload{
+		Kind: NodeKindIntegration,
+		Name: "producer",
+		From: []string{"kamelet:timer-source"},
+		To:   []string{"kafka:orders?brokers=my-cluster:9092", "http://billing/invoices", "kafka:audit"},
+	})
+	b.AddWorkload(Workload{
+		Kind:        NodeKindIntegration,
+		Name:        "billing",
+		From:        []string{"platform-http:/invoices"},
+		ExposesHTTP: true,
+	})
+	b.AddWorkload(Workload{
+		Kind: NodeKindPipe,
+		Name: "consumer",
+		From: []string{"kafka:orders"},
+		To:   []string{"kamelet:missing-sink"},
+	})
+
+	g := b.Build()
+	assert.Equal(t, "ns", g.Namespace)
+	assert.Equal(t, []Node{
+		{ID: "integration/billing", Kind: NodeKindIntegration, Name: "billing"},
+		{ID: "integration/producer", Kind: NodeKindIntegration, Name: "producer"},
+		{ID: "kafkatopic/audit", Kind: NodeKindKafkaTopic, Name: "audit", Dangling: true},
+		{ID: "kafkatopic/orders", Kind: NodeKindKafkaTopic, Name: "orders"},
+		{ID: "kamelet/missing-sink", Kind: NodeKindKamelet, Name: "missing-sink", Dangling: true},
+		{ID: "kamelet/timer-source", Kind: NodeKindKamelet, Name: "timer-source"},
+		{ID: "pipe/consumer", Kind: NodeKindPipe, Name: "consumer"},
+		{ID: "service/billing", Kind: NodeKindService, Name: "billing"},
+	}, g.Nodes)
+	assert.Equal(t, []Edge{
+		{From: "integration/producer", To: "kafkatopic/audit", URI: "kafka:audit", Dangling: true},
+		{From: "integration/producer", To: "kafkatopic/orders", URI: "kafka:orders?brokers=my-cluster:9092", qualifier: "my-cluster:9092"},
+		{From: "integration/producer", To: "service/billing", URI: "http://billing/invoices"},
+		{From: "kafkatopic/orders", To: "pipe/consumer", URI: "kafka:orders"},
+		{From: "kamelet/timer-source", To: "integration/producer", URI: "kamelet:timer-source"},
+		{From: "pipe/consumer", To: "kamelet/missing-sink", URI: "kamelet:missing-sink", Dangling: true},
+		{From: "service/billing", To: "integration/billing"},
+	}, g.Edges)
+}
+
+func TestBuildGraphQualifiers(t *testing.T) {
+	b := NewBuilder("ns")
+	b.AddWorkload(Workload{
+		Kind: NodeKindIntegration,
+		Name: "producer",
+		To:   []string{"kafka:orders?brokers=a:9092", "knative:event/order.created"},
+	})
+	b.AddWorkload(Workload{
+		Kind: NodeKindIntegration,
+		Name: "consumer",
+		From: []string{"kafka:orders?brokers=b:9092", "knative:event/order.deleted"},
+	})
+
+	g := b.Build()
+	for _, e := range g.Edges {
+		assert.True(t, e.Dangling, "%s -> %s should be dangling", e.From, e.To)
+	}
+}
+
+func TestWriteGraph(t *testing.T) {
+	b := NewBuilder("ns")
+	b.AddWorkload(Workload{
+		Kind: NodeKindIntegration,
+		Name: "producer",
+		To:   []string{"knative:channel/messages"},
+	})
+	g := b.Build()
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, WriteDOT(buf, g))
+	assert.Equal(t, `digraph "ns" {
+  rankdir=LR;
+  "channel/messages" [label="Channel\nmessages", shape=ellipse, color=red];
+  "integration/producer" [label="Integration\nproducer", shape=box];
+  "integration/producer" -> "channel/messages" [color=red, style=dashed];
+}
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteMermaid(buf, g))
+	assert.Equal(t, `flowchart LR
+  n0(["Channel: messages"])
+  n1["Integration: producer"]
+  n1 -.-> n0
+  classDef dangling stroke:#f00,stroke-dasharray:5
+  class n0 dangling
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteJSON(buf, g))
+	assert.JSONEq(t, `{
+  "namespace": "ns",
+  "nodes": [
+    {"id": "channel/messages", "kind": "Channel", "name": "messages", "dangling": true},
+    {"id": "integration/producer", "kind": "Integration", "name": "producer"}
+  ],
+  "edges": [
+    {"from": "integration/producer", "to": "channel/messages", "uri": "knative:channel/messages", "dangling": true}
+  ]
+}`, buf.String())
+}